@@ -21,9 +21,12 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 
 	"github.com/nebulasio/go-nebulas/cmd/console"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/urfave/cli"
 )
 
@@ -74,6 +77,27 @@ Update an existing account.`,
 
 Imports an encrypted private key from <keyfile> and creates a new account.`,
 			},
+			{
+				Name:  "slashing",
+				Usage: "Export or import the slashing protection records of the miners",
+				Description: `
+The blocks and witnesses signed by the miners are recorded to refuse the conflicting ones,
+export the records before moving a miner key to another machine and import them there.`,
+				Subcommands: []cli.Command{
+					{
+						Name:      "export",
+						Usage:     "Export the slashing protection records into a file",
+						Action:    MergeFlags(slashingExport),
+						ArgsUsage: "<file>",
+					},
+					{
+						Name:      "import",
+						Usage:     "Merge the slashing protection records from a file",
+						Action:    MergeFlags(slashingImport),
+						ArgsUsage: "<file>",
+					},
+				},
+			},
 		},
 	}
 )
@@ -163,6 +187,59 @@ func accountImport(ctx *cli.Context) error {
 	return nil
 }
 
+// loadSlashingProtection load the slashing protection records in the datadir
+func loadSlashingProtection(ctx *cli.Context) *pod.SlashingProtection {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		FatalF("load config failed:%s", err)
+	}
+	stor, err := storage.NewDiskStorage(filepath.Join(neb.Config().Chain.Datadir, pod.SlashingProtectionDir))
+	if err != nil {
+		FatalF("open slashing protection failed:%s", err)
+	}
+	sp, err := pod.NewSlashingProtection(stor)
+	if err != nil {
+		FatalF("load slashing protection failed:%s", err)
+	}
+	return sp
+}
+
+// slashingExport export the slashing protection records
+func slashingExport(ctx *cli.Context) error {
+	file := ctx.Args().First()
+	if len(file) == 0 {
+		FatalF("file must be given as argument")
+	}
+
+	data, err := loadSlashingProtection(ctx).Export()
+	if err != nil {
+		FatalF("slashing export failed:%s", err)
+	}
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		FatalF("file write failed:%s", err)
+	}
+	fmt.Printf("Exported slashing protection to %s\n", file)
+	return nil
+}
+
+// slashingImport import the slashing protection records
+func slashingImport(ctx *cli.Context) error {
+	file := ctx.Args().First()
+	if len(file) == 0 {
+		FatalF("file must be given as argument")
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		FatalF("file read failed:%s", err)
+	}
+
+	if err := loadSlashingProtection(ctx).Import(data); err != nil {
+		FatalF("slashing import failed:%s", err)
+	}
+	fmt.Printf("Imported slashing protection from %s\n", file)
+	return nil
+}
+
 // getPassPhrase get passphrase from consle
 func getPassPhrase(prompt string, confirmation bool) string {
 	if prompt != "" {
@@ -21,11 +21,13 @@ package main
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"bytes"
 	"encoding/json"
 
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/urfave/cli"
 )
 
@@ -58,6 +60,63 @@ Dump the genesis config info.`,
 		},
 	}
 
+	chainCommand = cli.Command{
+		Name:     "chain",
+		Usage:    "Manage the blocks in storage",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Offline commands on the chain in storage, the node should be stopped.`,
+		Subcommands: []cli.Command{
+			{
+				Name:   "verify",
+				Usage:  "Re-execute a range of blocks and compare the resulting roots",
+				Action: MergeFlags(verifyChain),
+				Flags: []cli.Flag{
+					cli.Uint64Flag{
+						Name:  "from",
+						Usage: "height of the first block to verify",
+						Value: 2,
+					},
+					cli.Uint64Flag{
+						Name:  "to",
+						Usage: "height of the last block to verify, the tail by default",
+					},
+				},
+				Description: `
+   neb chain verify --from 2 --to 1000
+
+Load the blocks from storage, execute them again against the world state of their parents,
+and report the first block whose state, txs, events or consensus root mismatches,
+with the events of the transactions differing.`,
+			},
+			{
+				Name:   "rollback",
+				Usage:  "Reset the tail to a block in storage",
+				Action: MergeFlags(rollbackChain),
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "to",
+						Usage: "height on the canonical chain or hash of the new tail",
+					},
+					cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "show the blocks to discard without changing the storage",
+					},
+					cli.BoolFlag{
+						Name:  "force",
+						Usage: "allow to rollback below the latest irreversible block",
+					},
+				},
+				Description: `
+   neb chain rollback --to <height|hash> [--dry-run] [--force]
+
+Reset the tail to the block and rebuild the height index, the blocks above it are discarded
+from the canonical chain. Going below the latest irreversible block is refused unless forced,
+then the LIB is reset as well.`,
+			},
+		},
+	}
+
 	blockDumpCommand = cli.Command{
 		Action:    MergeFlags(dumpblock),
 		Name:      "dump",
@@ -124,3 +183,99 @@ func dumpblock(ctx *cli.Context) error {
 	fmt.Printf("blockchain dump: %s\n", neb.BlockChain().Dump(count))
 	return nil
 }
+
+func verifyChain(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	neb.Setup()
+
+	chain := neb.BlockChain()
+	from, to := ctx.Uint64("from"), ctx.Uint64("to")
+	if to == 0 {
+		to = chain.TailBlock().Height()
+	}
+	fmt.Printf("verify blocks from %d to %d\n", from, to)
+
+	mismatch, err := chain.VerifyBlocks(from, to, func(v *core.BlockVerification) {
+		if v.Height%1000 == 0 {
+			fmt.Printf("verified block %d\n", v.Height)
+		}
+	})
+	if err != nil {
+		FatalF("verify blocks failed: %v", err)
+	}
+	if mismatch == nil {
+		fmt.Printf("all blocks from %d to %d are verified\n", from, to)
+		return nil
+	}
+
+	data, err := json.MarshalIndent(mismatch, "", "    ")
+	if err != nil {
+		return err
+	}
+	FatalF("block %d mismatches:\n%s", mismatch.Height, data)
+	return nil
+}
+
+func rollbackChain(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	neb.Setup()
+
+	chain := neb.BlockChain()
+	target, err := parseBlock(chain, ctx.String("to"))
+	if err != nil {
+		FatalF("rollback faild: %v", err)
+	}
+
+	discarded, ancestor, err := chain.RollbackPlan(target)
+	if err != nil {
+		FatalF("rollback faild: %v", err)
+	}
+	fmt.Printf("tail %d %s, lib %d %s\n", chain.TailBlock().Height(), chain.TailBlock().Hash(), chain.LIB().Height(), chain.LIB().Hash())
+	fmt.Printf("rollback to %d %s, %d blocks discarded:\n", target.Height(), target.Hash(), len(discarded))
+	for _, block := range discarded {
+		fmt.Printf("  %d %s\n", block.Height(), block.Hash())
+	}
+	if ancestor.Height() < chain.LIB().Height() {
+		fmt.Printf("lib would be reset to %d %s\n", ancestor.Height(), ancestor.Hash())
+	}
+
+	if ctx.Bool("dry-run") {
+		return nil
+	}
+	if _, err := chain.Rollback(target, ctx.Bool("force")); err != nil {
+		FatalF("rollback faild: %v", err)
+	}
+	fmt.Printf("rolled back to %d %s\n", target.Height(), target.Hash())
+	return nil
+}
+
+// parseBlock return the block of a height on the canonical chain or a hash in hex.
+func parseBlock(chain *core.BlockChain, arg string) (*core.Block, error) {
+	if len(arg) == 0 {
+		return nil, core.ErrInvalidArgument
+	}
+	if height, err := strconv.ParseUint(arg, 10, 64); err == nil && len(arg) < 2*core.BlockHashLength {
+		block := chain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, core.ErrCannotFindBlockAtGivenHeight
+		}
+		return block, nil
+	}
+	hash, err := byteutils.FromHex(strings.TrimPrefix(arg, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	block := chain.GetBlock(hash)
+	if block == nil {
+		return nil, core.ErrBlockNotFound
+	}
+	return block, nil
+}
@@ -64,6 +64,7 @@ func main() {
 	app.Commands = []cli.Command{
 		initCommand,
 		genesisCommand,
+		chainCommand,
 		accountCommand,
 		consoleCommand,
 		networkCommand,
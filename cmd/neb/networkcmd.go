@@ -19,7 +19,12 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
 
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/util"
@@ -48,6 +53,30 @@ If the private key of a network node is exist, the nodeID will not change.
 
 Make sure that the seed node should have a private key.`,
 			},
+			{
+				Name:      "replay",
+				Usage:     "Replay captured network messages into an offline node",
+				Action:    MergeFlags(replayCapture),
+				ArgsUsage: "<capture file or dir>",
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "pace",
+						Usage: "keep the original intervals between messages",
+					},
+					cli.DurationFlag{
+						Name:  "wait",
+						Usage: "time to wait for the node to process the replayed messages",
+						Value: 10 * time.Second,
+					},
+				},
+				Description: `
+   neb network replay <capture file or dir>
+
+Feed the inbound messages of a capture, recorded with network.capture_dir,
+into the dispatcher of a node which is not connected to the network,
+to reproduce the behaviour of the block pool and the sync service.
+The node runs on a temporary copy of the datadir, removed on exit.`,
+			},
 		},
 	}
 )
@@ -69,3 +98,65 @@ func generatePrivateKey(ctx *cli.Context) error {
 
 	return util.FileWrite(path, []byte(str), false)
 }
+
+func replayCapture(ctx *cli.Context) error {
+	path := ctx.Args().First()
+	if len(path) == 0 {
+		FatalF("replay capture failed: missing capture path")
+	}
+
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	conf := neb.Config()
+
+	// replay into a copy of the datadir, the configured chain is left untouched.
+	datadir, err := ioutil.TempDir("", "neb-replay-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(datadir)
+	if exist, _ := util.FileExists(conf.Chain.Datadir); exist {
+		if err := util.CopyDir(conf.Chain.Datadir, datadir); err != nil {
+			return fmt.Errorf("replay capture failed: %v", err)
+		}
+	}
+	conf.Chain.Datadir = datadir
+	// do not capture the replayed messages again.
+	conf.Network.CaptureDir = ""
+	conf.Chain.StartMine = false
+	conf.Stats.EnableMetrics = false
+	neb.Setup()
+
+	storage := neb.Storage()
+	defer func() {
+		neb.Stop()
+		if closer, ok := storage.(io.Closer); ok {
+			closer.Close()
+		}
+	}()
+
+	ns, ok := neb.NetService().(*net.NebService)
+	if !ok {
+		return errors.New("replay capture failed: unexpected net service")
+	}
+	ns.StartDispatcher()
+	neb.EventEmitter().Start()
+	neb.BlockChain().Start()
+	// the captured blocks are older than the accepted network delay.
+	neb.BlockChain().BlockPool().SetTimeoutCheck(false)
+	neb.BlockChain().BlockPool().Start()
+	neb.BlockChain().TransactionPool().Start()
+	neb.SyncService().Start()
+
+	count, err := net.ReplayCapture(path, ns, ctx.Bool("pace"))
+	if err != nil {
+		return fmt.Errorf("replay capture failed: %v", err)
+	}
+	time.Sleep(ctx.Duration("wait"))
+
+	tail := neb.BlockChain().TailBlock()
+	fmt.Printf("replayed %d messages, tail block: height %d, hash %s\n", count, tail.Height(), tail.Hash())
+	return nil
+}
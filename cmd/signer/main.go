@@ -0,0 +1,214 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/cmd/console"
+	"github.com/nebulasio/go-nebulas/core"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/signer"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/urfave/cli"
+)
+
+var (
+	version string
+	commit  string
+	branch  string
+)
+
+const maxUnlockDuration time.Duration = 1<<63 - 1
+
+var (
+	listenFlag = cli.StringFlag{
+		Name:  "listen",
+		Usage: "signer service listen address",
+		Value: "127.0.0.1:8688",
+	}
+	keydirFlag = cli.StringFlag{
+		Name:  "keydir",
+		Usage: "keystore directory of the miners",
+		Value: "keydir",
+	}
+	addressFlag = cli.StringSliceFlag{
+		Name:  "address",
+		Usage: "miner addresses to sign for, multi-value support.",
+	}
+	passphraseFileFlag = cli.StringFlag{
+		Name:  "passphrase",
+		Usage: "load the passphrase of the miners from `FILE`, one line per address, prompted if not set",
+	}
+	datadirFlag = cli.StringFlag{
+		Name:  "datadir",
+		Usage: "data directory persisting the blocks and witnesses signed, to refuse the conflicting ones after restarts",
+		Value: "signer.db",
+	}
+	chainIDFlag = cli.UintFlag{
+		Name:  "chainid",
+		Usage: "only sign for the chain, 0 for any",
+	}
+	tlsCertFlag = cli.StringFlag{
+		Name:  "tls.cert",
+		Usage: "certificate of the signer, PEM encoded",
+	}
+	tlsKeyFlag = cli.StringFlag{
+		Name:  "tls.key",
+		Usage: "private key of the signer certificate, PEM encoded",
+	}
+	tlsCAFlag = cli.StringFlag{
+		Name:  "tls.ca",
+		Usage: "CA certificate to verify the miners, PEM encoded",
+	}
+	logFileFlag = cli.StringFlag{
+		Name:  "log.file",
+		Usage: "log directory",
+		Value: "logs/signer",
+	}
+	logLevelFlag = cli.StringFlag{
+		Name:  "log.level",
+		Usage: "log level",
+		Value: "info",
+	}
+)
+
+// keystoreConfig is the neblet of the account manager.
+type keystoreConfig struct {
+	config *nebletpb.Config
+}
+
+func (k *keystoreConfig) Config() *nebletpb.Config {
+	return k.config
+}
+
+func main() {
+	app := cli.NewApp()
+	app.Action = runSigner
+	app.Name = "signer"
+	app.Version = fmt.Sprintf("%s, branch %s, commit %s", version, branch, commit)
+	app.Usage = "the remote signer of the go-nebulas miners"
+	app.Copyright = "Copyright 2017-2019 The go-nebulas Authors"
+	app.Flags = []cli.Flag{
+		listenFlag,
+		keydirFlag,
+		addressFlag,
+		passphraseFileFlag,
+		datadirFlag,
+		chainIDFlag,
+		tlsCertFlag,
+		tlsKeyFlag,
+		tlsCAFlag,
+		logFileFlag,
+		logLevelFlag,
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func runSigner(ctx *cli.Context) error {
+	logging.Init(ctx.String(logFileFlag.Name), ctx.String(logLevelFlag.Name), 0)
+
+	tlsConfig, err := signer.NewServerTLSConfig(ctx.String(tlsCertFlag.Name), ctx.String(tlsKeyFlag.Name), ctx.String(tlsCAFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	am, err := account.NewManager(&keystoreConfig{
+		config: &nebletpb.Config{Chain: &nebletpb.ChainConfig{Keydir: ctx.String(keydirFlag.Name)}},
+	})
+	if err != nil {
+		return err
+	}
+
+	addresses, err := unlockAddresses(ctx, am)
+	if err != nil {
+		return err
+	}
+
+	stor, err := storage.NewDiskStorage(ctx.String(datadirFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	policy, err := signer.NewDefaultPolicy(uint32(ctx.Uint(chainIDFlag.Name)), stor)
+	if err != nil {
+		return err
+	}
+
+	server := signer.NewServer(am, addresses, policy, tlsConfig)
+	if _, err := server.Start(ctx.String(listenFlag.Name)); err != nil {
+		return err
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
+	<-c
+	server.Stop()
+	return nil
+}
+
+func unlockAddresses(ctx *cli.Context, am *account.Manager) ([]*core.Address, error) {
+	values := ctx.StringSlice(addressFlag.Name)
+	if len(values) == 0 {
+		return nil, fmt.Errorf("missing the miner addresses to sign for")
+	}
+
+	var passphrases []string
+	if file := ctx.String(passphraseFileFlag.Name); len(file) > 0 {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		passphrases = strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+		if len(passphrases) != len(values) {
+			return nil, fmt.Errorf("the passphrase file should have %d lines, one per address", len(values))
+		}
+	}
+
+	addresses := make([]*core.Address, len(values))
+	for k, v := range values {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		var passphrase string
+		if passphrases != nil {
+			passphrase = passphrases[k]
+		} else if passphrase, err = console.Stdin.PromptPassphrase("Passphrase of " + v + ": "); err != nil {
+			return nil, err
+		}
+		if err := am.Unlock(addr, []byte(passphrase), maxUnlockDuration); err != nil {
+			return nil, err
+		}
+		addresses[k] = addr
+	}
+	return addresses, nil
+}
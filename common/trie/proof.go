@@ -23,6 +23,12 @@ import (
 	"errors"
 )
 
+// Errors of proofs
+var (
+	ErrKeyExists           = errors.New("key exists in trie, cannot prove its exclusion")
+	ErrWrongExclusionProof = errors.New("wrong exclusion proof")
+)
+
 // MerkleProof is a path from root to the proved node
 // every element in path is the value of a node
 type MerkleProof [][][]byte
@@ -34,7 +40,7 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 	curRoute := keyToRoute(key)
 	curRootHash := t.rootHash
 	var proof MerkleProof
-	for len(curRoute) > 0 {
+	for {
 		// fetch sub-trie root node
 		rootNode, err := t.fetchNode(curRootHash)
 		if err != nil {
@@ -46,6 +52,9 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 		}
 		switch flag {
 		case branch:
+			if len(curRoute) == 0 {
+				return nil, ErrNotFound
+			}
 			proof = append(proof, rootNode.Val)
 			curRootHash = rootNode.Val[curRoute[0]]
 			curRoute = curRoute[1:]
@@ -71,7 +80,6 @@ func (t *Trie) Prove(key []byte) (MerkleProof, error) {
 			return nil, ErrNotFound
 		}
 	}
-	return nil, ErrNotFound
 }
 
 // Verify whether the merkle proof from root to the associated node is right
@@ -91,16 +99,19 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 		}
 		switch len(val) {
 		case 16: // Branch Node
+			if len(curRoute) == 0 {
+				return errors.New("wrong hash")
+			}
 			wantHash = val[curRoute[0]]
 			curRoute = curRoute[1:]
 			break
 		case 3: // Extension Node or Leaf Node
-			if val[0] == nil || len(val) == 0 {
+			if len(val[0]) == 0 {
 				return errors.New("unknown node type")
 			}
 			if val[0][0] == byte(ext) {
 				extLen := len(val[1])
-				if !bytes.Equal(val[1], curRoute[:extLen]) {
+				if extLen > len(curRoute) || !bytes.Equal(val[1], curRoute[:extLen]) {
 					return errors.New("wrong hash")
 				}
 				wantHash = val[2]
@@ -119,3 +130,110 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 	}
 	return nil
 }
+
+// ProvedValue return the value of the leaf node the verified proof ends with,
+// a proof not ending with a leaf node doesn't prove any value.
+func ProvedValue(proof MerkleProof) ([]byte, error) {
+	if len(proof) == 0 {
+		return nil, ErrNotFound
+	}
+	val := proof[len(proof)-1]
+	if len(val) != 3 || len(val[0]) == 0 || val[0][0] != byte(leaf) {
+		return nil, ErrNotFound
+	}
+	return val[2], nil
+}
+
+// ProveExclusion the key doesn't exist in trie,
+// MerkleProof is the path from root to the node the route of the key diverges at
+func (t *Trie) ProveExclusion(key []byte) (MerkleProof, error) {
+	curRoute := keyToRoute(key)
+	curRootHash := t.rootHash
+	var proof MerkleProof
+	for {
+		rootNode, err := t.fetchNode(curRootHash)
+		if err != nil {
+			return nil, err
+		}
+		flag, err := rootNode.Type()
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, rootNode.Val)
+		switch flag {
+		case branch:
+			if len(curRoute) == 0 || len(rootNode.Val[curRoute[0]]) == 0 {
+				return proof, nil
+			}
+			curRootHash = rootNode.Val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case ext:
+			path := rootNode.Val[1]
+			if prefixLen(path, curRoute) != len(path) {
+				return proof, nil
+			}
+			curRootHash = rootNode.Val[2]
+			curRoute = curRoute[len(path):]
+		case leaf:
+			if !bytes.Equal(rootNode.Val[1], curRoute) {
+				return proof, nil
+			}
+			return nil, ErrKeyExists
+		default:
+			return nil, ErrNotFound
+		}
+	}
+}
+
+// VerifyExclusion whether the merkle proof from root proves the key doesn't exist,
+// the last node of the proof must diverge from the route of the key
+func (t *Trie) VerifyExclusion(rootHash []byte, key []byte, proof MerkleProof) error {
+	curRoute := keyToRoute(key)
+	wantHash := rootHash
+	for i, val := range proof {
+		n, err := t.createNode(val)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(wantHash, n.Hash) {
+			return ErrWrongExclusionProof
+		}
+		last := i == len(proof)-1
+		switch len(val) {
+		case 16: // Branch Node
+			if len(curRoute) == 0 || len(val[curRoute[0]]) == 0 {
+				if last {
+					return nil
+				}
+				return ErrWrongExclusionProof
+			}
+			wantHash = val[curRoute[0]]
+			curRoute = curRoute[1:]
+		case 3: // Extension Node or Leaf Node
+			if len(val[0]) == 0 {
+				return ErrWrongExclusionProof
+			}
+			diverged := prefixLen(val[1], curRoute) != len(val[1])
+			if val[0][0] == byte(ext) {
+				if diverged {
+					if last {
+						return nil
+					}
+					return ErrWrongExclusionProof
+				}
+				wantHash = val[2]
+				curRoute = curRoute[len(val[1]):]
+			} else if val[0][0] == byte(leaf) {
+				if last && !bytes.Equal(val[1], curRoute) {
+					return nil
+				}
+				return ErrWrongExclusionProof
+			} else {
+				return ErrWrongExclusionProof
+			}
+		default:
+			return ErrWrongExclusionProof
+		}
+	}
+	return ErrWrongExclusionProof
+}
@@ -18,14 +18,193 @@
 
 package trie
 
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	triepb "github.com/nebulasio/go-nebulas/common/trie/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+)
+
+// Sync constants
+const (
+	MaxNodesPerFetch = 256
+)
+
+// Sync Errors
+var (
+	ErrNoNodeFetcher    = errors.New("no node fetcher to sync trie")
+	ErrSyncNodeNotFound = errors.New("trie node not found from other servers")
+)
+
+// NodeFetcher fetches trie nodes from other servers,
+// the i-th returned bytes is the node of the i-th hash, nil if not found.
+type NodeFetcher interface {
+	FetchNodes(hashes [][]byte) ([][]byte, error)
+}
+
+// SetNodeFetcher set where the missing nodes are fetched when syncing
+func (t *Trie) SetNodeFetcher(fetcher NodeFetcher) {
+	t.fetcher = fetcher
+}
+
 // SyncTrie data from other servers
 // Sync whole trie to build snapshot
 func (t *Trie) SyncTrie(rootHash []byte) error {
+	if len(rootHash) == 0 {
+		t.rootHash = nil
+		return nil
+	}
+
+	pending := [][]byte{rootHash}
+	for len(pending) > 0 {
+		// the nodes already in storage are walked through as well,
+		// so that an interrupted sync is completed.
+		missing := [][]byte{}
+		next := [][]byte{}
+		for _, h := range pending {
+			if _, err := t.storage.Get(h); err != nil {
+				missing = append(missing, h)
+				continue
+			}
+			n, err := t.fetchNode(h)
+			if err != nil {
+				return err
+			}
+			children, err := childrenOfNode(n)
+			if err != nil {
+				return err
+			}
+			next = append(next, children...)
+		}
+
+		for len(missing) > 0 {
+			size := len(missing)
+			if size > MaxNodesPerFetch {
+				size = MaxNodesPerFetch
+			}
+			nodes, err := t.syncNodes(missing[:size])
+			if err != nil {
+				return err
+			}
+			for _, n := range nodes {
+				children, err := childrenOfNode(n)
+				if err != nil {
+					return err
+				}
+				next = append(next, children...)
+			}
+			missing = missing[size:]
+		}
+		pending = next
+	}
+
+	t.rootHash = rootHash
 	return nil
 }
 
 // SyncPath from rootHash to key node from other servers
 // Useful for verification quickly
 func (t *Trie) SyncPath(rootHash []byte, key []byte) error {
+	if len(rootHash) == 0 {
+		t.rootHash = nil
+		return nil
+	}
+
+	curRootHash := rootHash
+	curRoute := keyToRoute(key)
+	for {
+		if _, err := t.storage.Get(curRootHash); err != nil {
+			if _, err := t.syncNodes([][]byte{curRootHash}); err != nil {
+				return err
+			}
+		}
+		if len(curRoute) == 0 {
+			break
+		}
+
+		n, err := t.fetchNode(curRootHash)
+		if err != nil {
+			return err
+		}
+		flag, err := n.Type()
+		if err != nil {
+			return err
+		}
+		if flag == branch {
+			curRootHash = n.Val[curRoute[0]]
+			curRoute = curRoute[1:]
+		} else if flag == ext {
+			path := n.Val[1]
+			matchLen := prefixLen(path, curRoute)
+			if matchLen != len(path) {
+				break
+			}
+			curRootHash = n.Val[2]
+			curRoute = curRoute[matchLen:]
+		} else {
+			break
+		}
+		if len(curRootHash) == 0 {
+			break
+		}
+	}
+
+	t.rootHash = rootHash
 	return nil
 }
+
+// syncNodes fetches the nodes from other servers, verifies and saves them.
+func (t *Trie) syncNodes(hashes [][]byte) ([]*node, error) {
+	if t.fetcher == nil {
+		return nil, ErrNoNodeFetcher
+	}
+	values, err := t.fetcher.FetchNodes(hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(hashes) {
+		return nil, ErrSyncNodeNotFound
+	}
+
+	nodes := make([]*node, len(hashes))
+	for idx, h := range hashes {
+		if values[idx] == nil || !bytes.Equal(hash.Sha3256(values[idx]), h) {
+			return nil, ErrSyncNodeNotFound
+		}
+		pb := new(triepb.Node)
+		if err := proto.Unmarshal(values[idx], pb); err != nil {
+			return nil, err
+		}
+		n := new(node)
+		if err := n.FromProto(pb); err != nil {
+			return nil, err
+		}
+		if err := t.storage.Put(h, values[idx]); err != nil {
+			return nil, err
+		}
+		nodes[idx] = n
+	}
+	return nodes, nil
+}
+
+// childrenOfNode return the hashes of the child nodes.
+func childrenOfNode(n *node) ([][]byte, error) {
+	flag, err := n.Type()
+	if err != nil {
+		return nil, err
+	}
+	children := [][]byte{}
+	switch flag {
+	case branch:
+		for _, v := range n.Val {
+			if len(v) > 0 {
+				children = append(children, v)
+			}
+		}
+	case ext:
+		children = append(children, n.Val[2])
+	}
+	return children, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package trie
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type storageFetcher struct {
+	storage storage.Storage
+	fetched int
+	corrupt bool
+}
+
+func (f *storageFetcher) FetchNodes(hashes [][]byte) ([][]byte, error) {
+	nodes := make([][]byte, len(hashes))
+	for idx, h := range hashes {
+		value, err := f.storage.Get(h)
+		if err != nil {
+			continue
+		}
+		if f.corrupt {
+			value = append([]byte{}, value...)
+			value[len(value)-1]++
+		}
+		nodes[idx] = value
+		f.fetched++
+	}
+	return nodes, nil
+}
+
+func TestTrie_SyncTrie(t *testing.T) {
+	stor1, _ := storage.NewMemoryStorage()
+	src, _ := NewTrie(nil, stor1, false)
+	for i := 0; i < 1000; i++ {
+		key := hash.Sha3256([]byte(strconv.Itoa(i)))
+		_, err := src.Put(key, []byte(strconv.Itoa(i)))
+		assert.Nil(t, err)
+	}
+
+	stor2, _ := storage.NewMemoryStorage()
+	dst, _ := NewTrie(nil, stor2, false)
+	assert.Equal(t, ErrNoNodeFetcher, dst.SyncTrie(src.RootHash()))
+
+	fetcher := &storageFetcher{storage: stor1}
+	dst.SetNodeFetcher(fetcher)
+	assert.Nil(t, dst.SyncTrie(src.RootHash()))
+	assert.Equal(t, src.RootHash(), dst.RootHash())
+	for i := 0; i < 1000; i++ {
+		value, err := dst.Get(hash.Sha3256([]byte(strconv.Itoa(i))))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(strconv.Itoa(i)), value)
+	}
+
+	// nothing is fetched again.
+	fetched := fetcher.fetched
+	assert.Nil(t, dst.SyncTrie(src.RootHash()))
+	assert.Equal(t, fetched, fetcher.fetched)
+
+	// the nodes must match their hashes.
+	stor3, _ := storage.NewMemoryStorage()
+	bad, _ := NewTrie(nil, stor3, false)
+	bad.SetNodeFetcher(&storageFetcher{storage: stor1, corrupt: true})
+	assert.Equal(t, ErrSyncNodeNotFound, bad.SyncTrie(src.RootHash()))
+}
+
+func TestTrie_SyncPath(t *testing.T) {
+	stor1, _ := storage.NewMemoryStorage()
+	src, _ := NewTrie(nil, stor1, false)
+	for i := 0; i < 100; i++ {
+		key := hash.Sha3256([]byte(strconv.Itoa(i)))
+		_, err := src.Put(key, []byte(strconv.Itoa(i)))
+		assert.Nil(t, err)
+	}
+
+	stor2, _ := storage.NewMemoryStorage()
+	dst, _ := NewTrie(nil, stor2, false)
+	dst.SetNodeFetcher(&storageFetcher{storage: stor1})
+
+	// only the root.
+	assert.Nil(t, dst.SyncPath(src.RootHash(), nil))
+	_, err := NewTrie(src.RootHash(), stor2, false)
+	assert.Nil(t, err)
+
+	key := hash.Sha3256([]byte("7"))
+	assert.Nil(t, dst.SyncPath(src.RootHash(), key))
+	value, err := dst.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("7"), value)
+
+	_, err = dst.Get(hash.Sha3256([]byte("8")))
+	assert.NotNil(t, err)
+}
@@ -121,6 +121,7 @@ type Trie struct {
 	storage       storage.Storage
 	changelog     []*Entry
 	needChangelog bool
+	fetcher       NodeFetcher
 }
 
 // CreateNode in trie
@@ -683,3 +683,55 @@ func TestTrie_Iterator(t *testing.T) {
 	it, err = tr.Iterator(HashDomainsPrefix("b"))
 	assert.NotNil(t, err)
 }
+
+func TestTrie_ProvedValue(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage, false)
+	_, err := tr.Put([]byte("key1"), []byte("value1"))
+	assert.Nil(t, err)
+	_, err = tr.Put([]byte("key2"), []byte("value2"))
+	assert.Nil(t, err)
+
+	proof, err := tr.Prove([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Nil(t, tr.Verify(tr.RootHash(), []byte("key1"), proof))
+	val, err := ProvedValue(proof)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value1"), val)
+
+	// a truncated proof is verified but proves nothing.
+	assert.Nil(t, tr.Verify(tr.RootHash(), []byte("key1"), proof[:len(proof)-1]))
+	_, err = ProvedValue(proof[:len(proof)-1])
+	assert.Equal(t, ErrNotFound, err)
+
+	// a proof longer than the key is rejected.
+	branch := make([][]byte, 16)
+	assert.NotNil(t, tr.Verify(tr.RootHash(), nil, MerkleProof{branch}))
+}
+
+func TestTrie_ProveExclusion(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage, false)
+	for _, key := range []string{"key1", "key2", "other"} {
+		_, err := tr.Put([]byte(key), []byte("value"))
+		assert.Nil(t, err)
+	}
+
+	// diverging at a branch, an extension or a leaf.
+	for _, key := range []string{"key3", "kez1", "otheq", "o"} {
+		proof, err := tr.ProveExclusion([]byte(key))
+		assert.Nil(t, err)
+		assert.Nil(t, tr.VerifyExclusion(tr.RootHash(), []byte(key), proof))
+		assert.Equal(t, ErrWrongExclusionProof, tr.VerifyExclusion(tr.RootHash(), []byte("key1"), proof))
+	}
+
+	_, err := tr.ProveExclusion([]byte("key1"))
+	assert.Equal(t, ErrKeyExists, err)
+
+	// the inclusion proof, a truncated or an empty one proves nothing.
+	proof, err := tr.Prove([]byte("key1"))
+	assert.Nil(t, err)
+	assert.Equal(t, ErrWrongExclusionProof, tr.VerifyExclusion(tr.RootHash(), []byte("key1"), proof))
+	assert.Equal(t, ErrWrongExclusionProof, tr.VerifyExclusion(tr.RootHash(), []byte("key3"), proof[:1]))
+	assert.Equal(t, ErrWrongExclusionProof, tr.VerifyExclusion(tr.RootHash(), []byte("key3"), nil))
+}
@@ -0,0 +1,454 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Dev is the instant-seal consensus of the local dev chains, a single miner seals
+// a block once transactions arrive, or every interval.
+type Dev struct {
+	quitCh chan bool
+
+	chain    *core.BlockChain
+	am       core.AccountManager
+	eventSub *core.EventSubscriber
+
+	coinbase *core.Address
+	miner    *core.Address
+	proposer *core.Address
+
+	sealInterval int64
+
+	// sealMu guards the sealing and the clock offset.
+	sealMu     sync.Mutex
+	timeOffset int64
+
+	enable  bool
+	pending bool
+}
+
+// NewDev create Dev instance.
+func NewDev() *Dev {
+	dev := &Dev{
+		quitCh:   make(chan bool, 5),
+		eventSub: core.NewEventSubscriber(1024, []string{core.TopicPendingTransaction}),
+		enable:   false,
+		pending:  true,
+	}
+	return dev
+}
+
+// Setup a dev consensus handler
+func (dev *Dev) Setup(neblet core.Neblet) error {
+	dev.chain = neblet.BlockChain()
+	dev.am = neblet.AccountManager()
+
+	proposer, err := genesisProposer(neblet.Genesis())
+	if err != nil {
+		return err
+	}
+	dev.proposer = proposer
+
+	chainConfig := neblet.Config().Chain
+	dev.sealInterval = int64(chainConfig.GetDev().GetSealInterval())
+	if chainConfig.StartMine {
+		coinbase, err := core.AddressParse(chainConfig.Coinbase)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return err
+		}
+		miner, err := core.AddressParse(chainConfig.Miner)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": chainConfig.Miner,
+				"err":     err,
+			}).Error("Failed to parse miner address.")
+			return err
+		}
+		if !miner.Equals(proposer) {
+			logging.CLog().WithFields(logrus.Fields{
+				"miner":    miner,
+				"expected": proposer,
+			}).Error("Miner is not the proposer of dev chain.")
+			return ErrInvalidDevMiner
+		}
+		dev.coinbase = coinbase
+		dev.miner = miner
+	}
+	return nil
+}
+
+// Start start dev service.
+func (dev *Dev) Start() {
+	logging.CLog().WithFields(logrus.Fields{
+		"interval": dev.sealInterval,
+	}).Info("Starting Dev Mining...")
+	if dev.sealInterval == 0 {
+		dev.chain.EventEmitter().Register(dev.eventSub)
+	}
+	go dev.blockLoop()
+}
+
+// Stop stop dev service.
+func (dev *Dev) Stop() {
+	logging.CLog().Info("Stopping Dev Mining...")
+	if dev.sealInterval == 0 {
+		dev.chain.EventEmitter().Deregister(dev.eventSub)
+	}
+	dev.DisableMining()
+	dev.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (dev *Dev) EnableMining(passphrase string) error {
+	if dev.miner == nil {
+		return ErrInvalidDevMiner
+	}
+	if err := dev.am.Unlock(dev.miner, []byte(passphrase), DefaultMaxUnlockDuration); err != nil {
+		return err
+	}
+	dev.enable = true
+	logging.CLog().Info("Enabled Dev Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (dev *Dev) DisableMining() error {
+	if dev.miner == nil {
+		return nil
+	}
+	if err := dev.am.Lock(dev.miner); err != nil {
+		return err
+	}
+	dev.enable = false
+	logging.CLog().Info("Disable Dev Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (dev *Dev) Enable() bool {
+	return dev.enable
+}
+
+// Pending return if consensus can do mining now
+func (dev *Dev) Pending() bool {
+	return dev.pending
+}
+
+// SuspendMining pend dev mining
+func (dev *Dev) SuspendMining() {
+	logging.CLog().Info("Suspended Dev Mining.")
+	dev.pending = true
+}
+
+// ResumeMining continue dev mining
+func (dev *Dev) ResumeMining() {
+	logging.CLog().Info("Resumed Dev Mining.")
+	dev.pending = false
+}
+
+// Serial return dynasty serial number, the dynasty of dev chains never changes.
+func (dev *Dev) Serial(timestamp int64) int64 {
+	return GenesisDynastySerial
+}
+
+// NumberOfBlocksInDynasty number of blocks in one dynasty
+func (dev *Dev) NumberOfBlocksInDynasty() uint64 {
+	return 1
+}
+
+// CheckTimeout check whether the block is timeout, the blocks of dev chains never expire.
+func (dev *Dev) CheckTimeout(block *core.Block) bool {
+	return false
+}
+
+// CheckDoubleMint if double mint exists, the single miner never does.
+func (dev *Dev) CheckDoubleMint(block *core.Block) bool {
+	return false
+}
+
+func less(a *core.Block, b *core.Block) bool {
+	if a.Height() != b.Height() {
+		return a.Height() < b.Height()
+	}
+	return byteutils.Less(a.Hash(), b.Hash())
+}
+
+// ForkChoice select new tail
+func (dev *Dev) ForkChoice() error {
+	bc := dev.chain
+	tailBlock := bc.TailBlock()
+	newTailBlock := tailBlock
+	for _, v := range bc.DetachedTailBlocks() {
+		if less(newTailBlock, v) {
+			newTailBlock = v
+		}
+	}
+
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		return nil
+	}
+
+	if err := bc.SetTailBlock(newTailBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Debug("Failed to set new tail block.")
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+	return nil
+}
+
+// UpdateLIB update the latest irrversible block, the tail of dev chains is irreversible at once.
+func (dev *Dev) UpdateLIB(rversibleBlocks []byteutils.Hash) {
+	lib := dev.chain.LIB()
+	tail := dev.chain.TailBlock()
+	if tail.Hash().Equals(lib.Hash()) {
+		return
+	}
+	if err := dev.chain.StoreLIBHashToStorage(tail); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Debug("Failed to store latest irreversible block.")
+		return
+	}
+	dev.chain.SetLIB(tail)
+
+	e := &state.Event{
+		Topic: core.TopicLibBlock,
+		Data:  tail.String(),
+	}
+	dev.chain.EventEmitter().Trigger(e)
+}
+
+func verifyBlockSign(miner *core.Address, block *core.Block) error {
+	signer, err := core.RecoverSignerFromSignature(block.Alg(), block.Hash(), block.Signature())
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"err":    err,
+			"block":  block,
+		}).Debug("Failed to recover block's miner.")
+		return err
+	}
+	if !miner.Equals(signer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"miner":  miner,
+			"block":  block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockProposer
+	}
+	return nil
+}
+
+// VerifyBlock verify the block
+func (dev *Dev) VerifyBlock(block *core.Block) error {
+	return dev.VerifyBlockHeader(block)
+}
+
+// VerifyBlockHeader verify the header of the block
+func (dev *Dev) VerifyBlockHeader(block *core.Block) error {
+	if block.Timestamp() != block.ConsensusRoot().Timestamp {
+		return ErrInvalidBlockTimestamp
+	}
+	if !byteutils.Equal(dev.proposer.Bytes(), block.ConsensusRoot().Proposer) {
+		return ErrInvalidBlockProposer
+	}
+	if err := verifyBlockSign(dev.proposer, block); err != nil {
+		return err
+	}
+
+	// check block random
+	if core.RandomAvailableAtHeight(block.Height()) && !block.HasRandomSeed() {
+		logging.VLog().WithFields(logrus.Fields{
+			"blockHeight":      block.Height(),
+			"compatibleHeight": core.NebCompatibility.RandomAvailableHeight(),
+		}).Debug("No random found in block header.")
+		return core.ErrInvalidBlockRandom
+	}
+	return nil
+}
+
+// generateRandomSeed takes the hash of the VRF inputs as the seed, the proof is a placeholder never verified.
+func (dev *Dev) generateRandomSeed(block *core.Block) error {
+	ancestorHash, parentSeed, err := dev.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
+	if err != nil {
+		return err
+	}
+	seed := hash.Sha3256(ancestorHash, parentSeed)
+	block.SetRandomSeed(seed, seed)
+	return nil
+}
+
+// sealBlock seals a block on the tail, packing the pending transactions if collect.
+func (dev *Dev) sealBlock(collect bool) (*core.Block, error) {
+	dev.sealMu.Lock()
+	defer dev.sealMu.Unlock()
+
+	if !dev.enable {
+		return nil, ErrCannotMintWhenDisable
+	}
+	if dev.pending {
+		return nil, ErrCannotMintWhenPending
+	}
+
+	tail := dev.chain.TailBlock()
+	// the blocks sealed in the same second take the following ones.
+	timestamp := time.Now().Unix() + dev.timeOffset
+	if timestamp <= tail.Timestamp() {
+		timestamp = tail.Timestamp() + 1
+	}
+	consensusState, err := tail.WorldState().NextConsensusState(timestamp - tail.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := core.NewBlock(dev.chain.ChainID(), dev.coinbase, tail)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":     tail,
+			"coinbase": dev.coinbase,
+			"err":      err,
+		}).Error("Failed to create new block")
+		return nil, err
+	}
+	if core.RandomAvailableAtHeight(block.Height()) {
+		if err := dev.generateRandomSeed(block); err != nil {
+			return nil, err
+		}
+	}
+	block.WorldState().SetConsensusState(consensusState)
+	block.SetTimestamp(timestamp)
+	if collect {
+		block.CollectTransactions(time.Now().Unix()*SecondInMs + PackDurationInMs)
+	}
+	if err := block.Seal(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Error("Failed to seal new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if err := dev.am.SignBlock(dev.miner, block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"miner": dev.miner,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to sign new block")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+
+	if err := dev.chain.BlockPool().PushAndBroadcast(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to push new sealed block into block pool")
+		go block.ReturnTransactions()
+		return nil, err
+	}
+	if !dev.chain.TailBlock().Hash().Equals(block.Hash()) {
+		return nil, ErrAppendNewBlockFailed
+	}
+	dev.UpdateLIB(nil)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"block": block,
+		"txs":   len(block.Transactions()),
+	}).Info("Sealed new block")
+	return block, nil
+}
+
+// MineBlocks seals n empty blocks on the tail at once.
+func (dev *Dev) MineBlocks(n int) ([]*core.Block, error) {
+	if n <= 0 || n > MaxMineBlocks {
+		return nil, ErrInvalidMineBlocksCount
+	}
+	blocks := make([]*core.Block, 0, n)
+	for i := 0; i < n; i++ {
+		block, err := dev.sealBlock(false)
+		if err != nil {
+			return blocks, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// AdvanceTime moves the clock of the following blocks forward, returns the time of the chain.
+func (dev *Dev) AdvanceTime(seconds int64) (int64, error) {
+	if seconds <= 0 {
+		return 0, ErrInvalidAdvanceTime
+	}
+	dev.sealMu.Lock()
+	defer dev.sealMu.Unlock()
+
+	dev.timeOffset += seconds
+	logging.CLog().WithFields(logrus.Fields{
+		"seconds": seconds,
+		"offset":  dev.timeOffset,
+	}).Info("Advanced time of dev chain.")
+	return time.Now().Unix() + dev.timeOffset, nil
+}
+
+func (dev *Dev) blockLoop() {
+	logging.CLog().Info("Started Dev Mining.")
+
+	var sealCh <-chan time.Time
+	if dev.sealInterval > 0 {
+		sealCh = time.NewTicker(time.Duration(dev.sealInterval) * time.Second).C
+	}
+	for {
+		select {
+		case <-sealCh:
+			dev.sealBlock(true)
+		case <-dev.eventSub.EventChan():
+			// the transactions arrived together are packed by the first sealing.
+			if !dev.chain.TransactionPool().Empty() {
+				dev.sealBlock(true)
+			}
+		case <-dev.quitCh:
+			logging.CLog().Info("Stopped Dev Mining.")
+			return
+		}
+	}
+}
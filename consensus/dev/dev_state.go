@@ -0,0 +1,184 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// State carry context in dev consensus, the proposer is kept from genesis.
+type State struct {
+	timestamp int64
+	proposer  byteutils.Hash
+
+	dynastyTrie *trie.Trie // key: delegatee, val: delegatee
+}
+
+// NewState create a new dev state
+func (dev *Dev) NewState(root *consensuspb.ConsensusRoot, stor storage.Storage, needChangeLog bool) (state.ConsensusState, error) {
+	if root == nil {
+		root = &consensuspb.ConsensusRoot{}
+	}
+	dynastyTrie, err := trie.NewTrie(root.DynastyRoot, stor, needChangeLog)
+	if err != nil {
+		return nil, err
+	}
+
+	return &State{
+		timestamp: root.Timestamp,
+		proposer:  root.Proposer,
+
+		dynastyTrie: dynastyTrie,
+	}, nil
+}
+
+// genesisProposer returns the miner of the dev chain, the first member of the dynasty in genesis.
+func genesisProposer(conf *corepb.Genesis) (*core.Address, error) {
+	dynasty := conf.GetConsensus().GetDpos().GetDynasty()
+	if len(dynasty) == 0 {
+		return nil, ErrEmptyDynasty
+	}
+	return core.AddressParse(dynasty[0])
+}
+
+// GenesisConsensusState create a new genesis dev state
+func (dev *Dev) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genesis) (state.ConsensusState, error) {
+	proposer, err := genesisProposer(conf)
+	if err != nil {
+		return nil, err
+	}
+	dynastyTrie, err := trie.NewTrie(nil, chain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range conf.Consensus.Dpos.Dynasty {
+		member, err := core.AddressParse(addr)
+		if err != nil {
+			return nil, err
+		}
+		v := member.Bytes()
+		if _, err = dynastyTrie.Put(v, v); err != nil {
+			return nil, err
+		}
+	}
+	return &State{
+		timestamp: core.GenesisTimestamp,
+		proposer:  proposer.Bytes(),
+
+		dynastyTrie: dynastyTrie,
+	}, nil
+}
+
+func (ds *State) String() string {
+	return fmt.Sprintf(`{"timestamp": %d, "proposer": "%s", "dynasty": "%s"}`,
+		ds.timestamp,
+		ds.proposer.String(),
+		byteutils.Hex(ds.dynastyTrie.RootHash()),
+	)
+}
+
+// Replay a dev state
+func (ds *State) Replay(done state.ConsensusState) error {
+	state := done.(*State)
+	if _, err := ds.dynastyTrie.Replay(state.dynastyTrie); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone a dev state
+func (ds *State) Clone() (state.ConsensusState, error) {
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneDynastyTrie
+	}
+	return &State{
+		timestamp: ds.timestamp,
+		proposer:  ds.proposer,
+
+		dynastyTrie: dynastyTrie,
+	}, nil
+}
+
+// RootHash hash dev state
+func (ds *State) RootHash() *consensuspb.ConsensusRoot {
+	return &consensuspb.ConsensusRoot{
+		DynastyRoot: ds.dynastyTrie.RootHash(),
+		Timestamp:   ds.TimeStamp(),
+		Proposer:    ds.Proposer(),
+	}
+}
+
+// Dynasty return the current dynasty
+func (ds *State) Dynasty() ([]byteutils.Hash, error) {
+	members := []byteutils.Hash{}
+	iter, err := ds.dynastyTrie.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err != nil {
+		return members, nil
+	}
+	exist, _ := iter.Next()
+	for exist {
+		members = append(members, iter.Value())
+		exist, _ = iter.Next()
+	}
+	return members, nil
+}
+
+// DynastyRoot return the roothash of current dynasty
+func (ds *State) DynastyRoot() byteutils.Hash {
+	return ds.dynastyTrie.RootHash()
+}
+
+// Proposer return the current proposer
+func (ds *State) Proposer() byteutils.Hash {
+	return ds.proposer
+}
+
+// TimeStamp return the current timestamp
+func (ds *State) TimeStamp() int64 {
+	return ds.timestamp
+}
+
+// NextConsensusState return the new state after some seconds elapsed, any interval goes on dev chains.
+func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
+	if elapsedSecond <= 0 {
+		return nil, ErrInvalidBlockInterval
+	}
+	dynastyTrie, err := ds.dynastyTrie.Clone()
+	if err != nil {
+		return nil, ErrCloneDynastyTrie
+	}
+	return &State{
+		timestamp: ds.timestamp + elapsedSecond,
+		proposer:  ds.proposer,
+
+		dynastyTrie: dynastyTrie,
+	}, nil
+}
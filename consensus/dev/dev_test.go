@@ -0,0 +1,138 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockDevNeb(t *testing.T) (*core.MockNeb, *Dev) {
+	dev := NewDev()
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dev, nil)
+	return neb, dev
+}
+
+func mockBlockFromNetwork(t *testing.T, block *core.Block) *core.Block {
+	pbBlock, err := block.ToProto()
+	assert.Nil(t, err)
+	bytes, err := proto.Marshal(pbBlock)
+	assert.Nil(t, err)
+	assert.Nil(t, proto.Unmarshal(bytes, pbBlock))
+	block = new(core.Block)
+	assert.Nil(t, block.FromProto(pbBlock))
+	return block
+}
+
+func TestDev_Setup(t *testing.T) {
+	neb, dev := mockDevNeb(t)
+	assert.Equal(t, core.MockDynasty[0], dev.proposer.String())
+	assert.Equal(t, dev.proposer.Bytes(), []byte(neb.BlockChain().GenesisBlock().ConsensusRoot().Proposer))
+
+	miner := neb.Config().Chain.Miner
+	neb.Config().Chain.Miner = core.MockDynasty[1]
+	assert.Equal(t, ErrInvalidDevMiner, dev.Setup(neb))
+	neb.Config().Chain.Miner = miner
+	assert.Nil(t, dev.Setup(neb))
+}
+
+func TestDev_MineBlocks(t *testing.T) {
+	neb, dev := mockDevNeb(t)
+	chain := neb.BlockChain()
+
+	// random is available from the 2nd block on local chains.
+	core.NebCompatibility = core.NewCompatibilityLocal()
+	defer func() { core.NebCompatibility = core.NewCompatibilityTestNet() }()
+
+	_, err := dev.MineBlocks(1)
+	assert.Equal(t, ErrCannotMintWhenDisable, err)
+	assert.Nil(t, dev.EnableMining("passphrase"))
+	_, err = dev.MineBlocks(1)
+	assert.Equal(t, ErrCannotMintWhenPending, err)
+	dev.ResumeMining()
+
+	_, err = dev.MineBlocks(0)
+	assert.Equal(t, ErrInvalidMineBlocksCount, err)
+	blocks, err := dev.MineBlocks(3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(blocks))
+	for i, block := range blocks {
+		assert.Equal(t, uint64(i+2), block.Height())
+		assert.Equal(t, 0, len(block.Transactions()))
+		if i > 0 {
+			assert.True(t, block.Timestamp() > blocks[i-1].Timestamp())
+		}
+	}
+	assert.Equal(t, blocks[2].Hash(), chain.TailBlock().Hash())
+	assert.Equal(t, blocks[2].Hash(), chain.LIB().Hash())
+
+	// the blocks are accepted by the other nodes.
+	block := mockBlockFromNetwork(t, blocks[2])
+	assert.True(t, block.HasRandomSeed())
+	assert.Nil(t, dev.VerifyBlock(block))
+}
+
+func TestDev_AdvanceTime(t *testing.T) {
+	neb, dev := mockDevNeb(t)
+	assert.Nil(t, dev.EnableMining("passphrase"))
+	dev.ResumeMining()
+
+	_, err := dev.AdvanceTime(0)
+	assert.Equal(t, ErrInvalidAdvanceTime, err)
+	now := time.Now().Unix()
+	timestamp, err := dev.AdvanceTime(3600)
+	assert.Nil(t, err)
+	assert.True(t, timestamp >= now+3600)
+
+	blocks, err := dev.MineBlocks(1)
+	assert.Nil(t, err)
+	assert.True(t, blocks[0].Timestamp() >= timestamp)
+	assert.Equal(t, blocks[0].Hash(), neb.BlockChain().TailBlock().Hash())
+}
+
+func TestDev_SealTransactions(t *testing.T) {
+	neb, dev := mockDevNeb(t)
+	chain := neb.BlockChain()
+	assert.Nil(t, dev.EnableMining("passphrase"))
+	dev.ResumeMining()
+
+	from, err := core.AddressParse(core.MockDynasty[0])
+	assert.Nil(t, err)
+	to, err := core.AddressParse(core.MockDynasty[1])
+	assert.Nil(t, err)
+	value, err := util.NewUint128FromInt(1)
+	assert.Nil(t, err)
+	tx, err := core.NewTransaction(chain.ChainID(), from, to, value, 1, core.TxPayloadBinaryType, nil, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	assert.Nil(t, neb.AccountManager().SignTransaction(from, tx))
+	assert.Nil(t, chain.TransactionPool().Push(tx))
+
+	block, err := dev.sealBlock(true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.True(t, chain.TransactionPool().Empty())
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dev
+
+import (
+	"errors"
+	"time"
+)
+
+// Consensus Related Constants
+const (
+	DefaultMaxUnlockDuration time.Duration = 1<<63 - 1
+	SecondInMs                             = int64(1000)
+	GenesisDynastySerial                   = 0
+
+	// transactions arrived in the duration are packed in a block.
+	PackDurationInMs = int64(500)
+
+	// MaxMineBlocks is the limit of the blocks mined in a call.
+	MaxMineBlocks = 1024
+)
+
+// Errors in dev consensus
+var (
+	ErrInvalidBlockTimestamp  = errors.New("invalid block timestamp, should be same as consensus's timestamp")
+	ErrInvalidBlockInterval   = errors.New("invalid block interval, should be later than the parent")
+	ErrInvalidBlockProposer   = errors.New("invalid block proposer")
+	ErrInvalidDevMiner        = errors.New("the miner of dev chain should be the first member of the dynasty in genesis")
+	ErrEmptyDynasty           = errors.New("the dynasty in genesis block is empty")
+	ErrCannotMintWhenPending  = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDisable  = errors.New("cannot mint block now, waiting for enable it again")
+	ErrAppendNewBlockFailed   = errors.New("failed to append new block to real chain")
+	ErrInvalidMineBlocksCount = errors.New("invalid count of blocks to mine")
+	ErrInvalidAdvanceTime     = errors.New("invalid seconds to advance, should be positive")
+	ErrCloneDynastyTrie       = errors.New("Failed to clone dynasty trie")
+)
@@ -21,8 +21,8 @@ package dpos
 import (
 	"time"
 
-	"github.com/nebulasio/go-nebulas/rpc"
-	rpcpb "github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/signer"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
 	"golang.org/x/net/context"
 
 	"github.com/nebulasio/go-nebulas/core/state"
@@ -30,6 +30,7 @@ import (
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -49,7 +50,7 @@ type Dpos struct {
 	coinbase               *core.Address
 	miner                  *core.Address
 	enableRemoteSignServer bool
-	remoteSignServer       string
+	remoteSigner           signer.Signer
 
 	slot *lru.Cache
 
@@ -100,7 +101,15 @@ func (dpos *Dpos) Setup(neblet core.Neblet) error {
 		dpos.coinbase = coinbase
 		dpos.miner = miner
 		dpos.enableRemoteSignServer = chainConfig.EnableRemoteSignServer
-		dpos.remoteSignServer = chainConfig.RemoteSignServer
+		if dpos.enableRemoteSignServer {
+			if dpos.remoteSigner, err = signer.NewSigner(chainConfig.RemoteSignServer, chainConfig.RemoteSigner); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"server": chainConfig.RemoteSignServer,
+					"err":    err,
+				}).Error("Failed to setup remote signer.")
+				return err
+			}
+		}
 	}
 
 	slot, err := lru.New(128)
@@ -121,6 +130,9 @@ func (dpos *Dpos) Start() {
 func (dpos *Dpos) Stop() {
 	logging.CLog().Info("Stopping Dpos Mining...")
 	dpos.DisableMining()
+	if dpos.remoteSigner != nil {
+		dpos.remoteSigner.Close()
+	}
 	dpos.quitCh <- true
 }
 
@@ -319,6 +331,16 @@ func (pod *Dpos) Serial(timestamp int64) int64 {
 
 // VerifyBlock verify the block
 func (dpos *Dpos) VerifyBlock(block *core.Block) error {
+	if err := dpos.VerifyBlockHeader(block); err != nil {
+		return err
+	}
+
+	dpos.slot.Add(block.Timestamp(), block)
+	return nil
+}
+
+// VerifyBlockHeader verify the header of the block without recording it
+func (dpos *Dpos) VerifyBlockHeader(block *core.Block) error {
 	tail := dpos.chain.TailBlock()
 	// check timestamp
 	if block.Timestamp() != block.ConsensusRoot().Timestamp {
@@ -382,12 +404,10 @@ func (dpos *Dpos) VerifyBlock(block *core.Block) error {
 		}).Debug("No random found in block header.")
 		return core.ErrInvalidBlockRandom
 	}
-
-	dpos.slot.Add(block.Timestamp(), block)
 	return nil
 }
 
-func (dpos *Dpos) generateRandomSeed(block *core.Block, adminService rpcpb.AdminServiceClient) error {
+func (dpos *Dpos) generateRandomSeed(block *core.Block, slot int64) error {
 
 	ancestorHash, parentSeed, err := dpos.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
 	if err != nil {
@@ -395,21 +415,21 @@ func (dpos *Dpos) generateRandomSeed(block *core.Block, adminService rpcpb.Admin
 	}
 
 	if dpos.enableRemoteSignServer == true {
-		if adminService == nil {
-			return ErrInvalidArgument
-		}
 		// generate VRF hash,proof
-		random, err := adminService.GenerateRandomSeed(
+		vrfSeed, vrfProof, err := dpos.remoteSigner.GenerateRandomSeed(
 			context.Background(),
-			&rpcpb.GenerateRandomSeedRequest{
+			&signerpb.GenerateRandomSeedRequest{
 				Address:      dpos.miner.String(),
 				ParentSeed:   parentSeed,
 				AncestorHash: ancestorHash,
+				Height:       block.Height(),
+				Slot:         slot,
+				ParentHash:   block.ParentHash(),
 			})
 		if err != nil {
 			return err
 		}
-		block.SetRandomSeed(random.VrfSeed, random.VrfProof)
+		block.SetRandomSeed(vrfSeed, vrfProof)
 		return nil
 	}
 
@@ -423,23 +443,24 @@ func (dpos *Dpos) generateRandomSeed(block *core.Block, adminService rpcpb.Admin
 	return nil
 }
 
-func (dpos *Dpos) remoteSignBlock(block *core.Block, adminService rpcpb.AdminServiceClient) error {
-	if adminService == nil {
-		return ErrInvalidArgument
-	}
+func (dpos *Dpos) remoteSignBlock(block *core.Block) error {
 	alg := keystore.SECP256K1
-	resp, err := adminService.SignHash(
+	pbBlock, err := core.NewCompactBlock(block).ToProto()
+	if err != nil {
+		return err
+	}
+	sign, err := dpos.remoteSigner.SignBlock(
 		context.Background(),
-		&rpcpb.SignHashRequest{
+		&signerpb.SignBlockRequest{
 			Address: dpos.miner.String(),
-			Hash:    block.Hash(),
 			Alg:     uint32(alg),
+			Block:   pbBlock.(*corepb.CompactBlock),
 		})
 	if err != nil {
 		return err
 	}
 
-	block.SetSignature(alg, resp.Data)
+	block.SetSignature(alg, sign)
 	return nil
 }
 
@@ -464,22 +485,8 @@ func (dpos *Dpos) newBlock(tail *core.Block, consensusState state.ConsensusState
 		return nil, err
 	}
 
-	var adminService rpcpb.AdminServiceClient
-	if dpos.enableRemoteSignServer == true {
-		conn, err := rpc.Dial(dpos.remoteSignServer)
-		defer func() {
-			if conn != nil {
-				conn.Close()
-			}
-		}()
-		if err != nil {
-			return nil, err
-		}
-		adminService = rpcpb.NewAdminServiceClient(conn)
-	}
-
 	if core.RandomAvailableAtHeight(block.Height()) {
-		err := dpos.generateRandomSeed(block, adminService)
+		err := dpos.generateRandomSeed(block, consensusState.TimeStamp())
 		if err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"block": block,
@@ -502,7 +509,7 @@ func (dpos *Dpos) newBlock(tail *core.Block, consensusState state.ConsensusState
 	}
 
 	if dpos.enableRemoteSignServer == true {
-		err = dpos.remoteSignBlock(block, adminService)
+		err = dpos.remoteSignBlock(block)
 	} else {
 		err = dpos.am.SignBlock(dpos.miner, block)
 	}
@@ -207,7 +207,13 @@ func TestVerifyBlock(t *testing.T) {
 	block.SetTimestamp(tail.Timestamp() + elapsedSecond)
 	block.Seal()
 	assert.Nil(t, manager.SignBlock(coinbase, block))
+	// a verified header is not recorded for the double mint check.
+	assert.Nil(t, dpos.VerifyBlockHeader(block))
+	_, recorded := dpos.(*Dpos).slot.Get(block.Timestamp())
+	assert.False(t, recorded)
 	assert.Nil(t, dpos.VerifyBlock(block))
+	_, recorded = dpos.(*Dpos).slot.Get(block.Timestamp())
+	assert.True(t, recorded)
 
 	elapsedSecond = (DynastySize*BlockIntervalInMs + DynastyIntervalInMs) / SecondInMs
 	consensusState, err = tail.WorldState().NextConsensusState(elapsedSecond)
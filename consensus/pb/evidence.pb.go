@@ -0,0 +1,66 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evidence.proto
+
+/*
+Package consensuspb is a generated protocol buffer package.
+
+It is generated from these files:
+	evidence.proto
+
+It has these top-level messages:
+	Evidence
+*/
+package consensuspb
+
+import (
+	fmt "fmt"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// Evidence is a pair of conflicting blocks signed by a miner in the same slot.
+type Evidence struct {
+	// the marshaled corepb.CompactBlock of the blocks.
+	Blocks [][]byte `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *Evidence) Reset()                    { *m = Evidence{} }
+func (m *Evidence) String() string            { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()               {}
+func (*Evidence) Descriptor() ([]byte, []int) { return fileDescriptorEvidence, []int{0} }
+
+func (m *Evidence) GetBlocks() [][]byte {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Evidence)(nil), "consensuspb.Evidence")
+}
+
+func init() { proto.RegisterFile("evidence.proto", fileDescriptorEvidence) }
+
+var fileDescriptorEvidence = []byte{
+	// 81 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4b, 0x2d, 0xcb, 0x4c,
+	0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0xce, 0xcf, 0x2b,
+	0x4e, 0xcd, 0x2b, 0x2e, 0x2d, 0x2e, 0x48, 0x52, 0x52, 0xe2, 0xe2, 0x70, 0x85, 0x4a, 0x0b, 0x89,
+	0x71, 0xb1, 0x25, 0xe5, 0xe4, 0x27, 0x67, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0x41,
+	0x79, 0x49, 0x6c, 0x60, 0x7d, 0xc6, 0x80, 0x01, 0x00, 0x15, 0x9f, 0x1d, 0x78, 0x49, 0x00, 0x00,
+	0x00,
+}
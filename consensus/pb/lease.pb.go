@@ -0,0 +1,119 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: lease.proto
+
+/*
+Package consensuspb is a generated protocol buffer package.
+
+It is generated from these files:
+	lease.proto
+
+It has these top-level messages:
+	MinerLease
+*/
+package consensuspb
+
+import (
+	fmt "fmt"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+// MinerLease is the heartbeat of the active one of the miners sharing a key.
+type MinerLease struct {
+	Miner  []byte `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// the latest block signed by the holder.
+	Slot      int64  `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Hash      []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Alg       uint32 `protobuf:"varint,6,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign      []byte `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *MinerLease) Reset()                    { *m = MinerLease{} }
+func (m *MinerLease) String() string            { return proto.CompactTextString(m) }
+func (*MinerLease) ProtoMessage()               {}
+func (*MinerLease) Descriptor() ([]byte, []int) { return fileDescriptorLease, []int{0} }
+
+func (m *MinerLease) GetMiner() []byte {
+	if m != nil {
+		return m.Miner
+	}
+	return nil
+}
+
+func (m *MinerLease) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *MinerLease) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *MinerLease) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MinerLease) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *MinerLease) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *MinerLease) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MinerLease)(nil), "consensuspb.MinerLease")
+}
+
+func init() { proto.RegisterFile("lease.proto", fileDescriptorLease) }
+
+var fileDescriptorLease = []byte{
+	// 169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x8e, 0x4d, 0x0a, 0xc2, 0x30,
+	0x10, 0x46, 0x89, 0xfd, 0x91, 0x4e, 0x15, 0x64, 0x10, 0x99, 0x85, 0x8b, 0xe0, 0x2a, 0x2b, 0x37,
+	0x5e, 0x43, 0x37, 0xb9, 0x41, 0xaa, 0xa1, 0x2d, 0xa4, 0x49, 0xe9, 0xc4, 0x2b, 0x79, 0x4e, 0x49,
+	0x2a, 0xb8, 0x7b, 0x6f, 0xf8, 0x78, 0x0c, 0xb4, 0xce, 0x1a, 0xb6, 0xd7, 0x79, 0x09, 0x31, 0x60,
+	0xfb, 0x0c, 0x9e, 0xad, 0xe7, 0x37, 0xcf, 0xdd, 0xe5, 0x23, 0x00, 0x1e, 0xa3, 0xb7, 0xcb, 0x3d,
+	0x2d, 0xf0, 0x08, 0xd5, 0x94, 0x8c, 0x84, 0x14, 0x6a, 0xa7, 0x57, 0xc1, 0x13, 0xd4, 0x43, 0x70,
+	0x2f, 0xbb, 0xd0, 0x46, 0x0a, 0xd5, 0xe8, 0x9f, 0x21, 0x42, 0xc9, 0x2e, 0x44, 0x2a, 0xa4, 0x50,
+	0x85, 0xce, 0x9c, 0x6e, 0x83, 0xe1, 0x81, 0xca, 0x1c, 0xc8, 0x8c, 0x67, 0x68, 0xe2, 0x38, 0x59,
+	0x8e, 0x66, 0x9a, 0xa9, 0xca, 0xe3, 0xff, 0x01, 0x0f, 0x50, 0x18, 0xd7, 0x53, 0x2d, 0x85, 0xda,
+	0xeb, 0x84, 0xb9, 0x3b, 0xf6, 0x9e, 0xb6, 0x6b, 0x23, 0x71, 0x57, 0xe7, 0xe7, 0x6f, 0xdf, 0x01,
+	0x00, 0xf8, 0x9b, 0x7e, 0xd9, 0xcb, 0x00, 0x00, 0x00,
+}
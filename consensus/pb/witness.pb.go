@@ -83,20 +83,157 @@ func (m *Witness) GetSign() []byte {
 	return nil
 }
 
+// AggregatedWitness is the witnesses of the same blocks combined, the signs are
+// in the order of the signers marked in the bitmap of the dynasty members.
+type AggregatedWitness struct {
+	Hash                 [][]byte `protobuf:"bytes,1,rep,name=hash,proto3" json:"hash,omitempty"`
+	Serial               int64    `protobuf:"varint,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	Bitmap               []byte   `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	Alg                  uint32   `protobuf:"varint,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	Signs                [][]byte `protobuf:"bytes,5,rep,name=signs,proto3" json:"signs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregatedWitness) Reset()         { *m = AggregatedWitness{} }
+func (m *AggregatedWitness) String() string { return proto.CompactTextString(m) }
+func (*AggregatedWitness) ProtoMessage()    {}
+func (*AggregatedWitness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a1e9059dbd106eb, []int{1}
+}
+func (m *AggregatedWitness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatedWitness.Unmarshal(m, b)
+}
+func (m *AggregatedWitness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatedWitness.Marshal(b, m, deterministic)
+}
+func (m *AggregatedWitness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedWitness.Merge(m, src)
+}
+func (m *AggregatedWitness) XXX_Size() int {
+	return xxx_messageInfo_AggregatedWitness.Size(m)
+}
+func (m *AggregatedWitness) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedWitness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedWitness proto.InternalMessageInfo
+
+func (m *AggregatedWitness) GetHash() [][]byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *AggregatedWitness) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *AggregatedWitness) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+func (m *AggregatedWitness) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *AggregatedWitness) GetSigns() [][]byte {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+// FinalityCertificate is the witnesses finalizing a block.
+type FinalityCertificate struct {
+	Hash                 []byte               `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               uint64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Witnesses            []*AggregatedWitness `protobuf:"bytes,3,rep,name=witnesses,proto3" json:"witnesses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FinalityCertificate) Reset()         { *m = FinalityCertificate{} }
+func (m *FinalityCertificate) String() string { return proto.CompactTextString(m) }
+func (*FinalityCertificate) ProtoMessage()    {}
+func (*FinalityCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a1e9059dbd106eb, []int{2}
+}
+func (m *FinalityCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityCertificate.Unmarshal(m, b)
+}
+func (m *FinalityCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityCertificate.Marshal(b, m, deterministic)
+}
+func (m *FinalityCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCertificate.Merge(m, src)
+}
+func (m *FinalityCertificate) XXX_Size() int {
+	return xxx_messageInfo_FinalityCertificate.Size(m)
+}
+func (m *FinalityCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCertificate proto.InternalMessageInfo
+
+func (m *FinalityCertificate) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *FinalityCertificate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FinalityCertificate) GetWitnesses() []*AggregatedWitness {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Witness)(nil), "consensuspb.Witness")
+	proto.RegisterType((*AggregatedWitness)(nil), "consensuspb.AggregatedWitness")
+	proto.RegisterType((*FinalityCertificate)(nil), "consensuspb.FinalityCertificate")
 }
 
 func init() { proto.RegisterFile("witness.proto", fileDescriptor_6a1e9059dbd106eb) }
 
 var fileDescriptor_6a1e9059dbd106eb = []byte{
-	// 123 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2d, 0xcf, 0x2c, 0xc9,
-	0x4b, 0x2d, 0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0xce, 0xcf, 0x2b, 0x4e,
-	0xcd, 0x2b, 0x2e, 0x2d, 0x2e, 0x48, 0x52, 0x8a, 0xe5, 0x62, 0x0f, 0x87, 0xc8, 0x0a, 0x49, 0x70,
-	0xb1, 0x43, 0x15, 0x4a, 0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x04, 0xc1, 0xb8, 0x42, 0x42, 0x5c, 0x2c,
-	0x19, 0x89, 0xc5, 0x19, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x3c, 0x41, 0x60, 0xb6, 0x90, 0x00, 0x17,
-	0x73, 0x62, 0x4e, 0xba, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x6f, 0x10, 0x88, 0x09, 0x52, 0x55, 0x9c,
-	0x99, 0x9e, 0x27, 0xc1, 0x02, 0xd6, 0x0c, 0x66, 0x27, 0xb1, 0x81, 0xad, 0x34, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa6, 0x6a, 0xd7, 0x4d, 0x83, 0x00, 0x00, 0x00,
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x95, 0xeb, 0xb4, 0x15, 0xd7, 0x54, 0x02, 0x83, 0x90, 0x27, 0x64, 0x65, 0xf2, 0x94, 0x01,
+	0x56, 0x16, 0x84, 0xc4, 0x07, 0x78, 0xe9, 0xc4, 0xe0, 0x14, 0xe3, 0x9c, 0x14, 0x9c, 0x28, 0x67,
+	0x84, 0x98, 0xfa, 0xeb, 0x28, 0xae, 0xab, 0x46, 0x74, 0x7b, 0xcf, 0xbe, 0x7b, 0xef, 0xdd, 0x83,
+	0xed, 0x0f, 0xc6, 0xe0, 0x88, 0xea, 0x61, 0xec, 0x63, 0x2f, 0x36, 0xfb, 0x3e, 0x90, 0x0b, 0xf4,
+	0x4d, 0x43, 0x53, 0xbd, 0xc3, 0x7a, 0x77, 0xfc, 0x15, 0x12, 0xd6, 0x79, 0x50, 0x32, 0xc5, 0x74,
+	0x69, 0x4e, 0x54, 0x08, 0x28, 0x5a, 0x4b, 0xad, 0x5c, 0x28, 0xae, 0x4b, 0x93, 0xb0, 0xb8, 0x06,
+	0x6e, 0x3b, 0x2f, 0xb9, 0x62, 0x7a, 0x6b, 0x26, 0x38, 0x4d, 0x11, 0xfa, 0x20, 0x8b, 0xb4, 0x9c,
+	0x70, 0x75, 0x80, 0x9b, 0x17, 0xef, 0x47, 0xe7, 0x6d, 0x74, 0x1f, 0xbb, 0x7f, 0x72, 0x6c, 0x26,
+	0x77, 0x0f, 0x2b, 0x72, 0x23, 0xda, 0x4e, 0x2e, 0x14, 0xd3, 0xdc, 0x64, 0x36, 0xbd, 0x37, 0x18,
+	0xbf, 0xec, 0x90, 0x9c, 0x4a, 0x93, 0xd9, 0xc9, 0xbe, 0x38, 0xdb, 0xdf, 0xc1, 0x72, 0xb2, 0x24,
+	0xb9, 0x4c, 0xb2, 0x47, 0x52, 0x1d, 0xe0, 0xf6, 0x0d, 0x83, 0xed, 0x30, 0xfe, 0xbe, 0xba, 0x31,
+	0xe2, 0x27, 0xee, 0x6d, 0x74, 0xb3, 0x08, 0x6c, 0x1e, 0xa1, 0x75, 0xe8, 0xdb, 0x98, 0x22, 0x14,
+	0x26, 0x33, 0xf1, 0x0c, 0x57, 0xb9, 0x08, 0x47, 0x92, 0x2b, 0xae, 0x37, 0x8f, 0x0f, 0xf5, 0xac,
+	0xc3, 0xfa, 0xe2, 0x42, 0x73, 0x5e, 0x68, 0x56, 0xa9, 0xf4, 0xa7, 0xbf, 0x01, 0x00, 0xc6, 0x1b,
+	0x9d, 0x87, 0x85, 0x01, 0x00, 0x00,
 }
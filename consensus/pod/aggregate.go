@@ -0,0 +1,268 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors of witness aggregation
+var (
+	ErrInvalidProtoToAggregatedWitness   = errors.New("protobuf message cannot be converted into AggregatedWitness")
+	ErrInvalidProtoToFinalityCertificate = errors.New("protobuf message cannot be converted into FinalityCertificate")
+	ErrInvalidWitnessBitmap              = errors.New("invalid witness bitmap, not matching the signs or the dynasty")
+	ErrInvalidWitnessSerial              = errors.New("invalid witness serial, not matching the witnessed blocks")
+	ErrWitnessBlocksNotFound             = errors.New("cannot find the witnessed blocks")
+	ErrBlockNotFinalized                 = errors.New("the block is not finalized on canonical chain")
+	ErrCertificateNotFound               = errors.New("cannot find the finality certificate of the block")
+)
+
+// Finality certificate constants
+const (
+	finalityCertificatePrefix = "pod_certificate_"
+
+	// MaxCertificateSearch the max number of the descendants searched for the certificate of a block.
+	MaxCertificateSearch = 1024
+)
+
+// AggregatedWitness is the witnesses of the same blocks combined,
+// the signers are the members of the dynasty serial at the indexes.
+type AggregatedWitness struct {
+	blockHashs []byteutils.Hash
+	serial     int64
+
+	// sign by the index of signer
+	alg   keystore.Algorithm
+	signs map[int]byteutils.Hash
+}
+
+func newAggregatedWitness(serial int64, hashs []byteutils.Hash, alg keystore.Algorithm) *AggregatedWitness {
+	return &AggregatedWitness{
+		blockHashs: hashs,
+		serial:     serial,
+		alg:        alg,
+		signs:      make(map[int]byteutils.Hash),
+	}
+}
+
+// Hash return the hash signed by the witnesses, same as the Witness of the blocks.
+func (a *AggregatedWitness) Hash() byteutils.Hash {
+	w := &Witness{blockHashs: a.blockHashs}
+	return w.Hash()
+}
+
+func (a *AggregatedWitness) add(index int, sign byteutils.Hash) bool {
+	if _, ok := a.signs[index]; ok {
+		return false
+	}
+	a.signs[index] = sign
+	return true
+}
+
+// merge the signs of the same blocks, return the indexes of the new signers.
+func (a *AggregatedWitness) merge(other *AggregatedWitness) []int {
+	added := []int{}
+	if a.serial != other.serial || a.alg != other.alg || !a.Hash().Equals(other.Hash()) {
+		return added
+	}
+	for _, idx := range other.indexes() {
+		if a.add(idx, other.signs[idx]) {
+			added = append(added, idx)
+		}
+	}
+	return added
+}
+
+func (a *AggregatedWitness) clone() *AggregatedWitness {
+	c := newAggregatedWitness(a.serial, a.blockHashs, a.alg)
+	for idx, sign := range a.signs {
+		c.signs[idx] = sign
+	}
+	return c
+}
+
+func (a *AggregatedWitness) indexes() []int {
+	indexes := make([]int, 0, len(a.signs))
+	for idx := range a.signs {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// witness return the witness of the signer at the index, as the legacy nodes receive it.
+func (a *AggregatedWitness) witness(members []byteutils.Hash, index int) *Witness {
+	return &Witness{
+		witness:    members[index],
+		blockHashs: a.blockHashs,
+		alg:        a.alg,
+		sign:       a.signs[index],
+	}
+}
+
+func (a *AggregatedWitness) contains(hash byteutils.Hash) bool {
+	for _, v := range a.blockHashs {
+		if v.Equals(hash) {
+			return true
+		}
+	}
+	return false
+}
+
+// signers verify the signs are signed by the members at the indexes and return the signers.
+func (a *AggregatedWitness) signers(members []byteutils.Hash) ([]byteutils.Hash, error) {
+	hash := a.Hash()
+	signers := []byteutils.Hash{}
+	for _, idx := range a.indexes() {
+		if idx >= len(members) {
+			return nil, ErrInvalidWitnessBitmap
+		}
+		signer, err := core.RecoverSignerFromSignature(a.alg, hash, a.signs[idx])
+		if err != nil {
+			return nil, err
+		}
+		if !members[idx].Equals(signer.Bytes()) {
+			return nil, ErrInvalidWitnessSign
+		}
+		signers = append(signers, members[idx])
+	}
+	return signers, nil
+}
+
+// ToProto converts domain AggregatedWitness to proto AggregatedWitness
+func (a *AggregatedWitness) ToProto() (proto.Message, error) {
+	hashs := make([][]byte, len(a.blockHashs))
+	for k, v := range a.blockHashs {
+		hashs[k] = v
+	}
+	indexes := a.indexes()
+	bitmap := []byte{}
+	signs := make([][]byte, len(indexes))
+	for k, idx := range indexes {
+		for len(bitmap) <= idx/8 {
+			bitmap = append(bitmap, 0)
+		}
+		bitmap[idx/8] |= 1 << uint(idx%8)
+		signs[k] = a.signs[idx]
+	}
+	return &consensuspb.AggregatedWitness{
+		Hash:   hashs,
+		Serial: a.serial,
+		Bitmap: bitmap,
+		Alg:    uint32(a.alg),
+		Signs:  signs,
+	}, nil
+}
+
+// FromProto converts proto AggregatedWitness to domain AggregatedWitness
+func (a *AggregatedWitness) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*consensuspb.AggregatedWitness); ok {
+		if msg != nil {
+			alg := keystore.Algorithm(msg.Alg)
+			if err := crypto.CheckAlgorithm(alg); err != nil {
+				return err
+			}
+			hashs := make([]byteutils.Hash, len(msg.Hash))
+			for k, v := range msg.Hash {
+				hashs[k] = v
+			}
+			// the bitmap ends with the last signer, the signers are bounded by the dynasty in signers.
+			if len(msg.Bitmap) > 0 && msg.Bitmap[len(msg.Bitmap)-1] == 0 {
+				return ErrInvalidWitnessBitmap
+			}
+			signs := make(map[int]byteutils.Hash)
+			for idx := 0; idx < len(msg.Bitmap)*8; idx++ {
+				if msg.Bitmap[idx/8]&(1<<uint(idx%8)) == 0 {
+					continue
+				}
+				if len(signs) >= len(msg.Signs) {
+					return ErrInvalidWitnessBitmap
+				}
+				signs[idx] = msg.Signs[len(signs)]
+			}
+			if len(signs) != len(msg.Signs) {
+				return ErrInvalidWitnessBitmap
+			}
+			a.blockHashs = hashs
+			a.serial = msg.Serial
+			a.alg = alg
+			a.signs = signs
+			return nil
+		}
+		return ErrInvalidProtoToAggregatedWitness
+	}
+	return ErrInvalidProtoToAggregatedWitness
+}
+
+// FinalityCertificate is the witnesses finalizing a block
+type FinalityCertificate struct {
+	hash      byteutils.Hash
+	height    uint64
+	witnesses []*AggregatedWitness
+}
+
+// ToProto converts domain FinalityCertificate to proto FinalityCertificate
+func (c *FinalityCertificate) ToProto() (proto.Message, error) {
+	witnesses := make([]*consensuspb.AggregatedWitness, len(c.witnesses))
+	for k, v := range c.witnesses {
+		msg, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		witnesses[k] = msg.(*consensuspb.AggregatedWitness)
+	}
+	return &consensuspb.FinalityCertificate{
+		Hash:      c.hash,
+		Height:    c.height,
+		Witnesses: witnesses,
+	}, nil
+}
+
+// FromProto converts proto FinalityCertificate to domain FinalityCertificate
+func (c *FinalityCertificate) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*consensuspb.FinalityCertificate); ok {
+		if msg != nil {
+			witnesses := make([]*AggregatedWitness, len(msg.Witnesses))
+			for k, v := range msg.Witnesses {
+				witnesses[k] = new(AggregatedWitness)
+				if err := witnesses[k].FromProto(v); err != nil {
+					return err
+				}
+			}
+			c.hash = msg.Hash
+			c.height = msg.Height
+			c.witnesses = witnesses
+			return nil
+		}
+		return ErrInvalidProtoToFinalityCertificate
+	}
+	return ErrInvalidProtoToFinalityCertificate
+}
+
+func finalityCertificateKey(hash byteutils.Hash) []byte {
+	return append([]byte(finalityCertificatePrefix), hash...)
+}
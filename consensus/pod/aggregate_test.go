@@ -0,0 +1,218 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockWitnessSigners(t *testing.T, n int) ([]byteutils.Hash, []keystore.Signature) {
+	members := []byteutils.Hash{}
+	signatures := []keystore.Signature{}
+	for i := 0; i < n; i++ {
+		priv := secp256k1.GeneratePrivateKey()
+		pub, err := priv.PublicKey().Encoded()
+		assert.Nil(t, err)
+		addr, err := core.NewAddressFromPublicKey(pub)
+		assert.Nil(t, err)
+		signature, err := crypto.NewSignature(keystore.SECP256K1)
+		assert.Nil(t, err)
+		assert.Nil(t, signature.InitSign(priv))
+		members = append(members, addr.Bytes())
+		signatures = append(signatures, signature)
+	}
+	return members, signatures
+}
+
+func TestAggregatedWitness(t *testing.T) {
+	members, signatures := mockWitnessSigners(t, 10)
+	hashs := []byteutils.Hash{[]byte("block1"), []byte("block2")}
+
+	aggregate := newAggregatedWitness(1, hashs, keystore.SECP256K1)
+	other := newAggregatedWitness(1, hashs, keystore.SECP256K1)
+	for _, idx := range []int{0, 3, 9} {
+		sign, err := signatures[idx].Sign(aggregate.Hash())
+		assert.Nil(t, err)
+		if idx == 9 {
+			other.add(idx, sign)
+		} else {
+			assert.True(t, aggregate.add(idx, sign))
+			assert.False(t, aggregate.add(idx, sign))
+			other.add(idx, sign)
+		}
+	}
+	assert.Equal(t, []int{9}, aggregate.merge(other))
+	assert.Equal(t, []int{}, aggregate.merge(other))
+	assert.Equal(t, []int{}, aggregate.merge(newAggregatedWitness(2, hashs, keystore.SECP256K1)))
+	assert.Equal(t, []int{0, 3, 9}, aggregate.indexes())
+	assert.True(t, aggregate.contains([]byte("block2")))
+	assert.False(t, aggregate.contains([]byte("block3")))
+
+	signers, err := aggregate.signers(members)
+	assert.Nil(t, err)
+	assert.Equal(t, []byteutils.Hash{members[0], members[3], members[9]}, signers)
+	_, err = aggregate.signers(members[:9])
+	assert.Equal(t, ErrInvalidWitnessBitmap, err)
+	_, err = aggregate.signers(append([]byteutils.Hash{members[1]}, members[1:]...))
+	assert.Equal(t, ErrInvalidWitnessSign, err)
+
+	msg, err := aggregate.ToProto()
+	assert.Nil(t, err)
+	pbAggregate := msg.(*consensuspb.AggregatedWitness)
+	assert.Equal(t, []byte{0x09, 0x02}, pbAggregate.Bitmap)
+	received := new(AggregatedWitness)
+	assert.Nil(t, received.FromProto(pbAggregate))
+	assert.Equal(t, aggregate, received)
+
+	// the signs must match the bitmap.
+	pbAggregate.Bitmap = []byte{0x0b, 0x02}
+	assert.Equal(t, ErrInvalidWitnessBitmap, received.FromProto(pbAggregate))
+	pbAggregate.Bitmap = []byte{0x01}
+	assert.Equal(t, ErrInvalidWitnessBitmap, received.FromProto(pbAggregate))
+
+	// the bitmap ends with the last signer and is bounded by the dynasty.
+	pbAggregate.Signs = pbAggregate.Signs[:2]
+	pbAggregate.Bitmap = []byte{0x09, 0x00}
+	assert.Equal(t, ErrInvalidWitnessBitmap, received.FromProto(pbAggregate))
+	pbAggregate.Bitmap = []byte{0x01, 0x00, 0x00, 0x01}
+	assert.Nil(t, received.FromProto(pbAggregate))
+	_, err = received.signers(members)
+	assert.Equal(t, ErrInvalidWitnessBitmap, err)
+}
+
+func TestFinalityCertificate(t *testing.T) {
+	_, signatures := mockWitnessSigners(t, 2)
+	aggregate := newAggregatedWitness(1, []byteutils.Hash{[]byte("block1")}, keystore.SECP256K1)
+	for idx, v := range signatures {
+		sign, err := v.Sign(aggregate.Hash())
+		assert.Nil(t, err)
+		aggregate.add(idx, sign)
+	}
+	cert := &FinalityCertificate{
+		hash:      []byte("block1"),
+		height:    10,
+		witnesses: []*AggregatedWitness{aggregate},
+	}
+
+	msg, err := cert.ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(msg)
+	assert.Nil(t, err)
+	pbCert := new(consensuspb.FinalityCertificate)
+	assert.Nil(t, proto.Unmarshal(data, pbCert))
+	received := new(FinalityCertificate)
+	assert.Nil(t, received.FromProto(pbCert))
+	assert.Equal(t, cert, received)
+	assert.Equal(t, ErrInvalidProtoToFinalityCertificate, received.FromProto(&consensuspb.Witness{}))
+}
+
+func TestAcceptAggregatedWitness_serial(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+	block := mintPodBlock(t, neb, pod.params.DynastyIntervalInMs/SecondInMs)
+	assert.Equal(t, int64(1), pod.dynasty.serial(block.Timestamp()))
+
+	// the serial is the one of the latest witnessed block.
+	parent := pod.chain.GetBlock(block.ParentHash())
+	serial, err := pod.witnessSerial([]byteutils.Hash{parent.Hash(), block.Hash(), []byte("unknown")})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), serial)
+
+	aggregate := newAggregatedWitness(0, []byteutils.Hash{parent.Hash(), block.Hash()}, keystore.SECP256K1)
+	assert.Equal(t, ErrInvalidWitnessSerial, pod.acceptAggregatedWitness(aggregate, false))
+	aggregate = newAggregatedWitness(1, []byteutils.Hash{[]byte("unknown")}, keystore.SECP256K1)
+	assert.Equal(t, ErrWitnessBlocksNotFound, pod.acceptAggregatedWitness(aggregate, false))
+}
+
+type witnessRelayRecorder struct {
+	core.MockNetService
+	aggregates []*AggregatedWitness
+	witnesses  []*Witness
+}
+
+func (r *witnessRelayRecorder) BroadcastByCapability(capability string, name string, msg net.Serializable, fallbackName string, fallback net.Serializable, priority int) {
+	if msg != nil {
+		r.aggregates = append(r.aggregates, msg.(*AggregatedWitness))
+	}
+	if fallback != nil {
+		r.witnesses = append(r.witnesses, fallback.(*Witness))
+	}
+}
+
+func TestAcceptAggregatedWitness_relay(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+	block := mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs)
+	hashs := []byteutils.Hash{block.Hash()}
+	serial, err := pod.witnessSerial(hashs)
+	assert.Nil(t, err)
+	members, err := pod.dynasty.members(serial)
+	assert.Nil(t, err)
+
+	signed := func(indexes ...int) *AggregatedWitness {
+		aggregate := newAggregatedWitness(serial, hashs, keystore.SECP256K1)
+		for _, idx := range indexes {
+			addr, err := core.AddressParseFromBytes(members[idx])
+			assert.Nil(t, err)
+			assert.Nil(t, neb.AccountManager().Unlock(addr, []byte("passphrase"), time.Hour))
+			sign, err := neb.AccountManager().SignHash(addr, aggregate.Hash(), keystore.SECP256K1)
+			assert.Nil(t, err)
+			aggregate.add(idx, sign)
+		}
+		return aggregate
+	}
+	recorder := &witnessRelayRecorder{}
+	pod.ns = recorder
+
+	// the received aggregate is relayed as is, the legacy nodes get the witness of each new signer.
+	first := signed(0, 1)
+	assert.Nil(t, pod.acceptAggregatedWitness(first, false))
+	assert.Equal(t, []*AggregatedWitness{first}, recorder.aggregates)
+	assert.Equal(t, 2, len(recorder.witnesses))
+	assert.Equal(t, members[0], recorder.witnesses[0].witness)
+	assert.Nil(t, verifyWitnessSign(recorder.witnesses[1]))
+
+	// nothing is relayed without new signers.
+	assert.Nil(t, pod.acceptAggregatedWitness(signed(1), false))
+	assert.Equal(t, 1, len(recorder.aggregates))
+	assert.Equal(t, 2, len(recorder.witnesses))
+
+	second := signed(1, 2)
+	assert.Nil(t, pod.acceptAggregatedWitness(second, false))
+	assert.Equal(t, second, recorder.aggregates[1])
+	assert.Equal(t, 3, len(recorder.witnesses))
+	assert.Equal(t, members[2], recorder.witnesses[2].witness)
+
+	// the local witness is relayed in the merged aggregate.
+	assert.Nil(t, pod.acceptAggregatedWitness(signed(3), true))
+	assert.Equal(t, []int{0, 1, 2, 3}, recorder.aggregates[2].indexes())
+	assert.Equal(t, 4, len(recorder.witnesses))
+	assert.Equal(t, members[3], recorder.witnesses[3].witness)
+}
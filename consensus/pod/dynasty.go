@@ -38,6 +38,7 @@ import (
 type Dynasty struct {
 	chain *core.BlockChain
 
+	params           *Params
 	genesisTimestamp int64
 
 	//tries map[int64]*trie.Trie
@@ -45,13 +46,14 @@ type Dynasty struct {
 }
 
 // NewDynasty create dynasty
-func NewDynasty(neb core.Neblet) (*Dynasty, error) {
+func NewDynasty(neb core.Neblet, params *Params) (*Dynasty, error) {
 	tries, err := lru.New(128)
 	if err != nil {
 		return nil, err
 	}
 	dynasty := &Dynasty{
-		chain: neb.BlockChain(),
+		chain:  neb.BlockChain(),
+		params: params,
 		//tries: make(map[int64]*trie.Trie),
 		tries: tries,
 	}
@@ -65,7 +67,7 @@ func NewDynasty(neb core.Neblet) (*Dynasty, error) {
 func (d Dynasty) updateDynasty(dynasty *corepb.Dynasty) error {
 	for _, v := range dynasty.Candidate {
 		if len(v.Dynasty) > 0 {
-			if len(v.Dynasty) != DynastySize {
+			if len(v.Dynasty) != d.params.DynastySize {
 				return ErrInvalidDynasty
 			}
 			dynastyTrie, err := DynastyTire(v.Dynasty, d.chain.Storage())
@@ -170,16 +172,26 @@ func (d *Dynasty) loadFromContract(serial int64) error {
 func (d *Dynasty) serial(timestamp int64) int64 {
 	if d.genesisTimestamp == 0 {
 		if second := d.chain.GetBlockOnCanonicalChainByHeight(2); second != nil {
-			d.genesisTimestamp = second.Timestamp() - BlockIntervalInMs/SecondInMs
+			d.genesisTimestamp = second.Timestamp() - d.params.BlockIntervalInMs/SecondInMs
 		} else {
 			return GenesisDynastySerial
 		}
 	}
-	if timestamp < d.genesisTimestamp {
-		return GenesisDynastySerial
+	return d.params.serial(d.genesisTimestamp, timestamp)
+}
+
+// serialStart return the timestamp the serial starts at
+func (d *Dynasty) serialStart(serial int64) int64 {
+	return d.genesisTimestamp + serial*d.params.DynastyIntervalInMs/SecondInMs
+}
+
+// members return the members of the dynasty serial in the order of the trie
+func (d *Dynasty) members(serial int64) ([]byteutils.Hash, error) {
+	dynasty, err := d.getDynasty(d.serialStart(serial))
+	if err != nil {
+		return nil, err
 	}
-	interval := (timestamp - d.genesisTimestamp) * SecondInMs
-	return interval / DynastyIntervalInMs
+	return TraverseDynasty(dynasty)
 }
 
 // isProposer return if the miner is propser in that dynasty
@@ -212,8 +224,23 @@ func (d *Dynasty) isProposer(now int64, miner byteutils.Hash) (bool, error) {
 
 // getDynastyTrie query dynasty trie
 func (d *Dynasty) getDynasty(timestamp int64) (*trie.Trie, error) {
+	return d.lookupDynasty(timestamp, true)
+}
+
+// knownDynasty query the dynasty trie known at the tail, without loading the dynasties from the contract.
+func (d *Dynasty) knownDynasty(timestamp int64) (*trie.Trie, error) {
+	return d.lookupDynasty(timestamp, false)
+}
+
+// lookupDynasty query dynasty trie, the dynasties are loaded from the contract and cached if load.
+func (d *Dynasty) lookupDynasty(timestamp int64, load bool) (*trie.Trie, error) {
+	get := d.tries.Get
+	if !load {
+		get = d.tries.Peek
+	}
+
 	// give a default dynasty trie
-	dt, _ := d.tries.Get(GenesisDynastySerial)
+	dt, _ := get(GenesisDynastySerial)
 
 	serial := d.serial(timestamp)
 	interval := (timestamp - d.genesisTimestamp) * SecondInMs
@@ -225,17 +252,17 @@ func (d *Dynasty) getDynasty(timestamp int64) (*trie.Trie, error) {
 		for _, v := range d.tries.Keys() {
 			start := v.(int64)
 
-			if start < serial+1 && start >= tmpDynasty && interval > start*DynastyIntervalInMs {
+			if start < serial+1 && start >= tmpDynasty && interval > start*d.params.DynastyIntervalInMs {
 				tmpDynasty = start
-				dt, _ = d.tries.Get(v)
+				dt, _ = get(v)
 			}
 		}
 	} else {
-		if !d.tries.Contains(serial) {
+		if load && !d.tries.Contains(serial) {
 			d.loadFromContract(serial)
 		}
 
-		temp, _ := d.tries.Get(serial)
+		temp, _ := get(serial)
 		// if dynasty not found in contract, use last dynasty.
 		if temp == nil {
 			dynastyRoot, err := d.chain.TailBlock().DynastyRoot()
@@ -247,7 +274,7 @@ func (d *Dynasty) getDynasty(timestamp int64) (*trie.Trie, error) {
 				return nil, err
 			}
 			tailSerial := d.serial(d.chain.TailBlock().Timestamp())
-			if tailSerial == serial {
+			if load && tailSerial == serial {
 				d.tries.Add(serial, tail)
 			}
 
@@ -299,22 +326,30 @@ func (d *Dynasty) getNodeInfo(miner *core.Address) (*core.NodeInfo, error) {
 }
 
 func (d *Dynasty) getParticipants() ([]*core.NodeInfo, error) {
-	result, err := d.chain.SimulateCallContract(core.NodePodContract(), core.PoDParticipants, "")
+	return d.getNodes(d.chain.TailBlock(), core.PoDParticipants)
+}
+
+// getNodes return the nodes listed by the function of the contract on the state of the block
+func (d *Dynasty) getNodes(block *core.Block, function string) ([]*core.NodeInfo, error) {
+	result, err := d.chain.SimulateCallContractAt(block, core.NodePodContract(), function, "")
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"result": result,
-			"error":  err,
-		}).Error("Failed to get participants from contract.")
+			"function": function,
+			"block":    block,
+			"result":   result,
+			"error":    err,
+		}).Error("Failed to get nodes from contract.")
 		return nil, err
 	}
-	participants := []*core.NodeInfo{}
-	if err := json.Unmarshal([]byte(result.Msg), &participants); err != nil {
+	nodes := []*core.NodeInfo{}
+	if err := json.Unmarshal([]byte(result.Msg), &nodes); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
-			"result": result,
-		}).Debug("Failed to parse Participants from contract.")
+			"function": function,
+			"result":   result,
+		}).Debug("Failed to parse nodes from contract.")
 		return nil, err
 	}
-	return participants, nil
+	return nodes, nil
 }
 
 // TraverseDynasty return all members in the dynasty
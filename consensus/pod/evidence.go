@@ -0,0 +1,392 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of evidence
+var (
+	ErrInvalidProtoToEvidence = errors.New("protobuf message cannot be converted into Evidence")
+	ErrInvalidEvidence        = errors.New("invalid evidence, the blocks are not conflicting in a slot")
+	ErrInvalidEvidenceSigner  = errors.New("invalid evidence, the blocks are signed by different miners")
+)
+
+// Evidence pool constants
+const (
+	MaxPendingEvidence   = 256
+	MaxCommittedEvidence = 1024
+
+	// the pending evidence is submitted again after the blocks, and dropped after a dynasty.
+	EvidenceResubmitBlocks = 4
+
+	evidencePoolKey = "pod_evidence_pool"
+)
+
+// Evidence is a pair of conflicting blocks signed by a miner in the same slot
+type Evidence struct {
+	blocks []*core.CompactBlock
+
+	// the signer recovered from the blocks.
+	miner *core.Address
+}
+
+// NewEvidence return the evidence of the conflicting blocks
+func NewEvidence(chainID uint32, a, b *core.Block) (*Evidence, error) {
+	e := &Evidence{
+		blocks: []*core.CompactBlock{core.NewCompactBlock(a), core.NewCompactBlock(b)},
+	}
+	if err := e.verify(chainID); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Miner return the miner signed the conflicting blocks
+func (e *Evidence) Miner() *core.Address {
+	return e.miner
+}
+
+// Timestamp return the slot of the conflicting blocks
+func (e *Evidence) Timestamp() int64 {
+	return e.blocks[0].Timestamp()
+}
+
+func (e *Evidence) key() string {
+	return evidenceKey(e.miner.String(), e.Timestamp())
+}
+
+func evidenceKey(miner string, timestamp int64) string {
+	return miner + "/" + strconv.FormatInt(timestamp, 10)
+}
+
+// verify the blocks are valid, conflicting and signed by the same miner.
+func (e *Evidence) verify(chainID uint32) error {
+	if len(e.blocks) != 2 {
+		return ErrInvalidEvidence
+	}
+	a, b := e.blocks[0], e.blocks[1]
+	if a.Timestamp() != b.Timestamp() || a.Hash().Equals(b.Hash()) {
+		return ErrInvalidEvidence
+	}
+
+	var miner *core.Address
+	for _, v := range e.blocks {
+		if err := v.VerifyHash(chainID); err != nil {
+			return err
+		}
+		signer, err := core.RecoverSignerFromSignature(v.Alg(), v.Hash(), v.Signature())
+		if err != nil {
+			return err
+		}
+		if miner != nil && !miner.Equals(signer) {
+			return ErrInvalidEvidenceSigner
+		}
+		miner = signer
+	}
+	e.miner = miner
+	return nil
+}
+
+// report return the evil report of the evidence
+func (e *Evidence) report() *core.Report {
+	return &core.Report{
+		Timestamp: e.Timestamp(),
+		Miner:     e.miner.String(),
+		Evil:      core.AttackDoubleSpend,
+	}
+}
+
+func (e *Evidence) info() *core.Evidence {
+	return &core.Evidence{
+		Miner:     e.miner.String(),
+		Timestamp: e.Timestamp(),
+		Evil:      core.AttackDoubleSpend,
+		Blocks:    []string{e.blocks[0].Hash().String(), e.blocks[1].Hash().String()},
+	}
+}
+
+// ToProto converts domain Evidence to proto Evidence
+func (e *Evidence) ToProto() (proto.Message, error) {
+	blocks := make([][]byte, len(e.blocks))
+	for idx, v := range e.blocks {
+		msg, err := v.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		blocks[idx] = data
+	}
+	return &consensuspb.Evidence{Blocks: blocks}, nil
+}
+
+// FromProto converts proto Evidence to domain Evidence, the evidence must be verified after.
+func (e *Evidence) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*consensuspb.Evidence); ok {
+		if msg != nil && len(msg.Blocks) == 2 {
+			blocks := make([]*core.CompactBlock, len(msg.Blocks))
+			for idx, v := range msg.Blocks {
+				pbBlock := new(corepb.CompactBlock)
+				if err := proto.Unmarshal(v, pbBlock); err != nil {
+					return err
+				}
+				blocks[idx] = new(core.CompactBlock)
+				if err := blocks[idx].FromProto(pbBlock); err != nil {
+					return err
+				}
+			}
+			e.blocks = blocks
+			return nil
+		}
+		return ErrInvalidProtoToEvidence
+	}
+	return ErrInvalidProtoToEvidence
+}
+
+type pendingEvidence struct {
+	evidence  *Evidence
+	submitted int64
+}
+
+type storedEvidence struct {
+	Evidence  []byte `json:"evidence"`
+	Submitted int64  `json:"submitted"`
+}
+
+type storedEvidencePool struct {
+	Pending   []*storedEvidence `json:"pending"`
+	Committed []*core.Evidence  `json:"committed"`
+}
+
+// EvidencePool keeps the evidence until its report is committed on chain
+type EvidencePool struct {
+	mu        sync.Mutex
+	storage   storage.Storage
+	pending   map[string]*pendingEvidence
+	committed *lru.Cache
+}
+
+// NewEvidencePool return the evidence pool persisted in the storage.
+func NewEvidencePool(stor storage.Storage, chainID uint32) (*EvidencePool, error) {
+	committed, err := lru.New(MaxCommittedEvidence)
+	if err != nil {
+		return nil, err
+	}
+	p := &EvidencePool{
+		storage:   stor,
+		pending:   make(map[string]*pendingEvidence),
+		committed: committed,
+	}
+
+	data, err := stor.Get([]byte(evidencePoolKey))
+	if err == storage.ErrKeyNotFound {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	stored := new(storedEvidencePool)
+	if err := json.Unmarshal(data, stored); err != nil {
+		return nil, err
+	}
+	for _, v := range stored.Pending {
+		pbEvidence := new(consensuspb.Evidence)
+		if err := proto.Unmarshal(v.Evidence, pbEvidence); err != nil {
+			return nil, err
+		}
+		e := new(Evidence)
+		if err := e.FromProto(pbEvidence); err != nil {
+			return nil, err
+		}
+		if err := e.verify(chainID); err != nil {
+			return nil, err
+		}
+		p.pending[e.key()] = &pendingEvidence{evidence: e, submitted: v.Submitted}
+	}
+	// the committed evidence is stored from the oldest added.
+	for _, v := range stored.Committed {
+		p.committed.Add(evidenceKey(v.Miner, v.Timestamp), v)
+	}
+	return p, nil
+}
+
+// Add a verified evidence, return false if it's known or the pool is full.
+func (p *EvidencePool) Add(e *Evidence) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := e.key()
+	if _, ok := p.pending[key]; ok || p.committed.Contains(key) {
+		return false
+	}
+	if len(p.pending) >= MaxPendingEvidence {
+		return false
+	}
+	p.pending[key] = &pendingEvidence{evidence: e}
+	p.save()
+	return true
+}
+
+// Commit the evidence reported in the block, return false if the report is known.
+func (p *EvidencePool) Commit(report *core.Report, height uint64, reporter string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := evidenceKey(report.Miner, report.Timestamp)
+	if p.committed.Contains(key) {
+		return false
+	}
+	info := &core.Evidence{
+		Miner:     report.Miner,
+		Timestamp: report.Timestamp,
+		Evil:      report.Evil,
+	}
+	if v, ok := p.pending[key]; ok {
+		info = v.evidence.info()
+		info.Evil = report.Evil
+		delete(p.pending, key)
+	}
+	info.Height = height
+	info.Reporter = reporter
+	p.committed.Add(key, info)
+	p.save()
+	return true
+}
+
+// Next return the oldest pending evidence not submitted in the resubmit interval,
+// and marks it submitted. The evidence expired in a dynasty interval is dropped.
+func (p *EvidencePool) Next(nowInMs int64, params *Params) *Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var next *pendingEvidence
+	dropped := false
+	for key, v := range p.pending {
+		if v.evidence.Timestamp()*SecondInMs+params.DynastyIntervalInMs < nowInMs {
+			delete(p.pending, key)
+			dropped = true
+			continue
+		}
+		if v.submitted > 0 && v.submitted+EvidenceResubmitBlocks*params.BlockIntervalInMs > nowInMs {
+			continue
+		}
+		if next == nil || v.evidence.Timestamp() < next.evidence.Timestamp() {
+			next = v
+		}
+	}
+	if next == nil {
+		if dropped {
+			p.save()
+		}
+		return nil
+	}
+	next.submitted = nowInMs
+	p.save()
+	return next.evidence
+}
+
+// Pending return the evidence waiting for the report committed.
+func (p *EvidencePool) Pending() []*core.Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result := []*core.Evidence{}
+	for _, v := range p.pending {
+		result = append(result, v.evidence.info())
+	}
+	sortEvidence(result)
+	return result
+}
+
+// Committed return the evidence reported on chain.
+func (p *EvidencePool) Committed() []*core.Evidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	result := []*core.Evidence{}
+	for _, key := range p.committed.Keys() {
+		if v, ok := p.committed.Peek(key); ok {
+			result = append(result, v.(*core.Evidence))
+		}
+	}
+	sortEvidence(result)
+	return result
+}
+
+// save the pool in the storage, the pool in memory is kept if it fails.
+func (p *EvidencePool) save() {
+	stored := &storedEvidencePool{
+		Pending:   []*storedEvidence{},
+		Committed: []*core.Evidence{},
+	}
+	for _, v := range p.pending {
+		msg, err := v.evidence.ToProto()
+		if err != nil {
+			continue
+		}
+		data, err := proto.Marshal(msg)
+		if err != nil {
+			continue
+		}
+		stored.Pending = append(stored.Pending, &storedEvidence{Evidence: data, Submitted: v.submitted})
+	}
+	for _, key := range p.committed.Keys() {
+		if v, ok := p.committed.Peek(key); ok {
+			stored.Committed = append(stored.Committed, v.(*core.Evidence))
+		}
+	}
+
+	data, err := json.Marshal(stored)
+	if err == nil {
+		err = p.storage.Put([]byte(evidencePoolKey), data)
+	}
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"pending":   len(stored.Pending),
+			"committed": len(stored.Committed),
+			"err":       err,
+		}).Error("Failed to save evidence pool.")
+	}
+}
+
+func sortEvidence(evidence []*core.Evidence) {
+	sort.Slice(evidence, func(i, j int) bool {
+		if evidence[i].Timestamp != evidence[j].Timestamp {
+			return evidence[i].Timestamp < evidence[j].Timestamp
+		}
+		return evidence[i].Miner < evidence[j].Miner
+	})
+}
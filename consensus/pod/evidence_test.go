@@ -0,0 +1,153 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/account"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockSignedBlock(t *testing.T, neb *core.MockNeb, timestamp int64, priv keystore.PrivateKey, coinbase string) *core.Block {
+	addr, err := core.AddressParse(coinbase)
+	assert.Nil(t, err)
+	block, err := core.NewBlock(neb.BlockChain().ChainID(), addr, neb.BlockChain().TailBlock())
+	assert.Nil(t, err)
+	block.SetTimestamp(timestamp)
+	assert.Nil(t, block.Seal())
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	assert.Nil(t, signature.InitSign(priv))
+	assert.Nil(t, block.Sign(signature))
+	return block
+}
+
+func TestEvidence(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, nil, nil)
+	chainID := neb.BlockChain().ChainID()
+
+	priv1, priv2 := secp256k1.GeneratePrivateKey(), secp256k1.GeneratePrivateKey()
+	block1 := mockSignedBlock(t, neb, 15, priv1, "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	block2 := mockSignedBlock(t, neb, 15, priv1, "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	block3 := mockSignedBlock(t, neb, 15, priv2, "n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so")
+	block4 := mockSignedBlock(t, neb, 30, priv1, "n1JAy4X6KKLCNiTd7MWMRsVBjgdVq5WCCpf")
+
+	_, err = NewEvidence(chainID, block1, block1)
+	assert.Equal(t, ErrInvalidEvidence, err)
+	_, err = NewEvidence(chainID, block1, block4)
+	assert.Equal(t, ErrInvalidEvidence, err)
+	_, err = NewEvidence(chainID, block1, block3)
+	assert.Equal(t, ErrInvalidEvidenceSigner, err)
+	_, err = NewEvidence(chainID+1, block1, block2)
+	assert.Equal(t, core.ErrInvalidChainID, err)
+
+	evidence, err := NewEvidence(chainID, block1, block2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), evidence.Timestamp())
+
+	msg, err := evidence.ToProto()
+	assert.Nil(t, err)
+	received := new(Evidence)
+	assert.Nil(t, received.FromProto(msg))
+	assert.Nil(t, received.verify(chainID))
+	assert.Equal(t, evidence.Miner(), received.Miner())
+	assert.Equal(t, evidence.info(), received.info())
+
+	// the blocks must be signed as they are.
+	pbEvidence := msg.(*consensuspb.Evidence)
+	pbEvidence.Blocks[1] = pbEvidence.Blocks[0]
+	assert.Nil(t, received.FromProto(pbEvidence))
+	assert.Equal(t, ErrInvalidEvidence, received.verify(chainID))
+	assert.Equal(t, ErrInvalidProtoToEvidence, received.FromProto(&consensuspb.Evidence{}))
+}
+
+func TestEvidencePool(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, nil, nil)
+	chainID := neb.BlockChain().ChainID()
+
+	priv1, priv2 := secp256k1.GeneratePrivateKey(), secp256k1.GeneratePrivateKey()
+	evidence1, err := NewEvidence(chainID,
+		mockSignedBlock(t, neb, 30, priv1, "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"),
+		mockSignedBlock(t, neb, 30, priv1, "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"))
+	assert.Nil(t, err)
+	duplicated, err := NewEvidence(chainID,
+		mockSignedBlock(t, neb, 30, priv1, "n1H4MYms9F55ehcvygwWE71J8tJC4CRr2so"),
+		mockSignedBlock(t, neb, 30, priv1, "n1JAy4X6KKLCNiTd7MWMRsVBjgdVq5WCCpf"))
+	assert.Nil(t, err)
+	evidence2, err := NewEvidence(chainID,
+		mockSignedBlock(t, neb, 15, priv2, "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"),
+		mockSignedBlock(t, neb, 15, priv2, "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s"))
+	assert.Nil(t, err)
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	pool, err := NewEvidencePool(stor, chainID)
+	assert.Nil(t, err)
+	assert.True(t, pool.Add(evidence1))
+	assert.False(t, pool.Add(evidence1))
+	assert.False(t, pool.Add(duplicated))
+	assert.True(t, pool.Add(evidence2))
+	assert.Equal(t, 2, len(pool.Pending()))
+
+	// the oldest evidence is submitted first, and again after the resubmit interval.
+	now, params := int64(30)*SecondInMs, DefaultParams()
+	assert.Equal(t, evidence2, pool.Next(now, params))
+	assert.Equal(t, evidence1, pool.Next(now, params))
+	assert.Nil(t, pool.Next(now, params))
+	assert.Equal(t, evidence2, pool.Next(now+EvidenceResubmitBlocks*params.BlockIntervalInMs, params))
+
+	assert.True(t, pool.Commit(evidence1.report(), 10, "reporter"))
+	assert.False(t, pool.Commit(evidence1.report(), 11, "reporter"))
+	assert.False(t, pool.Add(evidence1))
+	committed := pool.Committed()
+	assert.Equal(t, 1, len(committed))
+	assert.Equal(t, uint64(10), committed[0].Height)
+	assert.Equal(t, "reporter", committed[0].Reporter)
+	assert.Equal(t, 2, len(committed[0].Blocks))
+
+	// the reports without evidence are committed too.
+	report := &core.Report{Timestamp: 45, Miner: evidence2.Miner().String(), Evil: core.AttackDoubleSpend}
+	assert.True(t, pool.Commit(report, 12, "other"))
+	assert.Equal(t, 2, len(pool.Committed()))
+
+	// the pool is reloaded from the storage.
+	reloaded, err := NewEvidencePool(stor, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, pool.Pending(), reloaded.Pending())
+	assert.Equal(t, pool.Committed(), reloaded.Committed())
+	assert.False(t, reloaded.Add(evidence1))
+	assert.False(t, reloaded.Commit(report, 13, "other"))
+	assert.Nil(t, reloaded.Next(now, params))
+
+	// the expired evidence is dropped.
+	assert.Equal(t, 1, len(pool.Pending()))
+	assert.Nil(t, pool.Next(15*SecondInMs+params.DynastyIntervalInMs+1, params))
+	assert.Equal(t, 0, len(pool.Pending()))
+}
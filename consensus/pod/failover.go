@@ -0,0 +1,278 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"sync"
+	"syscall"
+
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors in failover
+var (
+	ErrInvalidFailoverLease = errors.New("invalid failover lease, should be file or peer")
+	ErrMissingLeaseFile     = errors.New("missing the lease file of the file lease")
+	ErrMissingLeaseHolder   = errors.New("missing the node name of the lease holder")
+)
+
+// the failover leases.
+const (
+	FailoverLeaseFile          = "file"
+	FailoverLeasePeer          = "peer"
+	DefaultLeaseDurationInS    = 30
+	LeaseHeartbeatsPerDuration = 3
+)
+
+// lease is held by the active one of the miners sharing a key until it expires,
+// with the latest block signed by the holder.
+type lease struct {
+	Holder string         `json:"holder"`
+	Expire int64          `json:"expire"`
+	Slot   int64          `json:"slot"`
+	Hash   byteutils.Hash `json:"hash"`
+}
+
+// Failover decides which one of the miners sharing a key is the active one,
+// the others are standby, following the chain without signing anything.
+// A standby takes over only after the lease of the active one expires.
+type Failover struct {
+	mu sync.Mutex
+
+	kind       string
+	file       string
+	holder     string
+	durationMs int64
+
+	startAt  int64
+	active   bool
+	expire   int64
+	observed *lease
+	beatAt   int64
+}
+
+// NewFailover return the failover in config, nil if it's disabled.
+func NewFailover(conf *nebletpb.FailoverConfig, defaultHolder string) (*Failover, error) {
+	if conf == nil || conf.Lease == "" {
+		return nil, nil
+	}
+	if conf.Lease != FailoverLeaseFile && conf.Lease != FailoverLeasePeer {
+		return nil, ErrInvalidFailoverLease
+	}
+	if conf.Lease == FailoverLeaseFile && conf.LeaseFile == "" {
+		return nil, ErrMissingLeaseFile
+	}
+	holder := conf.NodeName
+	if holder == "" {
+		holder = defaultHolder
+	}
+	if holder == "" {
+		return nil, ErrMissingLeaseHolder
+	}
+	duration := int64(conf.LeaseDuration)
+	if duration == 0 {
+		duration = DefaultLeaseDurationInS
+	}
+	return &Failover{
+		kind:       conf.Lease,
+		file:       conf.LeaseFile,
+		holder:     holder,
+		durationMs: duration * SecondInMs,
+		startAt:    -1,
+	}, nil
+}
+
+// Holder return the name of the node in the lease
+func (f *Failover) Holder() string {
+	return f.holder
+}
+
+// Active returns if it's the active miner
+func (f *Failover) Active() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active
+}
+
+// Tick acquires or renews the lease at now, with the latest block signed locally.
+// It returns the lease held by another node if any, whose block must never be signed over.
+func (f *Failover) Tick(nowInMs int64, slot int64, hash byteutils.Hash) (*lease, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.startAt < 0 {
+		f.startAt = nowInMs
+	}
+
+	if f.kind == FailoverLeaseFile {
+		other, acquired, err := f.renewFileLease(&lease{
+			Holder: f.holder,
+			Expire: nowInMs + f.durationMs,
+			Slot:   slot,
+			Hash:   hash,
+		}, nowInMs)
+		if err != nil {
+			// the active one steps down once its lease expires without renewal.
+			if f.active && nowInMs >= f.expire {
+				f.active = false
+			}
+			return nil, err
+		}
+		f.active = acquired
+		if acquired {
+			f.expire = nowInMs + f.durationMs
+		}
+		return other, nil
+	}
+
+	other := f.observed
+	if other != nil && other.Expire > nowInMs && (!f.active || other.Holder < f.holder) {
+		// two active ones after a partition, the smaller holder wins.
+		f.active = false
+		return other, nil
+	}
+	if !f.active && nowInMs < f.startAt+f.durationMs {
+		// wait a whole lease for the heartbeats of the active one.
+		return other, nil
+	}
+	f.active = true
+	f.expire = nowInMs + f.durationMs
+	return other, nil
+}
+
+// Observe records the heartbeat of another holder in the peer lease.
+func (f *Failover) Observe(holder string, timestampInMs int64, slot int64, hash byteutils.Hash) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.kind != FailoverLeasePeer || holder == f.holder {
+		return
+	}
+	if f.observed != nil && f.observed.Holder == holder && f.observed.Expire >= timestampInMs+f.durationMs {
+		return
+	}
+	if f.observed != nil && f.observed.Slot > slot {
+		slot, hash = f.observed.Slot, f.observed.Hash
+	}
+	f.observed = &lease{
+		Holder: holder,
+		Expire: timestampInMs + f.durationMs,
+		Slot:   slot,
+		Hash:   hash,
+	}
+}
+
+// Heartbeat returns if the active one should send a heartbeat in the peer lease at now.
+func (f *Failover) Heartbeat(nowInMs int64) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.kind != FailoverLeasePeer || !f.active {
+		return false
+	}
+	if nowInMs-f.beatAt < f.durationMs/LeaseHeartbeatsPerDuration {
+		return false
+	}
+	f.beatAt = nowInMs
+	return true
+}
+
+// Release gives up the lease, so that a standby could take over at once.
+func (f *Failover) Release() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.active {
+		return nil
+	}
+	f.active = false
+	if f.kind != FailoverLeaseFile {
+		return nil
+	}
+	return f.withFileLease(func(cur *lease) (*lease, error) {
+		if cur.Holder != f.holder {
+			return nil, nil
+		}
+		cur.Expire = 0
+		return cur, nil
+	})
+}
+
+// renewFileLease writes the lease into the lock file if it's free, expired or held by itself,
+// the lease of another holder in the file is returned.
+func (f *Failover) renewFileLease(l *lease, nowInMs int64) (*lease, bool, error) {
+	var other *lease
+	acquired := false
+	err := f.withFileLease(func(cur *lease) (*lease, error) {
+		if cur.Holder != "" && cur.Holder != l.Holder {
+			other = cur
+			if cur.Expire > nowInMs {
+				return nil, nil
+			}
+		}
+		acquired = true
+		return l, nil
+	})
+	return other, acquired, err
+}
+
+// withFileLease updates the lease in the lock file exclusively.
+func (f *Failover) withFileLease(update func(cur *lease) (*lease, error)) error {
+	file, err := os.OpenFile(f.file, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX); err != nil {
+		return err
+	}
+	defer syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	cur := new(lease)
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, cur); err != nil {
+			return err
+		}
+	}
+
+	next, err := update(cur)
+	if err != nil || next == nil {
+		return err
+	}
+	if data, err = json.Marshal(next); err != nil {
+		return err
+	}
+	if err := file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := file.WriteAt(data, 0); err != nil {
+		return err
+	}
+	return file.Sync()
+}
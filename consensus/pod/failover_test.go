@@ -0,0 +1,164 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFailover(t *testing.T) {
+	f, err := NewFailover(nil, "node")
+	assert.Nil(t, err)
+	assert.Nil(t, f)
+	f, err = NewFailover(&nebletpb.FailoverConfig{}, "node")
+	assert.Nil(t, err)
+	assert.Nil(t, f)
+
+	_, err = NewFailover(&nebletpb.FailoverConfig{Lease: "raft"}, "node")
+	assert.Equal(t, ErrInvalidFailoverLease, err)
+	_, err = NewFailover(&nebletpb.FailoverConfig{Lease: FailoverLeaseFile}, "node")
+	assert.Equal(t, ErrMissingLeaseFile, err)
+	_, err = NewFailover(&nebletpb.FailoverConfig{Lease: FailoverLeasePeer}, "")
+	assert.Equal(t, ErrMissingLeaseHolder, err)
+
+	f, err = NewFailover(&nebletpb.FailoverConfig{Lease: FailoverLeasePeer}, "node")
+	assert.Nil(t, err)
+	assert.Equal(t, "node", f.Holder())
+	assert.Equal(t, DefaultLeaseDurationInS*SecondInMs, f.durationMs)
+}
+
+func TestFailover_fileLease(t *testing.T) {
+	dir, err := ioutil.TempDir("", "failover")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := &nebletpb.FailoverConfig{Lease: FailoverLeaseFile, LeaseFile: filepath.Join(dir, "lease"), LeaseDuration: 10}
+	conf.NodeName = "a"
+	a, err := NewFailover(conf, "")
+	assert.Nil(t, err)
+	conf.NodeName = "b"
+	b, err := NewFailover(conf, "")
+	assert.Nil(t, err)
+
+	hash := byteutils.Hash([]byte("hash"))
+	other, err := a.Tick(1000, 15, hash)
+	assert.Nil(t, err)
+	assert.Nil(t, other)
+	assert.True(t, a.Active())
+
+	// b is standby until the lease of a expires.
+	other, err = b.Tick(2000, 0, nil)
+	assert.Nil(t, err)
+	assert.False(t, b.Active())
+	assert.Equal(t, "a", other.Holder)
+	assert.Equal(t, int64(15), other.Slot)
+	assert.Equal(t, hash, other.Hash)
+
+	other, err = b.Tick(11000, 0, nil)
+	assert.Nil(t, err)
+	assert.True(t, b.Active())
+	assert.Equal(t, "a", other.Holder)
+
+	other, err = a.Tick(12000, 15, hash)
+	assert.Nil(t, err)
+	assert.False(t, a.Active())
+	assert.Equal(t, "b", other.Holder)
+
+	// a takes over at once after b releases it.
+	assert.Nil(t, b.Release())
+	assert.False(t, b.Active())
+	_, err = a.Tick(13000, 15, hash)
+	assert.Nil(t, err)
+	assert.True(t, a.Active())
+}
+
+func TestFailover_peerLease(t *testing.T) {
+	a, err := NewFailover(&nebletpb.FailoverConfig{Lease: FailoverLeasePeer, LeaseDuration: 10}, "a")
+	assert.Nil(t, err)
+	b, err := NewFailover(&nebletpb.FailoverConfig{Lease: FailoverLeasePeer, LeaseDuration: 10}, "b")
+	assert.Nil(t, err)
+
+	// wait a whole lease for the heartbeats of the active one.
+	_, err = a.Tick(1000, 0, nil)
+	assert.Nil(t, err)
+	assert.False(t, a.Active())
+	_, err = a.Tick(11000, 0, nil)
+	assert.Nil(t, err)
+	assert.True(t, a.Active())
+	assert.True(t, a.Heartbeat(11000))
+	assert.False(t, a.Heartbeat(12000))
+
+	hash := byteutils.Hash([]byte("hash"))
+	b.Observe("a", 11000, 30, hash)
+	_, err = b.Tick(12000, 0, nil)
+	assert.Nil(t, err)
+	other, err := b.Tick(20000, 0, nil)
+	assert.Nil(t, err)
+	assert.False(t, b.Active())
+	assert.Equal(t, int64(30), other.Slot)
+
+	// takes over after the heartbeats stop.
+	other, err = b.Tick(22000, 0, nil)
+	assert.Nil(t, err)
+	assert.True(t, b.Active())
+	assert.Equal(t, "a", other.Holder)
+
+	// both are active after a partition, the smaller holder wins.
+	b.Observe("a", 23000, 45, hash)
+	_, err = b.Tick(23000, 30, hash)
+	assert.Nil(t, err)
+	assert.False(t, b.Active())
+	a.Observe("b", 23000, 30, hash)
+	_, err = a.Tick(23000, 45, hash)
+	assert.Nil(t, err)
+	assert.True(t, a.Active())
+}
+
+func TestSlashingProtection_observeBlock(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	sp, err := NewSlashingProtection(stor)
+	assert.Nil(t, err)
+	miner, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+
+	slot, hash := sp.LastBlock(miner)
+	assert.Equal(t, int64(0), slot)
+	assert.Nil(t, hash)
+
+	hash1, hash2 := byteutils.Hash([]byte("hash1")), byteutils.Hash([]byte("hash2"))
+	assert.Nil(t, sp.ObserveBlock(miner, 30, hash1))
+	assert.Nil(t, sp.ObserveBlock(miner, 15, hash2))
+	slot, hash = sp.LastBlock(miner)
+	assert.Equal(t, int64(30), slot)
+	assert.Equal(t, hash1, hash)
+
+	assert.Equal(t, ErrSlashingDoubleSign, sp.CheckAndRecordBlock(miner, 30, hash2))
+	assert.Equal(t, ErrSlashingSlotTooOld, sp.CheckAndRecordBlock(miner, 15, hash2))
+	assert.Nil(t, sp.CheckAndRecordBlock(miner, 45, hash2))
+}
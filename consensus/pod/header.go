@@ -0,0 +1,209 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of header verification
+var (
+	ErrInvalidHeaderParent  = errors.New("invalid header, not following its parent")
+	ErrInvalidHeaderDynasty = errors.New("invalid header, the dynasty changes within a serial")
+	ErrSecondHeaderNotFound = errors.New("cannot find the header at height 2 to count the dynasty serials")
+)
+
+// MinersLoader return the miners of the dynasty root in the order of the trie.
+type MinersLoader func(root byteutils.Hash) ([]byteutils.Hash, error)
+
+// HeaderVerifier verifies the headers following a trusted one without their states.
+// A header must be signed by the proposer of its slot in the dynasty committed by its consensus root,
+// and the dynasty root only changes at a serial boundary. The new dynasty is trusted once more than
+// a third of the former dynasty mint under it, or a checkpoint is reached.
+type HeaderVerifier struct {
+	params      *Params
+	checkpoints map[uint64]byteutils.Hash
+	miners      MinersLoader
+}
+
+// NewHeaderVerifier return new HeaderVerifier.
+func NewHeaderVerifier(params *Params, checkpoints map[uint64]byteutils.Hash, miners MinersLoader) *HeaderVerifier {
+	return &HeaderVerifier{
+		params:      params,
+		checkpoints: checkpoints,
+		miners:      miners,
+	}
+}
+
+// transition is a change of the dynasty not endorsed yet.
+type transition struct {
+	index    int
+	former   map[byteutils.HexHash]bool
+	endorsed map[byteutils.HexHash]bool
+}
+
+// Verify verifies the headers following the trusted parent, second is the header at height 2
+// the serials are counted from, it may be nil if the parent or the first header is the one.
+// Return the count of the leading headers trusted, the others follow an unendorsed dynasty.
+func (v *HeaderVerifier) Verify(second, parent *core.CompactBlock, headers []*core.CompactBlock) (int, error) {
+	if parent == nil {
+		return 0, core.ErrNilArgument
+	}
+	if second == nil && parent.Height() == 2 {
+		second = parent
+	}
+	if second == nil && len(headers) > 0 && headers[0].Height() == 2 {
+		second = headers[0]
+	}
+	if second == nil || second.Height() != 2 {
+		return 0, ErrSecondHeaderNotFound
+	}
+	genesisTimestamp := second.Timestamp() - v.params.BlockIntervalInMs/SecondInMs
+
+	loaded := make(map[byteutils.HexHash][]byteutils.Hash)
+	miners := func(root byteutils.Hash) ([]byteutils.Hash, error) {
+		if members, ok := loaded[root.Hex()]; ok {
+			return members, nil
+		}
+		members, err := v.miners(root)
+		if err != nil {
+			return nil, err
+		}
+		loaded[root.Hex()] = members
+		return members, nil
+	}
+
+	var pending *transition
+	for idx, header := range headers {
+		if err := v.verifyLink(parent, header); err != nil {
+			return 0, err
+		}
+
+		root := header.ConsensusRoot()
+		if root.Timestamp != header.Timestamp() {
+			return 0, ErrInvalidBlockTimestamp
+		}
+		if !byteutils.Equal(root.DynastyRoot, parent.ConsensusRoot().DynastyRoot) {
+			if v.params.serial(genesisTimestamp, header.Timestamp()) == v.params.serial(genesisTimestamp, parent.Timestamp()) {
+				logging.VLog().WithFields(logrus.Fields{
+					"header": header.Hash(),
+					"height": header.Height(),
+				}).Debug("Dynasty changes within a serial.")
+				return 0, ErrInvalidHeaderDynasty
+			}
+			if pending != nil {
+				return pending.index, nil
+			}
+			former, err := miners(parent.ConsensusRoot().DynastyRoot)
+			if err != nil {
+				return 0, err
+			}
+			pending = &transition{
+				index:    idx,
+				former:   make(map[byteutils.HexHash]bool),
+				endorsed: make(map[byteutils.HexHash]bool),
+			}
+			for _, miner := range former {
+				pending.former[miner.Hex()] = true
+			}
+		}
+
+		members, err := miners(root.DynastyRoot)
+		if err != nil {
+			return 0, err
+		}
+		signer, err := v.verifySigner(header, members)
+		if err != nil {
+			return 0, err
+		}
+
+		if pending != nil {
+			if pending.former[signer.Hex()] {
+				pending.endorsed[signer.Hex()] = true
+			}
+			if _, ok := v.checkpoints[header.Height()]; ok || len(pending.endorsed) > len(pending.former)/3 {
+				pending = nil
+			}
+		}
+		parent = header
+	}
+
+	if pending != nil {
+		return pending.index, nil
+	}
+	return len(headers), nil
+}
+
+// verifyLink verifies the header follows the parent, and matches the checkpoint at its height.
+func (v *HeaderVerifier) verifyLink(parent, header *core.CompactBlock) error {
+	if header.Height() != parent.Height()+1 || !header.ParentHash().Equals(parent.Hash()) ||
+		header.Timestamp() <= parent.Timestamp() || header.ConsensusRoot() == nil {
+		return ErrInvalidHeaderParent
+	}
+	if err := header.VerifyHash(parent.ChainID()); err != nil {
+		return err
+	}
+	if checkpoint, ok := v.checkpoints[header.Height()]; ok && !checkpoint.Equals(header.Hash()) {
+		return core.ErrCheckpointMismatch
+	}
+	return nil
+}
+
+// verifySigner verifies the header is signed by the proposer of its slot, return the signer.
+func (v *HeaderVerifier) verifySigner(header *core.CompactBlock, members []byteutils.Hash) (byteutils.Hash, error) {
+	proposer, err := v.params.FindProposer(header.Timestamp(), members)
+	if err != nil {
+		return nil, err
+	}
+	signer, err := core.RecoverSignerFromSignature(header.Alg(), header.Hash(), header.Signature())
+	if err != nil {
+		return nil, err
+	}
+	if !proposer.Equals(signer.Bytes()) || !proposer.Equals(header.ConsensusRoot().Proposer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"header":   header.Hash(),
+			"proposer": proposer.Base58(),
+			"signer":   signer,
+		}).Debug("Header is not signed by the proposer of its slot.")
+		return nil, core.ErrInvalidBlockProposer
+	}
+	return signer.Bytes(), nil
+}
+
+// VerifyHeaders implements core.HeaderVerifier, the nodes of the dynasties not in storage are synced by the fetcher.
+func (pod *PoD) VerifyHeaders(second, parent *core.CompactBlock, headers []*core.CompactBlock, fetcher trie.NodeFetcher) (int, error) {
+	verifier := NewHeaderVerifier(pod.params, pod.chain.Checkpoints(), func(root byteutils.Hash) ([]byteutils.Hash, error) {
+		dynasty, err := trie.NewTrie(nil, pod.chain.Storage(), false)
+		if err != nil {
+			return nil, err
+		}
+		dynasty.SetNodeFetcher(fetcher)
+		if err := dynasty.SyncTrie(root); err != nil {
+			return nil, err
+		}
+		return TraverseDynasty(dynasty)
+	})
+	return verifier.Verify(second, parent, headers)
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderVerifier(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+	chain := neb.BlockChain()
+
+	// serial 0 from height 2 to 5, and two members are replaced in serial 1.
+	intervalInS := pod.params.BlockIntervalInMs / SecondInMs
+	for i := 0; i < 3; i++ {
+		mintPodBlock(t, neb, intervalInS)
+	}
+	nvm := neb.Nvm().(*podNvm)
+	nvm.dynasty = append(append([]string{}, nvm.dynasty[2:]...),
+		"n1PJqpN1bkrjZ44pjrNcZAW8AkHc4iAMiBz", "n1RB386UkGfrA3uXvAksGETxUhwJAL2H94r")
+	mintPodBlock(t, neb, pod.dynasty.serialStart(1)-chain.TailBlock().Timestamp())
+	for i := 0; i < 12; i++ {
+		mintPodBlock(t, neb, intervalInS)
+	}
+
+	headers := []*core.CompactBlock{}
+	for height := uint64(2); height <= chain.TailBlock().Height(); height++ {
+		headers = append(headers, core.NewCompactBlock(chain.GetBlockOnCanonicalChainByHeight(height)))
+	}
+	boundary := 4
+	assert.NotEqual(t, headers[boundary-1].ConsensusRoot().DynastyRoot, headers[boundary].ConsensusRoot().DynastyRoot)
+
+	miners := func(root byteutils.Hash) ([]byteutils.Hash, error) {
+		dynasty, err := trie.NewTrie(root, chain.Storage(), false)
+		if err != nil {
+			return nil, err
+		}
+		return TraverseDynasty(dynasty)
+	}
+	genesis := core.NewCompactBlock(chain.GenesisBlock())
+	verifier := NewHeaderVerifier(pod.params, nil, miners)
+	trusted, err := verifier.Verify(nil, genesis, headers)
+	assert.Nil(t, err)
+	assert.Equal(t, len(headers), trusted)
+
+	// from a trusted parent, with the second header to count the serials.
+	trusted, err = verifier.Verify(headers[0], headers[1], headers[2:])
+	assert.Nil(t, err)
+	assert.Equal(t, len(headers)-2, trusted)
+	_, err = verifier.Verify(nil, headers[1], headers[2:])
+	assert.Equal(t, ErrSecondHeaderNotFound, err)
+	_, err = verifier.Verify(nil, genesis, headers[1:])
+	assert.Equal(t, ErrSecondHeaderNotFound, err)
+
+	// the new dynasty is pending until a third of the former one mints under it, or a checkpoint.
+	trusted, err = verifier.Verify(nil, genesis, headers[:boundary+3])
+	assert.Nil(t, err)
+	assert.Equal(t, boundary, trusted)
+	checkpoints := map[uint64]byteutils.Hash{headers[boundary].Height(): headers[boundary].Hash()}
+	trusted, err = NewHeaderVerifier(pod.params, checkpoints, miners).Verify(nil, genesis, headers[:boundary+1])
+	assert.Nil(t, err)
+	assert.Equal(t, boundary+1, trusted)
+	checkpoints[headers[1].Height()] = headers[boundary].Hash()
+	_, err = NewHeaderVerifier(pod.params, checkpoints, miners).Verify(nil, genesis, headers)
+	assert.Equal(t, core.ErrCheckpointMismatch, err)
+
+	// the headers must follow their parents.
+	_, err = verifier.Verify(nil, genesis, append([]*core.CompactBlock{headers[0]}, headers[2:]...))
+	assert.Equal(t, ErrInvalidHeaderParent, err)
+
+	// signed by the proposer of the slot in the committed dynasty.
+	rotated := func(root byteutils.Hash) ([]byteutils.Hash, error) {
+		members, err := miners(root)
+		if err != nil {
+			return nil, err
+		}
+		return append(members[1:], members[0]), nil
+	}
+	_, err = NewHeaderVerifier(pod.params, nil, rotated).Verify(nil, genesis, headers)
+	assert.Equal(t, core.ErrInvalidBlockProposer, err)
+
+	// the dynasty changes only at a serial boundary.
+	params := *pod.params
+	params.DynastyIntervalInMs *= 2
+	_, err = NewHeaderVerifier(&params, nil, miners).Verify(nil, genesis, headers)
+	assert.Equal(t, ErrInvalidHeaderDynasty, err)
+
+	// the dynasties are synced by the fetcher.
+	trusted, err = pod.VerifyHeaders(nil, genesis, headers, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, len(headers), trusted)
+}
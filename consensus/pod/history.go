@@ -0,0 +1,171 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Errors in consensus history
+var (
+	ErrInvalidHistorySerial  = errors.New("invalid serial, should not be later than the tail's")
+	ErrHistorySerialNotFound = errors.New("cannot find the blocks of the serial on canonical chain")
+	ErrInvalidScheduleCount  = errors.New("invalid count of slots to schedule")
+)
+
+// MaxScheduleSlots is the limit of the slots in a proposer schedule.
+const MaxScheduleSlots = 1024
+
+// ConsensusSnapshot return the consensus state at the block of the height on the canonical chain.
+func (pod *PoD) ConsensusSnapshot(height uint64) (*core.ConsensusSnapshot, error) {
+	block := pod.chain.GetBlockOnCanonicalChainByHeight(height)
+	if block == nil {
+		return nil, core.ErrBlockNotFound
+	}
+	members, err := block.Dynasty()
+	if err != nil {
+		return nil, err
+	}
+	dynastyRoot, err := block.DynastyRoot()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &core.ConsensusSnapshot{
+		Height:      block.Height(),
+		Hash:        block.Hash().String(),
+		Timestamp:   block.Timestamp(),
+		Serial:      pod.dynasty.serial(block.Timestamp()),
+		DynastyRoot: dynastyRoot.String(),
+		Dynasty:     []string{},
+	}
+	for _, v := range members {
+		addr, err := core.AddressParseFromBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Dynasty = append(snapshot.Dynasty, addr.String())
+	}
+
+	// the nodes are kept in the contract after the node update.
+	if core.NodeUpdateAtHeight(block.Height()) {
+		if snapshot.Candidates, err = pod.dynasty.getNodes(block, core.PoDCandidates); err != nil {
+			return nil, err
+		}
+		if snapshot.Participants, err = pod.dynasty.getNodes(block, core.PoDParticipants); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// SerialStartHeight return the height of the first block in the serial on the canonical chain.
+func (pod *PoD) SerialStartHeight(serial int64) (uint64, error) {
+	tail := pod.chain.TailBlock()
+	if serial < 0 || serial > pod.dynasty.serial(tail.Timestamp()) {
+		return 0, ErrInvalidHistorySerial
+	}
+	height, err := pod.searchSerialHeight(serial)
+	if err != nil {
+		return 0, err
+	}
+	// the block found may be in a later serial if none is minted in the serial.
+	block := pod.chain.GetBlockOnCanonicalChainByHeight(height)
+	if block == nil || pod.dynasty.serial(block.Timestamp()) != serial {
+		return 0, ErrHistorySerialNotFound
+	}
+	return height, nil
+}
+
+// searchSerialHeight return the height of the first block in the serial or a later one on the canonical chain,
+// the tail's height plus one if none.
+func (pod *PoD) searchSerialHeight(serial int64) (uint64, error) {
+	tail := pod.chain.TailBlock()
+
+	// the serials of the blocks grow with the heights.
+	var err error
+	start := pod.chain.HistoryStart()
+	idx := sort.Search(int(tail.Height()-start+1), func(i int) bool {
+		block := pod.chain.GetBlockOnCanonicalChainByHeight(start + uint64(i))
+		if block == nil {
+			err = core.ErrBlockNotFound
+			return true
+		}
+		return pod.dynasty.serial(block.Timestamp()) >= serial
+	})
+	if err != nil {
+		return 0, err
+	}
+	// the serial may start below the blocks kept by a state sync.
+	if idx == 0 && start > 1 {
+		return 0, core.ErrBlockHistoryUnavailable
+	}
+	return start + uint64(idx), nil
+}
+
+// ProposerSchedule return the proposers of the next slots, by the dynasties known at the tail.
+func (pod *PoD) ProposerSchedule(count int) ([]*core.ProposerSlot, error) {
+	if count == 0 {
+		count = pod.params.DynastySize
+	}
+	if count < 0 || count > MaxScheduleSlots {
+		return nil, ErrInvalidScheduleCount
+	}
+
+	intervalInS := pod.params.BlockIntervalInMs / SecondInMs
+	slot := pod.params.nextSlot(time.Now().Unix()*SecondInMs) / SecondInMs
+	if tail := pod.chain.TailBlock(); tail.Timestamp() >= slot {
+		slot = tail.Timestamp() + intervalInS
+	}
+
+	miners := make(map[int64][]byteutils.Hash)
+	schedule := make([]*core.ProposerSlot, 0, count)
+	for i := 0; i < count; i, slot = i+1, slot+intervalInS {
+		serial := pod.dynasty.serial(slot)
+		members, ok := miners[serial]
+		if !ok {
+			dynasty, err := pod.dynasty.knownDynasty(slot)
+			if err != nil {
+				return nil, err
+			}
+			if members, err = TraverseDynasty(dynasty); err != nil {
+				return nil, err
+			}
+			miners[serial] = members
+		}
+		proposer, err := pod.params.FindProposer(slot, members)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := core.AddressParseFromBytes(proposer)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, &core.ProposerSlot{
+			Timestamp: slot,
+			Serial:    serial,
+			Proposer:  addr.String(),
+		})
+	}
+	return schedule, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestPoD return a PoD persisting the slashing protection in a temp dir.
+func newTestPoD(t *testing.T) *PoD {
+	stor, err := storage.NewDiskStorage(t.TempDir())
+	assert.Nil(t, err)
+	t.Cleanup(func() { stor.Close() })
+
+	pod := NewPoD()
+	pod.SetSlashingStorage(stor)
+	return pod
+}
+
+func TestConsensusHistory(t *testing.T) {
+	pod := newTestPoD(t)
+	neb := core.NewMockNeb(nil, pod, nil)
+
+	snapshot, err := pod.ConsensusSnapshot(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), snapshot.Height)
+	assert.Equal(t, neb.BlockChain().GenesisBlock().Hash().String(), snapshot.Hash)
+	assert.Equal(t, int64(GenesisDynastySerial), snapshot.Serial)
+	assert.ElementsMatch(t, neb.Genesis().Consensus.Dpos.Dynasty, snapshot.Dynasty)
+	_, err = pod.ConsensusSnapshot(2)
+	assert.Equal(t, core.ErrBlockNotFound, err)
+
+	height, err := pod.SerialStartHeight(GenesisDynastySerial)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), height)
+	_, err = pod.SerialStartHeight(GenesisDynastySerial + 1)
+	assert.Equal(t, ErrInvalidHistorySerial, err)
+}
+
+func TestProposerSchedule(t *testing.T) {
+	pod := newTestPoD(t)
+	core.NewMockNeb(nil, pod, nil)
+
+	_, err := pod.ProposerSchedule(-1)
+	assert.Equal(t, ErrInvalidScheduleCount, err)
+	_, err = pod.ProposerSchedule(MaxScheduleSlots + 1)
+	assert.Equal(t, ErrInvalidScheduleCount, err)
+
+	// a round of the dynasty, each miner in turn.
+	schedule, err := pod.ProposerSchedule(0)
+	assert.Nil(t, err)
+	assert.Equal(t, pod.params.DynastySize, len(schedule))
+	proposers := make(map[string]bool)
+	for i, slot := range schedule {
+		assert.Equal(t, int64(0), slot.Timestamp*SecondInMs%pod.params.BlockIntervalInMs)
+		if i > 0 {
+			assert.Equal(t, pod.params.BlockIntervalInMs/SecondInMs, slot.Timestamp-schedule[i-1].Timestamp)
+		}
+		proposers[slot.Proposer] = true
+	}
+	assert.Equal(t, pod.params.DynastySize, len(proposers))
+}
+
+func TestSerialStartHeight(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+
+	// serial 0 till height 3, serial 1 at heights 4 and 5, serial 3 from height 6.
+	intervalInS := pod.params.DynastyIntervalInMs / SecondInMs
+	blockInS := pod.params.BlockIntervalInMs / SecondInMs
+	mintPodBlock(t, neb, blockInS)
+	mintPodBlock(t, neb, intervalInS-2*blockInS)
+	mintPodBlock(t, neb, blockInS)
+	mintPodBlock(t, neb, 2*intervalInS-blockInS)
+	tail := mintPodBlock(t, neb, blockInS)
+	assert.Equal(t, int64(3), pod.dynasty.serial(tail.Timestamp()))
+
+	for serial, expected := range map[int64]uint64{0: 1, 1: 4, 3: 6} {
+		height, err := pod.SerialStartHeight(serial)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, height, "serial %d", serial)
+	}
+	// no block is minted in serial 2.
+	_, err := pod.SerialStartHeight(2)
+	assert.Equal(t, ErrHistorySerialNotFound, err)
+	_, err = pod.SerialStartHeight(-1)
+	assert.Equal(t, ErrInvalidHistorySerial, err)
+	_, err = pod.SerialStartHeight(4)
+	assert.Equal(t, ErrInvalidHistorySerial, err)
+}
+
+func TestConsensusSnapshot_nodes(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+	block := mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs)
+
+	// no nodes before the node update.
+	snapshot, err := pod.ConsensusSnapshot(block.Height() - 1)
+	assert.Nil(t, err)
+	assert.Empty(t, snapshot.Candidates)
+	assert.Empty(t, snapshot.Participants)
+
+	snapshot, err = pod.ConsensusSnapshot(block.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, block.Hash().String(), snapshot.Hash)
+	assert.ElementsMatch(t, neb.Genesis().Consensus.Dpos.Dynasty, snapshot.Dynasty)
+	miner := neb.Genesis().Consensus.Dpos.Dynasty[0]
+	height := block.Height() + 1
+	assert.Equal(t, []*core.NodeInfo{{
+		Id: fmt.Sprintf("%s-%d", core.PoDCandidates, height), HeartbeatSerial: int64(height), Miner: miner, Score: fmt.Sprint(height),
+	}}, snapshot.Candidates)
+	assert.Equal(t, []*core.NodeInfo{{
+		Id: fmt.Sprintf("%s-%d", core.PoDParticipants, height), HeartbeatSerial: int64(height), Miner: miner, Score: fmt.Sprint(height),
+	}}, snapshot.Participants)
+}
+
+func TestProposerSchedule_readOnly(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+	mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs)
+
+	// the future dynasties are not loaded from the contract by the queries.
+	keys := pod.dynasty.tries.Keys()
+	schedule, err := pod.ProposerSchedule(MaxScheduleSlots)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxScheduleSlots, len(schedule))
+	for _, slot := range schedule {
+		assert.Contains(t, neb.Genesis().Consensus.Dpos.Dynasty, slot.Proposer)
+	}
+	assert.Equal(t, keys, pod.dynasty.tries.Keys())
+}
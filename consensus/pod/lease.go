@@ -0,0 +1,87 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"github.com/gogo/protobuf/proto"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/signer"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MinerLease is the heartbeat of the active miner in the peer lease
+type MinerLease struct {
+	miner     byteutils.Hash
+	holder    string
+	slot      int64
+	hash      byteutils.Hash
+	timestamp int64
+
+	// sign
+	alg  keystore.Algorithm
+	sign byteutils.Hash
+}
+
+// Hash return the hash of the lease
+func (l *MinerLease) Hash() byteutils.Hash {
+	return signer.LeaseHash(&consensuspb.MinerLease{
+		Miner:     l.miner,
+		Holder:    l.holder,
+		Slot:      l.slot,
+		Hash:      l.hash,
+		Timestamp: l.timestamp,
+	})
+}
+
+// ToProto converts domain MinerLease to proto MinerLease
+func (l *MinerLease) ToProto() (proto.Message, error) {
+	return &consensuspb.MinerLease{
+		Miner:     l.miner,
+		Holder:    l.holder,
+		Slot:      l.slot,
+		Hash:      l.hash,
+		Timestamp: l.timestamp,
+		Alg:       uint32(l.alg),
+		Sign:      l.sign,
+	}, nil
+}
+
+// FromProto converts proto MinerLease to domain MinerLease
+func (l *MinerLease) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*consensuspb.MinerLease); ok {
+		if msg != nil {
+			alg := keystore.Algorithm(msg.Alg)
+			if err := crypto.CheckAlgorithm(alg); err != nil {
+				return err
+			}
+			l.miner = msg.Miner
+			l.holder = msg.Holder
+			l.slot = msg.Slot
+			l.hash = msg.Hash
+			l.timestamp = msg.Timestamp
+			l.alg = alg
+			l.sign = msg.Sign
+			return nil
+		}
+		return ErrInvalidProtoToMinerLease
+	}
+	return ErrInvalidProtoToMinerLease
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors of consensus params
+var (
+	ErrInvalidParamsBlockInterval   = errors.New("invalid block interval in genesis, should be a positive multiple of 1000 ms")
+	ErrInvalidParamsDynastyInterval = errors.New("invalid dynasty interval in genesis, should be a multiple of block interval with a slot for each miner")
+	ErrInvalidParamsConsensusSize   = errors.New("invalid consensus size in genesis, should be more than 2/3 of the dynasty size")
+)
+
+// Params is the consensus timing of a chain
+type Params struct {
+	BlockIntervalInMs   int64
+	DynastyIntervalInMs int64
+	DynastySize         int
+	ConsensusSize       int
+}
+
+// DefaultParams return the consensus timing of mainnet.
+func DefaultParams() *Params {
+	return &Params{
+		BlockIntervalInMs:   DefaultBlockIntervalInMs,
+		DynastyIntervalInMs: DefaultDynastyIntervalInMs,
+		DynastySize:         DefaultDynastySize,
+		ConsensusSize:       DefaultDynastySize*2/3 + 1,
+	}
+}
+
+// NewParams return the validated params in genesis, the defaults for the ones not set.
+func NewParams(genesis *corepb.Genesis) (*Params, error) {
+	p := DefaultParams()
+	if genesis != nil && genesis.Consensus != nil && genesis.Consensus.Params != nil {
+		conf := genesis.Consensus.Params
+		if conf.BlockInterval != 0 {
+			p.BlockIntervalInMs = conf.BlockInterval
+		}
+		if conf.DynastyInterval != 0 {
+			p.DynastyIntervalInMs = conf.DynastyInterval
+		}
+		if conf.DynastySize != 0 {
+			p.DynastySize = int(conf.DynastySize)
+		}
+		p.ConsensusSize = int(conf.ConsensusSize)
+		if p.ConsensusSize == 0 {
+			p.ConsensusSize = p.DynastySize*2/3 + 1
+		}
+	}
+
+	if p.BlockIntervalInMs <= 0 || p.BlockIntervalInMs%SecondInMs != 0 {
+		return nil, ErrInvalidParamsBlockInterval
+	}
+	if p.DynastyIntervalInMs%p.BlockIntervalInMs != 0 ||
+		p.DynastyIntervalInMs < p.BlockIntervalInMs*int64(p.DynastySize) {
+		return nil, ErrInvalidParamsDynastyInterval
+	}
+	// more than 2/3 of the miners are required to tolerate the faulty ones.
+	if p.ConsensusSize*3 <= p.DynastySize*2 || p.ConsensusSize > p.DynastySize {
+		return nil, ErrInvalidParamsConsensusSize
+	}
+	return p, nil
+}
+
+// ToProto return the params in genesis
+func (p *Params) ToProto() *corepb.GenesisConsensusParams {
+	return &corepb.GenesisConsensusParams{
+		BlockInterval:   p.BlockIntervalInMs,
+		DynastyInterval: p.DynastyIntervalInMs,
+		DynastySize:     uint32(p.DynastySize),
+		ConsensusSize:   uint32(p.ConsensusSize),
+	}
+}
+
+// the durations of minting scale with the block interval.
+func (p *Params) acceptedNetworkDelayInMs() int64 {
+	return p.BlockIntervalInMs / 4
+}
+
+func (p *Params) maxMintDurationInMs() int64 {
+	return p.BlockIntervalInMs * 7 / 20
+}
+
+func (p *Params) minMintDurationInMs() int64 {
+	return p.BlockIntervalInMs * 3 / 20
+}
+
+func (p *Params) lastSlot(nowInMs int64) int64 {
+	return int64((nowInMs-SecondInMs)/p.BlockIntervalInMs) * p.BlockIntervalInMs
+}
+
+func (p *Params) nextSlot(nowInMs int64) int64 {
+	return int64((nowInMs+p.BlockIntervalInMs-SecondInMs)/p.BlockIntervalInMs) * p.BlockIntervalInMs
+}
+
+func (p *Params) deadline(nowInMs int64) int64 {
+	nextSlotInMs := p.nextSlot(nowInMs)
+	remainInMs := nextSlotInMs - nowInMs
+	if p.maxMintDurationInMs() > remainInMs {
+		return nextSlotInMs
+	}
+	return nowInMs + p.maxMintDurationInMs()
+}
+
+// serial return the dynasty serial of the timestamp, counted from the genesis timestamp.
+func (p *Params) serial(genesisTimestamp, timestamp int64) int64 {
+	if timestamp < genesisTimestamp {
+		return GenesisDynastySerial
+	}
+	return (timestamp - genesisTimestamp) * SecondInMs / p.DynastyIntervalInMs
+}
+
+// FindProposer for now in given dynasty
+func (p *Params) FindProposer(now int64, miners []byteutils.Hash) (proposer byteutils.Hash, err error) {
+	nowInMs := now * SecondInMs
+	offsetInMs := nowInMs % p.DynastyIntervalInMs
+	if (offsetInMs % p.BlockIntervalInMs) != 0 {
+		return nil, ErrNotBlockForgTime
+	}
+	offset := offsetInMs / p.BlockIntervalInMs
+	offset %= int64(p.DynastySize)
+
+	if offset >= 0 && int(offset) < len(miners) {
+		proposer = miners[offset]
+	} else {
+		logging.VLog().WithFields(logrus.Fields{
+			"proposer":  proposer,
+			"offset":    offset,
+			"delegatee": len(miners),
+		}).Warn("Found Nil Proposer.")
+		return nil, ErrFoundNilProposer
+	}
+	return proposer, nil
+}
+
+// NormalizeParams return the validated params in genesis filled with the defaults, nil if they equal the defaults.
+func (pod *PoD) NormalizeParams(conf *corepb.GenesisConsensusParams) (*corepb.GenesisConsensusParams, error) {
+	params, err := NewParams(&corepb.Genesis{Consensus: &corepb.GenesisConsensus{Params: conf}})
+	if err != nil {
+		return nil, err
+	}
+	if *params == *DefaultParams() {
+		return nil, nil
+	}
+	return params.ToProto(), nil
+}
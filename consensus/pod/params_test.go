@@ -0,0 +1,122 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewParams(t *testing.T) {
+	// defaults of mainnet.
+	p, err := NewParams(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, &Params{DefaultBlockIntervalInMs, DefaultDynastyIntervalInMs, DefaultDynastySize, 15}, p)
+
+	tests := []struct {
+		name   string
+		params *corepb.GenesisConsensusParams
+		want   *Params
+		err    error
+	}{
+		{"devnet", &corepb.GenesisConsensusParams{BlockInterval: 1000, DynastyInterval: 8000, DynastySize: 4},
+			&Params{1000, 8000, 4, 3}, nil},
+		{"consensus", &corepb.GenesisConsensusParams{BlockInterval: 5000, DynastySize: 6, ConsensusSize: 6},
+			&Params{5000, DefaultDynastyIntervalInMs, 6, 6}, nil},
+		{"negative block interval", &corepb.GenesisConsensusParams{BlockInterval: -1000}, nil, ErrInvalidParamsBlockInterval},
+		{"block interval not in seconds", &corepb.GenesisConsensusParams{BlockInterval: 1500}, nil, ErrInvalidParamsBlockInterval},
+		{"dynasty interval not in blocks", &corepb.GenesisConsensusParams{DynastyInterval: 3150001}, nil, ErrInvalidParamsDynastyInterval},
+		{"dynasty interval too short", &corepb.GenesisConsensusParams{DynastyInterval: 300000}, nil, ErrInvalidParamsDynastyInterval},
+		{"consensus too small", &corepb.GenesisConsensusParams{ConsensusSize: 14}, nil, ErrInvalidParamsConsensusSize},
+		{"consensus too large", &corepb.GenesisConsensusParams{ConsensusSize: 22}, nil, ErrInvalidParamsConsensusSize},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := &corepb.Genesis{Consensus: &corepb.GenesisConsensus{Params: tt.params}}
+			p, err := NewParams(genesis)
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.want, p)
+		})
+	}
+}
+
+func TestParams(t *testing.T) {
+	p := &Params{1000, 8000, 4, 3}
+	assert.Equal(t, int64(250), p.acceptedNetworkDelayInMs())
+	assert.Equal(t, int64(350), p.maxMintDurationInMs())
+	assert.Equal(t, int64(150), p.minMintDurationInMs())
+	assert.Equal(t, &corepb.GenesisConsensusParams{BlockInterval: 1000, DynastyInterval: 8000, DynastySize: 4, ConsensusSize: 3}, p.ToProto())
+
+	miners := []byteutils.Hash{[]byte("m0"), []byte("m1"), []byte("m2")}
+	proposer, err := p.FindProposer(9, miners)
+	assert.Nil(t, err)
+	assert.Equal(t, miners[1], proposer)
+	_, err = p.FindProposer(11, miners)
+	assert.Equal(t, ErrFoundNilProposer, err)
+	_, err = (&Params{2000, 8000, 4, 3}).FindProposer(1, miners)
+	assert.Equal(t, ErrNotBlockForgTime, err)
+}
+
+func TestPoD_NormalizeParams(t *testing.T) {
+	pod := NewPoD()
+	assert.Equal(t, DefaultParams(), pod.params)
+
+	// nothing for the defaults.
+	for _, conf := range []*corepb.GenesisConsensusParams{nil, {}, {BlockInterval: DefaultBlockIntervalInMs, ConsensusSize: 15}} {
+		params, err := pod.NormalizeParams(conf)
+		assert.Nil(t, err)
+		assert.Nil(t, params)
+	}
+
+	params, err := pod.NormalizeParams(&corepb.GenesisConsensusParams{DynastyInterval: 105000, DynastySize: 6})
+	assert.Nil(t, err)
+	assert.Equal(t, &corepb.GenesisConsensusParams{BlockInterval: DefaultBlockIntervalInMs, DynastyInterval: 105000, DynastySize: 6, ConsensusSize: 5}, params)
+
+	_, err = pod.NormalizeParams(&corepb.GenesisConsensusParams{BlockInterval: 1500})
+	assert.Equal(t, ErrInvalidParamsBlockInterval, err)
+}
+
+func TestGenesisParams(t *testing.T) {
+	pod := newTestPoD(t)
+	neb := core.NewMockNeb(nil, pod, nil)
+	chain := neb.BlockChain()
+
+	// the defaults are not committed to the genesis state.
+	conf := core.MockGenesisConf()
+	conf.Consensus.Params = &corepb.GenesisConsensusParams{BlockInterval: DefaultBlockIntervalInMs}
+	genesis, err := core.NewGenesisBlock(conf, chain)
+	assert.Nil(t, err)
+	assert.Equal(t, chain.GenesisBlock().StateRoot(), genesis.StateRoot())
+	conf.Consensus.Params = &corepb.GenesisConsensusParams{BlockInterval: 5000}
+	genesis, err = core.NewGenesisBlock(conf, chain)
+	assert.Nil(t, err)
+	assert.NotEqual(t, chain.GenesisBlock().StateRoot(), genesis.StateRoot())
+
+	// the params are compared as normalized.
+	neb.SetGenesis(conf)
+	assert.Equal(t, core.ErrGenesisNotEqualParamsInDB, chain.CheckGenesisConfig(neb))
+	conf.Consensus.Params = &corepb.GenesisConsensusParams{DynastySize: DefaultDynastySize, ConsensusSize: 15}
+	assert.Nil(t, chain.CheckGenesisConfig(neb))
+	conf.Consensus.Params = &corepb.GenesisConsensusParams{BlockInterval: 1500}
+	assert.Equal(t, ErrInvalidParamsBlockInterval, chain.CheckGenesisConfig(neb))
+}
@@ -0,0 +1,264 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/metrics"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in miner performance
+var (
+	ErrInvalidPerformanceSerial = errors.New("invalid serial, should not be later than the tail's")
+	ErrSerialStatisticsNotFound = errors.New("block statistics of the serial not found")
+)
+
+// the miner performance constants.
+const (
+	MaxWitnessSerials             = 8
+	PerformanceMetricsIntervalInS = 60
+)
+
+// witnessRecords records the blocks witnessed by the miners in the recent serials.
+type witnessRecords struct {
+	mu      sync.Mutex
+	serials map[int64]map[string]map[string]bool
+}
+
+func newWitnessRecords() *witnessRecords {
+	return &witnessRecords{serials: make(map[int64]map[string]map[string]bool)}
+}
+
+func (w *witnessRecords) add(serial int64, miner string, hash string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	miners, ok := w.serials[serial]
+	if !ok {
+		miners = make(map[string]map[string]bool)
+		w.serials[serial] = miners
+		for k := range w.serials {
+			if k <= serial-MaxWitnessSerials {
+				delete(w.serials, k)
+			}
+		}
+	}
+	blocks, ok := miners[miner]
+	if !ok {
+		blocks = make(map[string]bool)
+		miners[miner] = blocks
+	}
+	blocks[hash] = true
+}
+
+func (w *witnessRecords) count(serial int64, miner string) int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.serials[serial][miner])
+}
+
+// DynastyPerformance return the performance of the miners in the serial on the canonical chain,
+// the witnesses are only recorded in the recent MaxWitnessSerials serials.
+func (pod *PoD) DynastyPerformance(serial int64) (*core.DynastyPerformance, error) {
+	tail := pod.chain.TailBlock()
+	tailSerial := pod.dynasty.serial(tail.Timestamp())
+	if serial < 0 || serial > tailSerial {
+		return nil, ErrInvalidPerformanceSerial
+	}
+
+	// the statistics are counted back from the last block of the serial.
+	last := tail
+	if serial < tailSerial {
+		next, err := pod.searchSerialHeight(serial + 1)
+		if err != nil {
+			return nil, err
+		}
+		if next > 1 {
+			if last = pod.chain.GetBlockOnCanonicalChainByHeight(next - 1); last == nil {
+				return nil, core.ErrBlockNotFound
+			}
+		}
+	}
+	statistics, err := pod.chain.StatisticalLastBlocks(serial+1, last)
+	if err != nil {
+		return nil, err
+	}
+	var produced *core.Statistics
+	for _, v := range statistics {
+		if v.Serial == serial {
+			produced = v
+		}
+	}
+	if produced == nil {
+		return nil, ErrSerialStatisticsNotFound
+	}
+
+	// the slots of the serial, until the tail in the current one.
+	start := pod.dynasty.serialStart(serial)
+	end := start + pod.params.DynastyIntervalInMs/SecondInMs
+	if serial == tailSerial {
+		end = tail.Timestamp() + 1
+	}
+	members, err := pod.dynasty.members(serial)
+	if err != nil {
+		return nil, err
+	}
+	miners := []string{}
+	expected := make(map[string]int)
+	for _, v := range members {
+		addr, err := core.AddressParseFromBytes(v)
+		if err != nil {
+			return nil, err
+		}
+		miners = append(miners, addr.String())
+	}
+	for slot := pod.params.nextSlot(start*SecondInMs) / SecondInMs; slot < end; slot += pod.params.BlockIntervalInMs / SecondInMs {
+		proposer, err := pod.params.FindProposer(slot, members)
+		if err != nil {
+			continue
+		}
+		addr, err := core.AddressParseFromBytes(proposer)
+		if err != nil {
+			return nil, err
+		}
+		expected[addr.String()]++
+	}
+
+	// the evil reports in the blocks of the serial.
+	perf := &core.DynastyPerformance{
+		Serial:            serial,
+		StartHeight:       produced.Start,
+		EndHeight:         produced.Start,
+		WitnessesRecorded: serial > tailSerial-MaxWitnessSerials,
+	}
+	reports := make(map[string]int)
+	last = nil
+	for height := produced.Start; produced.Start > 0 && height <= tail.Height(); height++ {
+		block := pod.chain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil || pod.dynasty.serial(block.Timestamp()) != serial {
+			break
+		}
+		perf.EndHeight = height
+		last = block
+		for _, tx := range block.Transactions() {
+			if tx.Type() != core.TxPayloadPodType {
+				continue
+			}
+			payload, err := core.LoadPodPayload(tx.Data())
+			if err == nil && payload.Action == core.PoDReport {
+				reports[tx.From().String()]++
+			}
+		}
+	}
+
+	// the scores and the heartbeats at the end of the serial.
+	participants := make(map[string]*core.NodeInfo)
+	if last != nil && core.NodeUpdateAtHeight(last.Height()) {
+		nodes, err := pod.dynasty.getNodes(last, core.PoDParticipants)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range nodes {
+			participants[v.Miner] = v
+		}
+	}
+
+	// the miners produced out of the dynasty follow the members.
+	others := []string{}
+	for miner := range produced.Statistics {
+		if !contains(miners, miner) {
+			others = append(others, miner)
+		}
+	}
+	sort.Strings(others)
+	miners = append(miners, others...)
+
+	for _, miner := range miners {
+		mp := &core.MinerPerformance{
+			Miner:    miner,
+			Expected: expected[miner],
+			Produced: produced.Statistics[miner],
+			Reports:  reports[miner],
+		}
+		if perf.WitnessesRecorded {
+			mp.Witnesses = pod.witnesses.count(serial, miner)
+		}
+		if mp.Expected > mp.Produced {
+			mp.Missed = mp.Expected - mp.Produced
+		}
+		if node, ok := participants[miner]; ok {
+			mp.HeartbeatSerial = node.HeartbeatSerial
+			mp.Score = node.Score
+		}
+		perf.Miners = append(perf.Miners, mp)
+	}
+	return perf, nil
+}
+
+// updatePerformanceMetrics updates the metrics of the miners in the current serial.
+func (pod *PoD) updatePerformanceMetrics() {
+	if !atomic.CompareAndSwapInt32(&pod.measuring, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&pod.measuring, 0)
+
+	tail := pod.chain.TailBlock()
+	if !core.NodeUpdateAtHeight(tail.Height()) {
+		return
+	}
+	perf, err := pod.DynastyPerformance(pod.dynasty.serial(tail.Timestamp()))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"err":  err,
+		}).Debug("Failed to measure the miners performance.")
+		return
+	}
+
+	missed := 0
+	for _, v := range perf.Miners {
+		prefix := "neb.miner." + v.Miner
+		metrics.NewGauge(prefix + ".expected").Update(int64(v.Expected))
+		metrics.NewGauge(prefix + ".produced").Update(int64(v.Produced))
+		metrics.NewGauge(prefix + ".missed").Update(int64(v.Missed))
+		metrics.NewGauge(prefix + ".heartbeat").Update(v.HeartbeatSerial)
+		metrics.NewGauge(prefix + ".witnesses").Update(int64(v.Witnesses))
+		metrics.NewGauge(prefix + ".reports").Update(int64(v.Reports))
+		missed += v.Missed
+	}
+	metricsDynastySerial.Update(perf.Serial)
+	metricsDynastyMissed.Update(int64(missed))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
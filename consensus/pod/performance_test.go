@@ -0,0 +1,222 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWitnessRecords(t *testing.T) {
+	w := newWitnessRecords()
+	w.add(1, "a", "h1")
+	w.add(1, "a", "h1")
+	w.add(1, "a", "h2")
+	w.add(1, "b", "h1")
+	assert.Equal(t, 2, w.count(1, "a"))
+	assert.Equal(t, 1, w.count(1, "b"))
+	assert.Equal(t, 0, w.count(1, "c"))
+	assert.Equal(t, 0, w.count(2, "a"))
+
+	// the records out of the recent serials are dropped.
+	w.add(1+MaxWitnessSerials, "a", "h3")
+	assert.Equal(t, 0, w.count(1, "a"))
+	assert.Equal(t, 1, w.count(1+MaxWitnessSerials, "a"))
+	assert.Equal(t, 1, len(w.serials))
+}
+
+// podCompatibility updates the nodes at height 3, with the pod contract deployed before.
+type podCompatibility struct {
+	core.Compatibility
+	contract *core.Address
+}
+
+func (c *podCompatibility) NodeUpdateHeight() uint64 {
+	return 3
+}
+
+func (c *podCompatibility) NodeStartSerial() uint64 {
+	return 0
+}
+
+func (c *podCompatibility) NodePodContract() *core.Address {
+	return c.contract
+}
+
+// podNvm is a fake pod contract, the nodes in it score the height of the block called at,
+// and the dynasty elected for every serial is kept.
+type podNvm struct {
+	miner   string
+	dynasty []string
+}
+
+type podEngine struct {
+	nvm   *podNvm
+	block *core.Block
+}
+
+func (nvm *podNvm) CreateEngine(block *core.Block, tx *core.Transaction, contract state.Account, ws core.WorldState) (core.SmartContractEngine, error) {
+	return &podEngine{nvm: nvm, block: block}, nil
+}
+
+func (nvm *podNvm) CheckV8Run() error {
+	return nil
+}
+
+func (e *podEngine) SetExecutionLimits(uint64, uint64) error {
+	return nil
+}
+
+func (e *podEngine) DeployAndInit(source, sourceType, args string) (string, error) {
+	return "", nil
+}
+
+func (e *podEngine) Call(source, sourceType, function, args string) (string, error) {
+	switch function {
+	case core.PoDCandidates, core.PoDParticipants:
+		height := e.block.Height()
+		return fmt.Sprintf(`[{"id":"%s-%d","miner":"%s","score":"%d","heartbeat_serial":%d}]`,
+			function, height, e.nvm.miner, height, height), nil
+	case core.PoDMiners:
+		var serial uint64
+		if _, err := fmt.Sscanf(args, "[%d]", &serial); err != nil {
+			return "", err
+		}
+		data, err := json.Marshal(&corepb.Dynasty{
+			Candidate: []*corepb.DynastyCandidate{{Serial: serial, Dynasty: e.nvm.dynasty}},
+		})
+		return string(data), err
+	}
+	return "{}", nil
+}
+
+func (e *podEngine) ExecutionInstructions() uint64 {
+	return 100
+}
+
+func (e *podEngine) Dispose() {}
+
+// newPodChain return a mock chain on pod, with the fake pod contract deployed in block 2.
+// The compatibility is restored by the returned func.
+func newPodChain(t *testing.T) (*core.MockNeb, *PoD, func()) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	dynasty := core.MockGenesisConf().Consensus.Dpos.Dynasty
+	miner := dynasty[0]
+	pod := newTestPoD(t)
+	neb := core.NewMockNeb(am, pod, &podNvm{miner: miner, dynasty: dynasty})
+
+	from, err := core.AddressParse(miner)
+	assert.Nil(t, err)
+	assert.Nil(t, am.Unlock(from, []byte("passphrase"), time.Hour))
+	payload, err := core.NewDeployPayload("module.exports = {};", "js", "")
+	assert.Nil(t, err)
+	data, err := payload.ToBytes()
+	assert.Nil(t, err)
+	tx, err := core.NewTransaction(neb.BlockChain().ChainID(), from, from, util.NewUint128(), 1,
+		core.TxPayloadDeployType, data, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	assert.Nil(t, am.SignTransaction(from, tx))
+	contract, err := tx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	compatibility := core.NebCompatibility
+	core.NebCompatibility = &podCompatibility{Compatibility: compatibility, contract: contract}
+	mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs, tx)
+	return neb, pod, func() { core.NebCompatibility = compatibility }
+}
+
+// mintPodBlock mints a block of the transactions in the slot elapsed from the tail.
+func mintPodBlock(t *testing.T, neb *core.MockNeb, elapsed int64, txs ...*core.Transaction) *core.Block {
+	chain := neb.BlockChain()
+	tail := chain.TailBlock()
+	context, err := tail.WorldState().NextConsensusState(elapsed)
+	assert.Nil(t, err)
+	coinbase, err := core.AddressParseFromBytes(context.Proposer())
+	assert.Nil(t, err)
+	assert.Nil(t, neb.AccountManager().Unlock(coinbase, []byte("passphrase"), time.Hour))
+
+	block, err := chain.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(context)
+	block.SetTimestamp(tail.Timestamp() + elapsed)
+	for _, tx := range txs {
+		assert.Nil(t, chain.TransactionPool().Push(tx))
+	}
+	if len(txs) > 0 {
+		block.CollectTransactions(time.Now().UnixNano()/1e6 + 500)
+		assert.Equal(t, len(txs), len(block.Transactions()))
+	}
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, neb.AccountManager().SignBlock(coinbase, block))
+	assert.Nil(t, chain.BlockPool().Push(block))
+	assert.Equal(t, block.Hash(), chain.TailBlock().Hash())
+	return chain.TailBlock()
+}
+
+func TestDynastyPerformance(t *testing.T) {
+	neb, pod, restore := newPodChain(t)
+	defer restore()
+
+	// serial 0 at 15s and 30s, serial 1 at 3150s and 3165s, and the tail in serial 8.
+	intervalInS := pod.params.DynastyIntervalInMs / SecondInMs
+	mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs)
+	mintPodBlock(t, neb, intervalInS-2*pod.params.BlockIntervalInMs/SecondInMs)
+	last := mintPodBlock(t, neb, pod.params.BlockIntervalInMs/SecondInMs)
+	tail := mintPodBlock(t, neb, 7*intervalInS-pod.params.BlockIntervalInMs/SecondInMs)
+	assert.Equal(t, int64(8), pod.dynasty.serial(tail.Timestamp()))
+
+	_, err := pod.DynastyPerformance(9)
+	assert.Equal(t, ErrInvalidPerformanceSerial, err)
+	_, err = pod.DynastyPerformance(-1)
+	assert.Equal(t, ErrInvalidPerformanceSerial, err)
+
+	// the witnesses are only counted in the recent serials.
+	perf, err := pod.DynastyPerformance(8 - MaxWitnessSerials)
+	assert.Nil(t, err)
+	assert.False(t, perf.WitnessesRecorded)
+	assert.Equal(t, last.Height()-2, perf.EndHeight)
+
+	perf, err = pod.DynastyPerformance(1)
+	assert.Nil(t, err)
+	assert.True(t, perf.WitnessesRecorded)
+	assert.Equal(t, last.Height()-1, perf.StartHeight)
+	assert.Equal(t, last.Height(), perf.EndHeight)
+
+	// the score and the heartbeat are the ones at the last block of the serial.
+	miner := core.MockGenesisConf().Consensus.Dpos.Dynasty[0]
+	found := false
+	for _, mp := range perf.Miners {
+		if mp.Miner == miner {
+			found = true
+			assert.Equal(t, fmt.Sprint(last.Height()+1), mp.Score)
+			assert.Equal(t, int64(last.Height()+1), mp.HeartbeatSerial)
+		}
+	}
+	assert.True(t, found)
+}
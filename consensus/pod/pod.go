@@ -21,18 +21,22 @@ package pod
 import (
 	"context"
 	"encoding/json"
+	"path/filepath"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/util"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/net"
-	"github.com/nebulasio/go-nebulas/rpc"
-	rpcpb "github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/signer"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -46,18 +50,26 @@ type PoD struct {
 	ns    net.Service
 	am    core.AccountManager
 
+	params  *Params
 	dynasty *Dynasty
 
 	coinbase               *core.Address
 	miner                  *core.Address
 	enableRemoteSignServer bool
-	remoteSignServer       string
+	remoteSigner           signer.Signer
+	slashing               *SlashingProtection
+	slashingStorage        storage.Storage
+	slashingDB             *storage.DiskStorage
+	failover               *Failover
 
 	messageCh chan net.Message
 
 	slot       *lru.Cache
 	reversible *lru.Cache
 
+	aggregates  *lru.Cache
+	aggregateMu sync.Mutex
+
 	enable  bool
 	pending bool
 
@@ -67,6 +79,11 @@ type PoD struct {
 	heartbeatTryCount  int64
 
 	eventSub *core.EventSubscriber
+
+	witnesses *witnessRecords
+	measuring int32
+
+	evidence *EvidencePool
 }
 
 // NewPoD create PoD.
@@ -79,18 +96,38 @@ func NewPoD() *PoD {
 		heartbeatTimestamp: 0,
 		heartbeatTryCount:  0,
 		messageCh:          make(chan net.Message, 128),
-		eventSub:           core.NewEventSubscriber(128, []string{core.TopicPodStateUpdate}),
+		eventSub:           core.NewEventSubscriber(128, []string{core.TopicPodStateUpdate, core.TopicNewTailBlock}),
+		witnesses:          newWitnessRecords(),
+		params:             DefaultParams(),
 	}
 	return pod
 }
 
+// SetSlashingStorage set the storage of the slashing protection before Setup, it is owned by the caller.
+// Without it, the slashing protection is persisted in the datadir.
+func (pod *PoD) SetSlashingStorage(stor storage.Storage) {
+	pod.slashingStorage = stor
+}
+
 // Setup a pod consensus handler
 func (pod *PoD) Setup(neblet core.Neblet) error {
 	pod.chain = neblet.BlockChain()
 	pod.ns = neblet.NetService()
 	pod.am = neblet.AccountManager()
+	pod.ns.RegisterCapability(CapabilityAggregatedWitness)
 
-	dynasty, err := NewDynasty(neblet)
+	// the consensus timing of the chain in genesis.
+	params, err := NewParams(neblet.Genesis())
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"params": neblet.Genesis().GetConsensus().GetParams(),
+			"err":    err,
+		}).Error("Failed to load consensus params.")
+		return err
+	}
+	pod.params = params
+
+	dynasty, err := NewDynasty(neblet, params)
 	if err != nil {
 		return err
 	}
@@ -117,7 +154,46 @@ func (pod *PoD) Setup(neblet core.Neblet) error {
 		pod.coinbase = coinbase
 		pod.miner = miner
 		pod.enableRemoteSignServer = chainConfig.EnableRemoteSignServer
-		pod.remoteSignServer = chainConfig.RemoteSignServer
+		if pod.enableRemoteSignServer {
+			if pod.remoteSigner, err = signer.NewSigner(chainConfig.RemoteSignServer, chainConfig.RemoteSigner); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"server": chainConfig.RemoteSignServer,
+					"err":    err,
+				}).Error("Failed to setup remote signer.")
+				return err
+			}
+		}
+
+		stor := pod.slashingStorage
+		if stor == nil {
+			if chainConfig.Datadir == "" {
+				return ErrSlashingNoDatadir
+			}
+			if pod.slashingDB, err = storage.NewDiskStorage(filepath.Join(chainConfig.Datadir, SlashingProtectionDir)); err != nil {
+				return err
+			}
+			stor = pod.slashingDB
+		}
+		if pod.slashing, err = NewSlashingProtection(stor); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Failed to load slashing protection.")
+			return err
+		}
+
+		if failover := chainConfig.Failover; failover != nil && failover.Lease != "" {
+			holder := ""
+			if node := pod.ns.Node(); node != nil {
+				holder = node.ID()
+			}
+			if pod.failover, err = NewFailover(failover, holder); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"lease": failover.Lease,
+					"err":   err,
+				}).Error("Failed to setup miner failover.")
+				return err
+			}
+		}
 	}
 
 	slot, err := lru.New(128)
@@ -131,6 +207,19 @@ func (pod *PoD) Setup(neblet core.Neblet) error {
 		return err
 	}
 	pod.reversible = reversible
+
+	aggregates, err := lru.New(128)
+	if err != nil {
+		return err
+	}
+	pod.aggregates = aggregates
+
+	if pod.evidence, err = NewEvidencePool(pod.chain.Storage(), pod.chain.ChainID()); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to load evidence pool.")
+		return err
+	}
 	return nil
 }
 
@@ -139,6 +228,9 @@ func (pod *PoD) Start() {
 	logging.CLog().Info("Starting pod Mining...")
 
 	pod.ns.Register(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeWitness, net.MessageWeightZero))
+	pod.ns.Register(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeAggregatedWitness, net.MessageWeightZero))
+	pod.ns.Register(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeMinerLease, net.MessageWeightZero))
+	pod.ns.Register(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeEvidence, net.MessageWeightZero))
 	pod.chain.EventEmitter().Register(pod.eventSub)
 
 	go pod.blockLoop()
@@ -148,7 +240,20 @@ func (pod *PoD) Start() {
 func (pod *PoD) Stop() {
 	logging.CLog().Info("Stopping pod Mining...")
 	pod.ns.Deregister(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeWitness, net.MessageWeightZero))
+	pod.ns.Deregister(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeAggregatedWitness, net.MessageWeightZero))
+	pod.ns.Deregister(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeMinerLease, net.MessageWeightZero))
+	pod.ns.Deregister(net.NewSubscriber(pod, pod.messageCh, true, MessageTypeEvidence, net.MessageWeightZero))
 	pod.DisableMining()
+	if pod.failover != nil {
+		pod.failover.Release()
+	}
+	if pod.remoteSigner != nil {
+		pod.remoteSigner.Close()
+	}
+	if pod.slashingDB != nil {
+		pod.slashingDB.Close()
+		pod.slashingDB = nil
+	}
 	pod.chain.EventEmitter().Deregister(pod.eventSub)
 
 	pod.quitCh <- true
@@ -230,7 +335,7 @@ func (pod *PoD) ForkChoice() error {
 func (pod *PoD) UpdateLIB(rversibleBlocks []byteutils.Hash) {
 
 	available := core.NodeUpdateAtHeight(pod.chain.TailBlock().Height())
-	if pod.enable && !pod.Pending() && available && len(rversibleBlocks) > 0 {
+	if pod.enable && !pod.Pending() && !pod.Standby() && available && len(rversibleBlocks) > 0 {
 		found, _ := pod.dynasty.isProposer(pod.chain.TailBlock().Timestamp(), pod.miner.Bytes())
 		logging.VLog().WithFields(logrus.Fields{
 			"found": found,
@@ -246,17 +351,17 @@ func (pod *PoD) UpdateLIB(rversibleBlocks []byteutils.Hash) {
 	miners := make(map[string]bool)
 	dynasty := int64(-1)
 	for !cur.Hash().Equals(lib.Hash()) {
-		curDynasty := cur.Timestamp() * SecondInMs / DynastyIntervalInMs
+		curDynasty := cur.Timestamp() * SecondInMs / pod.params.DynastyIntervalInMs
 		if curDynasty != dynasty {
 			miners = make(map[string]bool)
 			dynasty = curDynasty
 		}
 		// fast prune
-		if int(cur.Height())-int(lib.Height()) < ConsensusSize-len(miners) {
+		if int(cur.Height())-int(lib.Height()) < pod.params.ConsensusSize-len(miners) {
 			return
 		}
 		miners[byteutils.Hex(cur.ConsensusRoot().Proposer)] = true
-		if len(miners) >= ConsensusSize {
+		if len(miners) >= pod.params.ConsensusSize {
 			pod.setLib(cur, len(miners))
 			return
 		}
@@ -277,7 +382,7 @@ func (pod *PoD) UpdateLIB(rversibleBlocks []byteutils.Hash) {
 		"lib":              lib,
 		"tail":             tail,
 		"err":              "supported miners is not enough",
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     pod.params.ConsensusSize,
 		"miners.supported": len(miners),
 	}).Debug("Failed to update latest irreversible block.")
 }
@@ -294,7 +399,7 @@ func (pod *PoD) setLib(block *core.Block, confirmed int) {
 		"lib.new":          block,
 		"lib.old":          pod.chain.LIB(),
 		"tail":             pod.chain.TailBlock(),
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     pod.params.ConsensusSize,
 		"miners.supported": confirmed,
 	}).Info("Succeed to update latest irreversible block.")
 	pod.chain.SetLIB(block)
@@ -371,77 +476,6 @@ func (pod *PoD) CheckDoubleMint(block *core.Block) bool {
 	return false
 }
 
-func (pod *PoD) reportEvil(preBlock, block *core.Block) error {
-	// check mining enable
-	if !pod.enable || pod.pending {
-		return nil
-	}
-
-	if !core.NodeUpdateAtHeight(pod.chain.TailBlock().Height()) {
-		return nil
-	}
-
-	found, err := pod.dynasty.isProposer(block.Timestamp(), pod.miner.Bytes())
-	logging.VLog().WithFields(logrus.Fields{
-		"timestamp": block.Timestamp(),
-		"serial":    pod.dynasty.serial(block.Timestamp()),
-		"miner":     pod.miner,
-		"curBlock":  block.Hash(),
-		"preBlock":  preBlock.Hash(),
-	}).Debug("check evil reporter.")
-	if err != nil {
-		return err
-	}
-	if found {
-		evil := core.AttackNotMiner
-		if preBlock.Miner().Equals(block.Miner()) {
-			evil = core.AttackDoubleSpend
-		} else {
-			//FIXME: Current block mint strategy is, no new block mint dynasty, use the last block mint dynasty
-			// Therefore, for the non - block node block temporarily do not punish, to prevent accidental injury.
-			logging.VLog().WithFields(logrus.Fields{
-				"timestamp": block.Timestamp(),
-				"serial":    pod.dynasty.serial(block.Timestamp()),
-				"miner":     pod.miner,
-				"curBlock":  block.Hash(),
-				"preBlock":  preBlock.Hash(),
-			}).Warn("Not the miner for report evil.")
-			return nil
-		}
-		// submit double mint attack
-		report := &core.Report{
-			Timestamp: block.Timestamp(),
-			Miner:     block.Miner().String(),
-			Evil:      evil,
-		}
-		bytes, err := report.ToBytes()
-		if err != nil {
-			return err
-		}
-		err = pod.sendTransaction(block.Timestamp(), core.PoDReport, bytes)
-		logging.VLog().WithFields(logrus.Fields{
-			"timestamp": block.Timestamp(),
-			"serial":    pod.dynasty.serial(block.Timestamp()),
-			"miner":     pod.miner,
-			"curBlock":  block,
-			"preBlock":  preBlock,
-			"error":     err,
-		}).Info("Send report evil tx.")
-		if err != nil {
-			return err
-		}
-	} else {
-		logging.VLog().WithFields(logrus.Fields{
-			"timestamp": block.Timestamp(),
-			"serial":    pod.dynasty.serial(block.Timestamp()),
-			"miner":     pod.miner,
-			"curBlock":  block.Hash(),
-			"preBlock":  preBlock.Hash(),
-		}).Info("Not the dynasty proposer for report evil.")
-	}
-	return nil
-}
-
 // Serial return dynasty serial number
 func (pod *PoD) Serial(timestamp int64) int64 {
 	return pod.dynasty.serial(timestamp)
@@ -449,12 +483,22 @@ func (pod *PoD) Serial(timestamp int64) int64 {
 
 // VerifyBlock verify the block
 func (pod *PoD) VerifyBlock(block *core.Block) error {
+	if err := pod.VerifyBlockHeader(block); err != nil {
+		return err
+	}
+
+	pod.slot.Add(block.Timestamp(), block)
+	return nil
+}
+
+// VerifyBlockHeader verify the header of the block without recording it
+func (pod *PoD) VerifyBlockHeader(block *core.Block) error {
 	// check timestamp
 	if block.Timestamp() != block.ConsensusRoot().Timestamp {
 		return ErrInvalidBlockTimestamp
 	}
 	elapsedSecondInMs := block.Timestamp() * SecondInMs
-	if elapsedSecondInMs <= 0 || (elapsedSecondInMs%BlockIntervalInMs) != 0 {
+	if elapsedSecondInMs <= 0 || (elapsedSecondInMs%pod.params.BlockIntervalInMs) != 0 {
 		return ErrInvalidBlockInterval
 	}
 
@@ -471,12 +515,10 @@ func (pod *PoD) VerifyBlock(block *core.Block) error {
 		}).Debug("No random found in block header.")
 		return core.ErrInvalidBlockRandom
 	}
-
-	pod.slot.Add(block.Timestamp(), block)
 	return nil
 }
 
-func (pod *PoD) generateRandomSeed(block *core.Block) error {
+func (pod *PoD) generateRandomSeed(block *core.Block, slot int64) error {
 
 	ancestorHash, parentSeed, err := pod.chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
 	if err != nil {
@@ -484,26 +526,22 @@ func (pod *PoD) generateRandomSeed(block *core.Block) error {
 	}
 
 	if pod.enableRemoteSignServer == true {
-		conn, err := rpc.Dial(pod.remoteSignServer)
-		if err != nil {
-			return err
-		}
-		defer conn.Close()
-		client := rpcpb.NewAdminServiceClient(conn)
-
 		// generate VRF hash,proof
-		random, err := client.GenerateRandomSeed(
+		vrfSeed, vrfProof, err := pod.remoteSigner.GenerateRandomSeed(
 			context.Background(),
-			&rpcpb.GenerateRandomSeedRequest{
+			&signerpb.GenerateRandomSeedRequest{
 				Address:      pod.miner.String(),
 				ParentSeed:   parentSeed,
 				AncestorHash: ancestorHash,
+				Height:       block.Height(),
+				Slot:         slot,
+				ParentHash:   block.ParentHash(),
 			})
 		if err != nil {
 			return err
 		}
 
-		block.SetRandomSeed(random.VrfSeed, random.VrfProof)
+		block.SetRandomSeed(vrfSeed, vrfProof)
 	} else {
 		// generate VRF hash,proof
 		vrfSeed, vrfProof, err := pod.am.GenerateRandomSeed(pod.miner, ancestorHash, parentSeed)
@@ -516,9 +554,23 @@ func (pod *PoD) generateRandomSeed(block *core.Block) error {
 }
 
 func (pod *PoD) signBlock(block *core.Block) error {
+	if pod.slashing != nil {
+		if err := pod.slashing.CheckAndRecordBlock(pod.miner, block.Timestamp(), block.Hash()); err != nil {
+			return err
+		}
+	}
+
 	if pod.enableRemoteSignServer {
 		alg := keystore.SECP256K1
-		sign, err := pod.remoteSign(alg, block.Hash())
+		pbBlock, err := core.NewCompactBlock(block).ToProto()
+		if err != nil {
+			return err
+		}
+		sign, err := pod.remoteSigner.SignBlock(context.Background(), &signerpb.SignBlockRequest{
+			Address: pod.miner.String(),
+			Alg:     uint32(alg),
+			Block:   pbBlock.(*corepb.CompactBlock),
+		})
 		if err != nil {
 			return err
 		}
@@ -529,26 +581,6 @@ func (pod *PoD) signBlock(block *core.Block) error {
 	}
 }
 
-func (pod *PoD) remoteSign(alg keystore.Algorithm, hash byteutils.Hash) (byteutils.Hash, error) {
-	conn, err := rpc.Dial(pod.remoteSignServer)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Close()
-	remoteSignClient := rpcpb.NewAdminServiceClient(conn)
-
-	result, err := remoteSignClient.SignHash(context.Background(),
-		&rpcpb.SignHashRequest{
-			Address: pod.miner.String(),
-			Hash:    hash,
-			Alg:     uint32(alg),
-		})
-	if err != nil {
-		return nil, err
-	}
-	return result.Data, nil
-}
-
 func (pod *PoD) unlock(passphrase string) error {
 	if pod.enableRemoteSignServer == false {
 		return pod.am.Unlock(pod.miner, []byte(passphrase), DefaultMaxUnlockDuration)
@@ -571,7 +603,7 @@ func (pod *PoD) newBlock(tail *core.Block, consensusState state.ConsensusState,
 	}
 
 	if core.RandomAvailableAtHeight(block.Height()) {
-		if err := pod.generateRandomSeed(block); err != nil {
+		if err := pod.generateRandomSeed(block, consensusState.TimeStamp()); err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"block": block,
 				"err":   err,
@@ -614,41 +646,24 @@ func (pod *PoD) newBlock(tail *core.Block, consensusState state.ConsensusState,
 	return block, nil
 }
 
-func lastSlot(nowInMs int64) int64 {
-	return int64((nowInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
-}
-
-func nextSlot(nowInMs int64) int64 {
-	return int64((nowInMs+BlockIntervalInMs-SecondInMs)/BlockIntervalInMs) * BlockIntervalInMs
-}
-
-func deadline(nowInMs int64) int64 {
-	nextSlotInMs := nextSlot(nowInMs)
-	remainInMs := nextSlotInMs - nowInMs
-	if MaxMintDurationInMs > remainInMs {
-		return nextSlotInMs
-	}
-	return nowInMs + MaxMintDurationInMs
-}
-
 func (pod *PoD) checkDeadline(tail *core.Block, nowInMs int64) (int64, error) {
-	lastSlotInMs := lastSlot(nowInMs)
-	nextSlotInMs := nextSlot(nowInMs)
+	lastSlotInMs := pod.params.lastSlot(nowInMs)
+	nextSlotInMs := pod.params.nextSlot(nowInMs)
 
 	if tail.Timestamp()*SecondInMs >= nextSlotInMs {
 		return 0, ErrBlockMintedInNextSlot
 	}
 	if tail.Timestamp()*SecondInMs == lastSlotInMs {
-		return deadline(nowInMs), nil
+		return pod.params.deadline(nowInMs), nil
 	}
-	if nextSlotInMs-nowInMs <= MinMintDurationInMs {
-		return deadline(nowInMs), nil
+	if nextSlotInMs-nowInMs <= pod.params.minMintDurationInMs() {
+		return pod.params.deadline(nowInMs), nil
 	}
 	return 0, ErrWaitingBlockInLastSlot
 }
 
 func (pod *PoD) checkProposer(tail *core.Block, nowInMs int64) (state.ConsensusState, error) {
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := pod.params.nextSlot(nowInMs)
 	elapsedInMs := slotInMs - tail.Timestamp()*SecondInMs
 	consensusState, err := tail.WorldState().NextConsensusState(elapsedInMs / SecondInMs)
 	if err != nil {
@@ -712,6 +727,11 @@ func (pod *PoD) mintBlock(now int64) error {
 		return ErrCannotMintWhenPending
 	}
 
+	// check the lease of the active miner
+	if pod.Standby() {
+		return ErrCannotMintWhenStandby
+	}
+
 	tail := pod.chain.TailBlock()
 
 	deadlineInMs, err := pod.checkDeadline(tail, nowInMs)
@@ -749,7 +769,7 @@ func (pod *PoD) mintBlock(now int64) error {
 		return err
 	}
 
-	slotInMs := nextSlot(nowInMs)
+	slotInMs := pod.params.nextSlot(nowInMs)
 	currentInMs := time.Now().Unix() * SecondInMs
 	if slotInMs > currentInMs {
 		timer := time.NewTimer(time.Duration(slotInMs-currentInMs) * time.Millisecond).C
@@ -781,7 +801,7 @@ func (pod *PoD) mintBlock(now int64) error {
 
 func (pod *PoD) heartbeat(now int64) error {
 	// check mining enable
-	if !pod.enable || pod.pending {
+	if !pod.enable || pod.pending || pod.Standby() {
 		return ErrNoHeartbeatWhenDisable
 	}
 
@@ -805,7 +825,7 @@ func (pod *PoD) heartbeat(now int64) error {
 	miner := pod.miner.String()
 
 	// check if heartbeat record on chain
-	if (now-pod.heartbeatTimestamp)%(BlockIntervalInMs/SecondInMs) == 0 {
+	if (now-pod.heartbeatTimestamp)%(pod.params.BlockIntervalInMs/SecondInMs) == 0 {
 		participants, err := pod.dynasty.getParticipants()
 		if err != nil {
 			return err
@@ -872,7 +892,7 @@ func (pod *PoD) heartbeat(now int64) error {
 // and submit last serial block mint statics
 func (pod *PoD) triggerState(now int64) error {
 	// check mining enable
-	if !pod.enable || pod.pending {
+	if !pod.enable || pod.pending || pod.Standby() {
 		return nil
 	}
 
@@ -934,8 +954,15 @@ func (pod *PoD) blockLoop() {
 		case now := <-timeChan:
 			metricsLruPoolSlotBlock.Update(int64(pod.slot.Len()))
 			timestamp := now.Unix()
+			pod.failoverTick(timestamp)
 			pod.heartbeat(timestamp)
 			pod.mintBlock(timestamp)
+			if timestamp%(pod.params.BlockIntervalInMs/SecondInMs) == 0 {
+				go pod.submitEvidence(timestamp)
+			}
+			if timestamp%PerformanceMetricsIntervalInS == 0 {
+				go pod.updatePerformanceMetrics()
+			}
 		case <-pod.quitCh:
 			logging.CLog().Info("Stopped pod Mining.")
 			return
@@ -943,12 +970,22 @@ func (pod *PoD) blockLoop() {
 			switch message.MessageType() {
 			case MessageTypeWitness:
 				pod.onWitnessReceived(message)
+			case MessageTypeAggregatedWitness:
+				pod.onAggregatedWitnessReceived(message)
+			case MessageTypeMinerLease:
+				pod.onMinerLeaseReceived(message)
+			case MessageTypeEvidence:
+				pod.onEvidenceReceived(message)
 			default:
 				logging.VLog().WithFields(logrus.Fields{
 					"messageName": message.MessageType(),
 				}).Warn("Received unknown message.")
 			}
 		case event := <-pod.eventSub.EventChan():
+			if event.Topic == core.TopicNewTailBlock {
+				pod.commitEvidence(event.Data)
+				continue
+			}
 			serial, err := strconv.ParseInt(event.Data, 10, 64)
 			if err == nil {
 				go pod.dynasty.loadFromContract(serial)
@@ -963,7 +1000,7 @@ func (pod *PoD) blockLoop() {
 
 // NumberOfBlocksInDynasty number of blocks in one dynasty
 func (pod *PoD) NumberOfBlocksInDynasty() uint64 {
-	return uint64(DynastyIntervalInMs) / uint64(BlockIntervalInMs)
+	return uint64(pod.params.DynastyIntervalInMs) / uint64(pod.params.BlockIntervalInMs)
 }
 
 // sendTransaction send pod consensus transaction
@@ -1010,7 +1047,15 @@ func (pod *PoD) sendTransaction(timestamp int64, action string, data []byte) err
 func (pod *PoD) signTransaction(tx *core.Transaction) error {
 	if pod.enableRemoteSignServer {
 		alg := keystore.SECP256K1
-		sign, err := pod.remoteSign(alg, tx.Hash())
+		pbTx, err := tx.ToProto()
+		if err != nil {
+			return err
+		}
+		sign, err := pod.remoteSigner.SignTransaction(context.Background(), &signerpb.SignTransactionRequest{
+			Address:     pod.miner.String(),
+			Alg:         uint32(alg),
+			Transaction: pbTx.(*corepb.Transaction),
+		})
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,188 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"encoding/json"
+
+	"github.com/gogo/protobuf/proto"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// PendingEvidence return the evidence waiting for the report committed.
+func (pod *PoD) PendingEvidence() []*core.Evidence {
+	return pod.evidence.Pending()
+}
+
+// CommittedEvidence return the evidence reported on chain.
+func (pod *PoD) CommittedEvidence() []*core.Evidence {
+	return pod.evidence.Committed()
+}
+
+// reportEvil keeps the evidence of the conflicting blocks and gossips it,
+// it's reported by any proposer in the dynasty.
+func (pod *PoD) reportEvil(preBlock, block *core.Block) error {
+	if !preBlock.Miner().Equals(block.Miner()) {
+		//FIXME: Current block mint strategy is, no new block mint dynasty, use the last block mint dynasty
+		// Therefore, for the non - block node block temporarily do not punish, to prevent accidental injury.
+		logging.VLog().WithFields(logrus.Fields{
+			"timestamp": block.Timestamp(),
+			"serial":    pod.dynasty.serial(block.Timestamp()),
+			"curBlock":  block.Hash(),
+			"preBlock":  preBlock.Hash(),
+		}).Warn("Not the miner for report evil.")
+		return nil
+	}
+
+	evidence, err := NewEvidence(pod.chain.ChainID(), preBlock, block)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"curBlock": block,
+			"preBlock": preBlock,
+			"err":      err,
+		}).Debug("Failed to create evidence.")
+		return err
+	}
+	if pod.evidence.Add(evidence) {
+		logging.VLog().WithFields(logrus.Fields{
+			"timestamp": evidence.Timestamp(),
+			"miner":     evidence.Miner(),
+			"curBlock":  block.Hash(),
+			"preBlock":  preBlock.Hash(),
+		}).Info("Found evidence of double mint.")
+		pod.ns.Broadcast(MessageTypeEvidence, evidence, net.MessagePriorityHigh)
+	}
+	return nil
+}
+
+func (pod *PoD) onEvidenceReceived(msg net.Message) error {
+	evidence := new(Evidence)
+	pbEvidence := new(consensuspb.Evidence)
+	if err := proto.Unmarshal(msg.Data(), pbEvidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return err
+	}
+	if err := evidence.FromProto(pbEvidence); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover an evidence from proto data.")
+		return err
+	}
+	if err := evidence.verify(pod.chain.ChainID()); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"err":     err,
+		}).Debug("Failed to verify evidence.")
+		return err
+	}
+
+	// only the miner proposing in the slot can be reported.
+	found, err := pod.dynasty.isProposer(evidence.Timestamp(), evidence.Miner().Bytes())
+	if !found || err != nil {
+		return err
+	}
+
+	if pod.evidence.Add(evidence) {
+		pod.ns.Relay(MessageTypeEvidence, evidence, net.MessagePriorityHigh)
+	}
+	return nil
+}
+
+// submitEvidence sends the report of a pending evidence if the miner is a proposer in the dynasty.
+func (pod *PoD) submitEvidence(now int64) error {
+	if !pod.enable || pod.pending || pod.Standby() {
+		return nil
+	}
+	if !core.NodeUpdateAtHeight(pod.chain.TailBlock().Height()) {
+		return nil
+	}
+	found, err := pod.dynasty.isProposer(now, pod.miner.Bytes())
+	if !found || err != nil {
+		return err
+	}
+
+	evidence := pod.evidence.Next(now*SecondInMs, pod.params)
+	if evidence == nil {
+		return nil
+	}
+	bytes, err := evidence.report().ToBytes()
+	if err != nil {
+		return err
+	}
+	err = pod.sendTransaction(evidence.Timestamp(), core.PoDReport, bytes)
+	logging.VLog().WithFields(logrus.Fields{
+		"timestamp": evidence.Timestamp(),
+		"serial":    pod.dynasty.serial(evidence.Timestamp()),
+		"evil":      evidence.Miner(),
+		"miner":     pod.miner,
+		"error":     err,
+	}).Info("Send report evil tx.")
+	return err
+}
+
+// commitEvidence moves the evidence reported in the new tail block to committed.
+func (pod *PoD) commitEvidence(data string) {
+	tail := new(struct {
+		Hash string `json:"hash"`
+	})
+	if err := json.Unmarshal([]byte(data), tail); err != nil {
+		return
+	}
+	hash, err := byteutils.FromHex(tail.Hash)
+	if err != nil {
+		return
+	}
+	block := pod.chain.GetBlock(hash)
+	if block == nil {
+		return
+	}
+
+	for _, tx := range block.Transactions() {
+		if tx.Type() != core.TxPayloadPodType {
+			continue
+		}
+		payload, err := core.LoadPodPayload(tx.Data())
+		if err != nil || payload.Action != core.PoDReport {
+			continue
+		}
+		report := new(core.Report)
+		if err := report.FromBytes(payload.Data); err != nil {
+			continue
+		}
+		if pod.evidence.Commit(report, block.Height(), tx.From().String()) {
+			logging.VLog().WithFields(logrus.Fields{
+				"timestamp": report.Timestamp,
+				"evil":      report.Miner,
+				"reporter":  tx.From(),
+				"block":     block,
+			}).Info("Evil report is committed.")
+		}
+	}
+}
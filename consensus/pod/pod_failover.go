@@ -0,0 +1,193 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/net"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Standby returns if mining is suspended for the lease of another active miner.
+func (pod *PoD) Standby() bool {
+	return pod.failover != nil && !pod.failover.Active()
+}
+
+// failoverTick renews the lease, a standby takes over the mining once the lease of the active one expires.
+func (pod *PoD) failoverTick(now int64) {
+	if pod.failover == nil {
+		return
+	}
+	// the lease is handed over while mining is disabled or pending.
+	if !pod.enable || pod.pending {
+		if pod.failover.Active() {
+			if err := pod.failover.Release(); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"holder": pod.failover.Holder(),
+					"err":    err,
+				}).Warn("Failed to release the miner lease.")
+			}
+			metricsMinerActive.Update(0)
+			logging.CLog().WithFields(logrus.Fields{
+				"holder": pod.failover.Holder(),
+				"miner":  pod.miner,
+			}).Info("Released the miner lease.")
+		}
+		return
+	}
+
+	slot, hash := pod.slashing.LastBlock(pod.miner)
+	active := pod.failover.Active()
+	other, err := pod.failover.Tick(now*SecondInMs, slot, hash)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"holder": pod.failover.Holder(),
+			"err":    err,
+		}).Warn("Failed to renew the miner lease.")
+	}
+	// never sign in the slots of the blocks signed by the other holder.
+	if other != nil {
+		if err := pod.slashing.ObserveBlock(pod.miner, other.Slot, other.Hash); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"lease": other,
+				"err":   err,
+			}).Warn("Failed to record the block signed by the lease holder.")
+		}
+	}
+
+	if pod.failover.Active() {
+		metricsMinerActive.Update(1)
+		if !active {
+			logging.CLog().WithFields(logrus.Fields{
+				"holder": pod.failover.Holder(),
+				"miner":  pod.miner,
+			}).Info("Took over pod mining as the active miner.")
+		}
+	} else {
+		metricsMinerActive.Update(0)
+		if active {
+			logging.CLog().WithFields(logrus.Fields{
+				"holder": pod.failover.Holder(),
+				"miner":  pod.miner,
+				"lease":  other,
+			}).Warn("Stepped down as a standby miner.")
+		}
+	}
+
+	if pod.failover.Heartbeat(now * SecondInMs) {
+		go pod.broadcastMinerLease(now, slot, hash)
+	}
+}
+
+func (pod *PoD) broadcastMinerLease(now int64, slot int64, hash byteutils.Hash) error {
+	lease := &MinerLease{
+		miner:     pod.miner.Bytes(),
+		holder:    pod.failover.Holder(),
+		slot:      slot,
+		hash:      hash,
+		timestamp: now * SecondInMs,
+	}
+	alg := keystore.SECP256K1
+	var (
+		sign byteutils.Hash
+		err  error
+	)
+	if pod.enableRemoteSignServer {
+		var pbLease proto.Message
+		if pbLease, err = lease.ToProto(); err != nil {
+			return err
+		}
+		sign, err = pod.remoteSigner.SignLease(context.Background(), &signerpb.SignLeaseRequest{
+			Address: pod.miner.String(),
+			Alg:     uint32(alg),
+			Lease:   pbLease.(*consensuspb.MinerLease),
+		})
+	} else {
+		sign, err = pod.am.SignHash(pod.miner, lease.Hash(), alg)
+	}
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"miner": pod.miner,
+			"err":   err,
+		}).Error("Failed to sign miner lease.")
+		return err
+	}
+	lease.alg = alg
+	lease.sign = sign
+	pod.ns.Broadcast(MessageTypeMinerLease, lease, net.MessagePriorityHigh)
+	return nil
+}
+
+func (pod *PoD) onMinerLeaseReceived(msg net.Message) error {
+	lease := new(MinerLease)
+	pbLease := new(consensuspb.MinerLease)
+	if err := proto.Unmarshal(msg.Data(), pbLease); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return err
+	}
+	if err := lease.FromProto(pbLease); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover a miner lease from proto data.")
+		return err
+	}
+
+	signer, err := core.RecoverSignerFromSignature(lease.alg, lease.Hash(), lease.sign)
+	if err != nil {
+		return err
+	}
+	from, err := core.AddressParseFromBytes(lease.miner)
+	if err != nil {
+		return err
+	}
+	if !from.Equals(signer) {
+		logging.VLog().WithFields(logrus.Fields{
+			"signer": signer,
+			"holder": lease.holder,
+		}).Debug("Failed to verify miner lease's sign.")
+		return ErrInvalidMinerLeaseSign
+	}
+
+	// only the leases of the miners in dynasty are relayed.
+	found, err := pod.dynasty.isProposer(pod.chain.TailBlock().Timestamp(), lease.miner)
+	if !found || err != nil {
+		return err
+	}
+
+	if pod.failover != nil && pod.miner != nil && pod.miner.Equals(signer) {
+		pod.failover.Observe(lease.holder, lease.timestamp, lease.slot, lease.hash)
+	}
+
+	pod.ns.Relay(MessageTypeMinerLease, lease, net.MessagePriorityHigh)
+	return nil
+}
@@ -80,12 +80,12 @@ func (pod *PoD) CheckTimeout(block *core.Block) bool {
 		return false
 	}
 	behindInMs := nowInMs - blockTimeInMs
-	if behindInMs > AcceptedNetWorkDelayInMs {
+	if behindInMs > pod.params.acceptedNetworkDelayInMs() {
 		logging.VLog().WithFields(logrus.Fields{
 			"block": block,
 			"now":   nowInMs,
 			"diff":  behindInMs,
-			"limit": AcceptedNetWorkDelayInMs,
+			"limit": pod.params.acceptedNetworkDelayInMs(),
 			"err":   "timeout - expired block",
 		}).Warn("Found a expired block.")
 		return true
@@ -99,10 +99,14 @@ func (pod *PoD) GenesisConsensusState(chain *core.BlockChain, conf *corepb.Genes
 	if err != nil {
 		return nil, err
 	}
-	if len(conf.Consensus.Dpos.Dynasty) < ConsensusSize {
+	params, err := NewParams(conf)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Consensus.Dpos.Dynasty) < params.ConsensusSize {
 		return nil, ErrInitialDynastyNotEnough
 	}
-	if len(conf.Consensus.Dpos.Dynasty) != DynastySize {
+	if len(conf.Consensus.Dpos.Dynasty) != params.DynastySize {
 		return nil, ErrInvalidDynasty
 	}
 	for i := 0; i < len(conf.Consensus.Dpos.Dynasty); i++ {
@@ -185,29 +189,6 @@ func (ds *State) DynastyRoot() byteutils.Hash {
 	return ds.dynastyTrie.RootHash()
 }
 
-// FindProposer for now in given dynasty
-func FindProposer(now int64, miners []byteutils.Hash) (proposer byteutils.Hash, err error) {
-	nowInMs := now * SecondInMs
-	offsetInMs := nowInMs % DynastyIntervalInMs
-	if (offsetInMs % BlockIntervalInMs) != 0 {
-		return nil, ErrNotBlockForgTime
-	}
-	offset := offsetInMs / BlockIntervalInMs
-	offset %= DynastySize
-
-	if offset >= 0 && int(offset) < len(miners) {
-		proposer = miners[offset]
-	} else {
-		logging.VLog().WithFields(logrus.Fields{
-			"proposer":  proposer,
-			"offset":    offset,
-			"delegatee": len(miners),
-		}).Warn("Found Nil Proposer.")
-		return nil, ErrFoundNilProposer
-	}
-	return proposer, nil
-}
-
 // Proposer return the current proposer
 func (ds *State) Proposer() byteutils.Hash {
 	return ds.proposer
@@ -220,17 +201,16 @@ func (ds *State) TimeStamp() int64 {
 
 // NextConsensusState return the new state after some seconds elapsed
 func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldState) (state.ConsensusState, error) {
-	elapsedSecondInMs := elapsedSecond * SecondInMs
-	if elapsedSecondInMs <= 0 || elapsedSecondInMs%BlockIntervalInMs != 0 {
-		return nil, ErrNotBlockForgTime
-	}
-
 	pod, ok := ds.consensus.(*PoD)
 	if !ok {
 		logging.VLog().WithFields(logrus.Fields{
 			"timestamp": ds.timestamp,
 		}).Fatal("Type conversion failed, unexpected error.")
 	}
+	elapsedSecondInMs := elapsedSecond * SecondInMs
+	if elapsedSecondInMs <= 0 || elapsedSecondInMs%pod.params.BlockIntervalInMs != 0 {
+		return nil, ErrNotBlockForgTime
+	}
 	nextTimestamp := ds.timestamp + elapsedSecond
 	dynastyTrie, err := pod.dynasty.getDynasty(nextTimestamp)
 	if err != nil {
@@ -250,7 +230,7 @@ func (ds *State) NextConsensusState(elapsedSecond int64, worldState state.WorldS
 	if err != nil {
 		return nil, err
 	}
-	consensusState.proposer, err = FindProposer(consensusState.timestamp, miners)
+	consensusState.proposer, err = pod.params.FindProposer(consensusState.timestamp, miners)
 	if err != nil {
 		return nil, err
 	}
@@ -19,18 +19,38 @@
 package pod
 
 import (
+	"context"
+
 	mapset "github.com/deckarep/golang-set"
 	"github.com/gogo/protobuf/proto"
 	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
 	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/net"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
 func (pod *PoD) broadcastWitness(hashs []byteutils.Hash) error {
+	// the blocks conflicting with the witnessed ones are not witnessed again.
+	if pod.slashing != nil {
+		blocks, err := pod.witnessBlocks(hashs)
+		if err != nil {
+			return err
+		}
+		hashs = []byteutils.Hash{}
+		for _, block := range pod.slashing.FilterWitness(pod.miner, blocks) {
+			hashs = append(hashs, block.Hash())
+		}
+		if len(hashs) == 0 {
+			return nil
+		}
+	}
+
 	witness := &Witness{
 		witness:    pod.miner.Bytes(),
 		blockHashs: hashs,
@@ -43,21 +63,51 @@ func (pod *PoD) broadcastWitness(hashs []byteutils.Hash) error {
 		}).Error("Failed to sign witness.")
 		return err
 	}
-	pod.ns.Broadcast(MessageTypeWitness, witness, net.MessagePriorityNormal)
+	// the witness is combined with the others of the same blocks.
+	serial, err := pod.witnessSerial(hashs)
+	if err != nil {
+		return err
+	}
+	aggregate, err := pod.aggregateWitness(serial, witness)
+	if err != nil {
+		return err
+	}
 	//logging.VLog().WithFields(logrus.Fields{
 	//	"miner": pod.miner,
 	//	"hash":  hashs,
 	//}).Debug("Broadcast witness to peers.")
-	return nil
+	return pod.acceptAggregatedWitness(aggregate, true)
 }
 
 // signWitness sign witness
 func (pod *PoD) signWitness(witness *Witness) (err error) {
+	blocks, err := pod.witnessBlocks(witness.blockHashs)
+	if err != nil {
+		return err
+	}
+	if pod.slashing != nil {
+		if err := pod.slashing.CheckAndRecordWitness(pod.miner, blocks); err != nil {
+			return err
+		}
+	}
+
 	hash := witness.Hash()
 	alg := keystore.SECP256K1
 	var sign byteutils.Hash
 	if pod.enableRemoteSignServer {
-		sign, err = pod.remoteSign(alg, hash)
+		witnessBlocks := make([]*corepb.CompactBlock, len(blocks))
+		for k, v := range blocks {
+			pbBlock, err := core.NewCompactBlock(v).ToProto()
+			if err != nil {
+				return err
+			}
+			witnessBlocks[k] = pbBlock.(*corepb.CompactBlock)
+		}
+		sign, err = pod.remoteSigner.SignWitness(context.Background(), &signerpb.SignWitnessRequest{
+			Address: pod.miner.String(),
+			Alg:     uint32(alg),
+			Blocks:  witnessBlocks,
+		})
 		if err != nil {
 			return err
 		}
@@ -72,6 +122,48 @@ func (pod *PoD) signWitness(witness *Witness) (err error) {
 	return nil
 }
 
+// witnessBlocks return the blocks of the hashes to witness.
+func (pod *PoD) witnessBlocks(hashs []byteutils.Hash) ([]*core.Block, error) {
+	blocks := make([]*core.Block, len(hashs))
+	for k, v := range hashs {
+		if blocks[k] = pod.chain.GetBlock(v); blocks[k] == nil {
+			return nil, ErrSlashingUnknownBlock
+		}
+	}
+	return blocks, nil
+}
+
+// witnessSerial return the serial of the latest witnessed block, the signers are the members of it.
+func (pod *PoD) witnessSerial(hashs []byteutils.Hash) (int64, error) {
+	var latest *core.Block
+	for _, v := range hashs {
+		if block := pod.chain.GetBlock(v); block != nil && (latest == nil || block.Timestamp() > latest.Timestamp()) {
+			latest = block
+		}
+	}
+	if latest == nil {
+		return 0, ErrWitnessBlocksNotFound
+	}
+	return pod.dynasty.serial(latest.Timestamp()), nil
+}
+
+// aggregateWitness return the aggregate of a signed witness by its index in the dynasty serial.
+func (pod *PoD) aggregateWitness(serial int64, witness *Witness) (*AggregatedWitness, error) {
+	members, err := pod.dynasty.members(serial)
+	if err != nil {
+		return nil, err
+	}
+	for idx, v := range members {
+		if v.Equals(witness.witness) {
+			aggregate := newAggregatedWitness(serial, witness.blockHashs, witness.alg)
+			aggregate.add(idx, witness.sign)
+			return aggregate, nil
+		}
+	}
+	return nil, ErrInvalidWitnessBitmap
+}
+
+// onWitnessReceived accepts the witness of a single miner as an aggregate.
 func (pod *PoD) onWitnessReceived(msg net.Message) error {
 	witness := new(Witness)
 	pbWitness := new(consensuspb.Witness)
@@ -95,49 +187,257 @@ func (pod *PoD) onWitnessReceived(msg net.Message) error {
 	if err := verifyWitnessSign(witness); err != nil {
 		return err
 	}
+	serial, err := pod.witnessSerial(witness.blockHashs)
+	if err == ErrWitnessBlocksNotFound {
+		// relay the witness of the blocks not received yet, as the legacy nodes do.
+		pod.ns.Relay(MessageTypeWitness, witness, net.MessagePriorityNormal)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	aggregate, err := pod.aggregateWitness(serial, witness)
+	if err != nil {
+		return err
+	}
+	return pod.acceptAggregatedWitness(aggregate, false)
+}
+
+func (pod *PoD) onAggregatedWitnessReceived(msg net.Message) error {
+	aggregate := new(AggregatedWitness)
+	pbAggregate := new(consensuspb.AggregatedWitness)
+	if err := proto.Unmarshal(msg.Data(), pbAggregate); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return err
+	}
+	if err := aggregate.FromProto(pbAggregate); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover an aggregated witness from proto data.")
+		return err
+	}
+	return pod.acceptAggregatedWitness(aggregate, false)
+}
+
+// acceptAggregatedWitness merges the verified witnesses into the local aggregate of the blocks,
+// the witnesses are relayed only if they add new signers.
+func (pod *PoD) acceptAggregatedWitness(aggregate *AggregatedWitness, local bool) error {
+	// the serial of the signers is the one of the blocks, not the sender's.
+	serial, err := pod.witnessSerial(aggregate.blockHashs)
+	if err != nil {
+		return err
+	}
+	if serial != aggregate.serial {
+		logging.VLog().WithFields(logrus.Fields{
+			"serial": aggregate.serial,
+			"blocks": serial,
+			"hash":   aggregate.blockHashs,
+		}).Debug("Failed to verify aggregated witness serial.")
+		return ErrInvalidWitnessSerial
+	}
+	members, err := pod.dynasty.members(serial)
+	if err != nil {
+		return err
+	}
+	if _, err := aggregate.signers(members); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"serial": aggregate.serial,
+			"hash":   aggregate.blockHashs,
+			"err":    err,
+		}).Debug("Failed to verify aggregated witness.")
+		return err
+	}
 
-	for _, v := range witness.blockHashs {
+	pod.aggregateMu.Lock()
+	key := aggregate.Hash().Hex()
+	merged, added := aggregate.clone(), aggregate.indexes()
+	if v, ok := pod.aggregates.Get(key); ok && v.(*AggregatedWitness).serial == aggregate.serial {
+		merged = v.(*AggregatedWitness)
+		added = merged.merge(aggregate)
+	} else {
+		pod.aggregates.Add(key, merged)
+	}
+	merged = merged.clone()
+	pod.aggregateMu.Unlock()
+	if len(added) == 0 {
+		return nil
+	}
+
+	for _, v := range merged.blockHashs {
 		block := pod.chain.GetBlock(v)
-		if block != nil {
-			found, err := pod.dynasty.isProposer(block.Timestamp(), witness.witness)
-			//logging.VLog().WithFields(logrus.Fields{
-			//	"msgType": msg.MessageType(),
-			//	"found": found,
-			//	"err":   err,
-			//}).Debug("Check witness proposer in dynasty.")
-			// if witness is not the miner in block's dynasty, don't relay the message.
-			if !found || err != nil {
-				return err
-			}
+		if block == nil {
+			continue
+		}
+		serial := pod.dynasty.serial(block.Timestamp())
 
-			// find local reversible blocks and update to the lib
-			reversibleSet, _ := pod.reversible.Get(v.Hex())
-			if reversibleSet == nil {
-				reversibleSet = mapset.NewSet()
+		// find local reversible blocks and update to the lib
+		reversibleSet, _ := pod.reversible.Get(v.Hex())
+		if reversibleSet == nil {
+			reversibleSet = mapset.NewSet()
+		}
+		for _, idx := range merged.indexes() {
+			signer := members[idx]
+			// the signer must be the miner in block's dynasty.
+			if serial != merged.serial {
+				if found, err := pod.dynasty.isProposer(block.Timestamp(), signer); !found || err != nil {
+					continue
+				}
 			}
-			reversibleSet.(mapset.Set).Add(witness.witness.Hex())
-			if reversibleSet.(mapset.Set).Cardinality() >= ConsensusSize {
-				logging.VLog().WithFields(logrus.Fields{
-					"hash": v.Hex(),
-					"set":  reversibleSet,
-					"len":  reversibleSet.(mapset.Set).Cardinality(),
-				}).Debug("Update lib by bft witness.")
-				pod.setLib(block, reversibleSet.(mapset.Set).Cardinality())
-			} else {
-				pod.reversible.Add(block.Hash().Hex(), reversibleSet)
+			if addr, err := core.AddressParseFromBytes(signer); err == nil {
+				pod.witnesses.add(serial, addr.String(), v.String())
 			}
+			reversibleSet.(mapset.Set).Add(signer.Hex())
+		}
+		if reversibleSet.(mapset.Set).Cardinality() >= pod.params.ConsensusSize {
+			logging.VLog().WithFields(logrus.Fields{
+				"hash": v.Hex(),
+				"set":  reversibleSet,
+				"len":  reversibleSet.(mapset.Set).Cardinality(),
+			}).Debug("Update lib by bft witness.")
+			pod.finalize(block, reversibleSet.(mapset.Set).Cardinality())
+		} else {
+			pod.reversible.Add(block.Hash().Hex(), reversibleSet)
 		}
 	}
 
-	pod.ns.Relay(MessageTypeWitness, witness, net.MessagePriorityNormal)
-
-	//logging.VLog().WithFields(logrus.Fields{
-	//	"msgType": msg.MessageType(),
-	//	"witness": witness,
-	//}).Debug("Receive witness from peers.")
+	pod.relayWitness(aggregate, merged, members, added, local)
 	return nil
 }
 
+// relayWitness relays the new signers of the aggregate. The peers aggregating the witnesses receive it
+// as it's received, so it's not sent again to the peers which have sent the same one, and only the
+// local witness is relayed in the merged aggregate. The others receive the witness of each new signer.
+func (pod *PoD) relayWitness(received, merged *AggregatedWitness, members []byteutils.Hash, added []int, local bool) {
+	relayed := received
+	if local {
+		relayed = merged
+	}
+	pod.ns.BroadcastByCapability(CapabilityAggregatedWitness, MessageTypeAggregatedWitness, relayed, "", nil, net.MessagePriorityNormal)
+	for _, idx := range added {
+		pod.ns.BroadcastByCapability(CapabilityAggregatedWitness, "", nil, MessageTypeWitness, merged.witness(members, idx), net.MessagePriorityNormal)
+	}
+}
+
+// finalize stores the certificate of the block from the aggregates witnessing it, and updates the lib.
+func (pod *PoD) finalize(block *core.Block, confirmed int) {
+	cert := &FinalityCertificate{
+		hash:   block.Hash(),
+		height: block.Height(),
+	}
+	pod.aggregateMu.Lock()
+	for _, key := range pod.aggregates.Keys() {
+		if v, ok := pod.aggregates.Peek(key); ok && v.(*AggregatedWitness).contains(block.Hash()) {
+			cert.witnesses = append(cert.witnesses, v.(*AggregatedWitness).clone())
+		}
+	}
+	pod.aggregateMu.Unlock()
+
+	if err := pod.storeFinalityCertificate(cert); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to store finality certificate.")
+	}
+	if block.Height() > pod.chain.LIB().Height() {
+		pod.setLib(block, confirmed)
+	} else {
+		pod.reversible.Remove(block.Hash().Hex())
+	}
+}
+
+func (pod *PoD) storeFinalityCertificate(cert *FinalityCertificate) error {
+	msg, err := cert.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return pod.chain.Storage().Put(finalityCertificateKey(cert.hash), data)
+}
+
+func (pod *PoD) loadFinalityCertificate(hash byteutils.Hash) (*FinalityCertificate, error) {
+	data, err := pod.chain.Storage().Get(finalityCertificateKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	pbCert := new(consensuspb.FinalityCertificate)
+	if err := proto.Unmarshal(data, pbCert); err != nil {
+		return nil, err
+	}
+	cert := new(FinalityCertificate)
+	if err := cert.FromProto(pbCert); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// FinalityCertificate return the certificate of a finalized block on canonical chain,
+// the certificate of the nearest certified descendant is returned for the block finalized as an ancestor.
+func (pod *PoD) FinalityCertificate(hash byteutils.Hash) (*core.FinalityCertificate, error) {
+	block := pod.chain.GetBlock(hash)
+	lib := pod.chain.LIB()
+	if block == nil || block.Height() > lib.Height() {
+		return nil, ErrBlockNotFinalized
+	}
+	if canonical := pod.chain.GetBlockOnCanonicalChainByHeight(block.Height()); canonical == nil || !canonical.Hash().Equals(hash) {
+		return nil, ErrBlockNotFinalized
+	}
+
+	for height := block.Height(); height <= lib.Height() && height < block.Height()+MaxCertificateSearch; height++ {
+		cur := pod.chain.GetBlockOnCanonicalChainByHeight(height)
+		if cur == nil {
+			break
+		}
+		cert, err := pod.loadFinalityCertificate(cur.Hash())
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result := &core.FinalityCertificate{
+			Hash:            block.Hash().String(),
+			Height:          block.Height(),
+			CertifiedHash:   cert.hash.String(),
+			CertifiedHeight: cert.height,
+		}
+		for _, v := range cert.witnesses {
+			result.Witnesses = append(result.Witnesses, pod.aggregatedWitnessInfo(v))
+		}
+		return result, nil
+	}
+	return nil, ErrCertificateNotFound
+}
+
+func (pod *PoD) aggregatedWitnessInfo(aggregate *AggregatedWitness) *core.AggregatedWitness {
+	info := &core.AggregatedWitness{Serial: aggregate.serial}
+	for _, v := range aggregate.blockHashs {
+		info.Blocks = append(info.Blocks, v.String())
+	}
+	if msg, err := aggregate.ToProto(); err == nil {
+		info.Bitmap = byteutils.Hex(msg.(*consensuspb.AggregatedWitness).Bitmap)
+	}
+	members, _ := pod.dynasty.members(aggregate.serial)
+	for _, idx := range aggregate.indexes() {
+		if idx < len(members) {
+			if addr, err := core.AddressParseFromBytes(members[idx]); err == nil {
+				info.Signers = append(info.Signers, addr.String())
+			}
+		}
+		info.Signs = append(info.Signs, aggregate.signs[idx].String())
+	}
+	return info
+}
+
 func verifyWitnessSign(witness *Witness) error {
 	signer, err := core.RecoverSignerFromSignature(witness.alg, witness.Hash(), witness.sign)
 	if err != nil {
@@ -0,0 +1,326 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in slashing protection
+var (
+	ErrSlashingDoubleSign     = errors.New("refuse to sign another block in a signed slot")
+	ErrSlashingSlotTooOld     = errors.New("refuse to sign a block in a slot before the signed one")
+	ErrSlashingDoubleWitness  = errors.New("refuse to witness another block at a witnessed height")
+	ErrSlashingWitnessTooOld  = errors.New("refuse to witness a block below the witness records")
+	ErrSlashingUnknownBlock   = errors.New("refuse to witness a block not found")
+	ErrSlashingImportConflict = errors.New("the imported slashing records conflict with the local ones")
+	ErrSlashingInvalidRecords = errors.New("invalid slashing records")
+	ErrSlashingNoDatadir      = errors.New("a datadir is required to persist the slashing protection")
+)
+
+// the slashing protection constants.
+const (
+	SlashingProtectionDir     = "slashing"
+	MaxWitnessRecords         = 512
+	slashingProtectionKey     = "slashing_protection"
+	slashingProtectionVersion = 1
+)
+
+type signedBlock struct {
+	Slot int64  `json:"slot"`
+	Hash string `json:"hash"`
+}
+
+type witnessedBlock struct {
+	Height uint64 `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+type slashingRecord struct {
+	Miner     string            `json:"miner"`
+	Block     *signedBlock      `json:"block,omitempty"`
+	Witnesses []*witnessedBlock `json:"witnesses,omitempty"`
+}
+
+type slashingRecords struct {
+	Version int               `json:"version"`
+	Records []*slashingRecord `json:"records"`
+}
+
+// SlashingProtection records the latest block and the witnesses signed by the miners,
+// and refuses to sign the conflicting ones, in case a miner runs twice.
+type SlashingProtection struct {
+	mu      sync.Mutex
+	storage storage.Storage
+	records map[string]*slashingRecord
+}
+
+// NewSlashingProtection return the slashing protection persisted in the storage.
+func NewSlashingProtection(stor storage.Storage) (*SlashingProtection, error) {
+	sp := &SlashingProtection{
+		storage: stor,
+		records: make(map[string]*slashingRecord),
+	}
+	data, err := stor.Get([]byte(slashingProtectionKey))
+	if err == storage.ErrKeyNotFound {
+		return sp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	records, err := parseSlashingRecords(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records.Records {
+		sp.records[record.Miner] = record
+	}
+	return sp, nil
+}
+
+// CheckAndRecordBlock records the block to sign by the miner in the slot,
+// it is refused if another block is signed in the slot or a later one.
+func (sp *SlashingProtection) CheckAndRecordBlock(miner *core.Address, slot int64, hash byteutils.Hash) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	record := sp.record(miner)
+	if record.Block != nil {
+		if slot < record.Block.Slot {
+			return ErrSlashingSlotTooOld
+		}
+		if slot == record.Block.Slot {
+			if record.Block.Hash != hash.String() {
+				logging.CLog().WithFields(logrus.Fields{
+					"miner":  miner,
+					"slot":   slot,
+					"signed": record.Block.Hash,
+					"hash":   hash.String(),
+				}).Error("Refused to sign another block in the slot.")
+				return ErrSlashingDoubleSign
+			}
+			return nil
+		}
+	}
+
+	record.Block = &signedBlock{Slot: slot, Hash: hash.String()}
+	return sp.save()
+}
+
+// LastBlock return the slot and hash of the latest block signed by the miner.
+func (sp *SlashingProtection) LastBlock(miner *core.Address) (int64, byteutils.Hash) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	record := sp.record(miner)
+	if record.Block == nil {
+		return 0, nil
+	}
+	hash, err := byteutils.FromHex(record.Block.Hash)
+	if err != nil {
+		return record.Block.Slot, nil
+	}
+	return record.Block.Slot, hash
+}
+
+// ObserveBlock records the block signed by the miner elsewhere, e.g. by the active one of
+// the miners sharing the key, so that no block is signed in its slot or an earlier one.
+func (sp *SlashingProtection) ObserveBlock(miner *core.Address, slot int64, hash byteutils.Hash) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	record := sp.record(miner)
+	if slot <= 0 || (record.Block != nil && slot <= record.Block.Slot) {
+		return nil
+	}
+	record.Block = &signedBlock{Slot: slot, Hash: hash.String()}
+	return sp.save()
+}
+
+// FilterWitness return the blocks which could be witnessed by the miner without conflicts.
+func (sp *SlashingProtection) FilterWitness(miner *core.Address, blocks []*core.Block) []*core.Block {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	record := sp.record(miner)
+	filtered := []*core.Block{}
+	for _, block := range blocks {
+		if checkWitness(record, block) == nil {
+			filtered = append(filtered, block)
+		}
+	}
+	return filtered
+}
+
+// CheckAndRecordWitness records the blocks to witness by the miner,
+// it is refused if another block at the same height is witnessed.
+func (sp *SlashingProtection) CheckAndRecordWitness(miner *core.Address, blocks []*core.Block) error {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	record := sp.record(miner)
+	for _, block := range blocks {
+		if err := checkWitness(record, block); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"miner": miner,
+				"block": block,
+				"err":   err,
+			}).Error("Refused to witness the block.")
+			return err
+		}
+	}
+	for _, block := range blocks {
+		record.Witnesses = addWitness(record.Witnesses, &witnessedBlock{Height: block.Height(), Hash: block.Hash().String()})
+	}
+	return sp.save()
+}
+
+// Export return the records in json.
+func (sp *SlashingProtection) Export() ([]byte, error) {
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	return json.MarshalIndent(sp.snapshot(), "", "  ")
+}
+
+// Import merges the exported records into the local ones, keeping the latest of both.
+// Nothing is imported if they conflict.
+func (sp *SlashingProtection) Import(data []byte) error {
+	records, err := parseSlashingRecords(data)
+	if err != nil {
+		return err
+	}
+
+	sp.mu.Lock()
+	defer sp.mu.Unlock()
+
+	merged := make(map[string]*slashingRecord)
+	for miner, record := range sp.records {
+		copied := *record
+		copied.Witnesses = append([]*witnessedBlock{}, record.Witnesses...)
+		merged[miner] = &copied
+	}
+	for _, imported := range records.Records {
+		record, ok := merged[imported.Miner]
+		if !ok {
+			record = &slashingRecord{Miner: imported.Miner}
+			merged[imported.Miner] = record
+		}
+		if imported.Block != nil {
+			if record.Block == nil || imported.Block.Slot > record.Block.Slot {
+				record.Block = imported.Block
+			} else if imported.Block.Slot == record.Block.Slot && imported.Block.Hash != record.Block.Hash {
+				return ErrSlashingImportConflict
+			}
+		}
+		for _, witness := range imported.Witnesses {
+			for _, v := range record.Witnesses {
+				if v.Height == witness.Height && v.Hash != witness.Hash {
+					return ErrSlashingImportConflict
+				}
+			}
+			record.Witnesses = addWitness(record.Witnesses, witness)
+		}
+	}
+
+	sp.records = merged
+	return sp.save()
+}
+
+func (sp *SlashingProtection) record(miner *core.Address) *slashingRecord {
+	record, ok := sp.records[miner.String()]
+	if !ok {
+		record = &slashingRecord{Miner: miner.String()}
+		sp.records[miner.String()] = record
+	}
+	return record
+}
+
+func (sp *SlashingProtection) snapshot() *slashingRecords {
+	records := &slashingRecords{Version: slashingProtectionVersion, Records: []*slashingRecord{}}
+	for _, record := range sp.records {
+		records.Records = append(records.Records, record)
+	}
+	sort.Slice(records.Records, func(i, j int) bool {
+		return records.Records[i].Miner < records.Records[j].Miner
+	})
+	return records
+}
+
+func (sp *SlashingProtection) save() error {
+	data, err := json.Marshal(sp.snapshot())
+	if err != nil {
+		return err
+	}
+	return sp.storage.Put([]byte(slashingProtectionKey), data)
+}
+
+func parseSlashingRecords(data []byte) (*slashingRecords, error) {
+	records := new(slashingRecords)
+	if err := json.Unmarshal(data, records); err != nil {
+		return nil, err
+	}
+	if records.Version != slashingProtectionVersion {
+		return nil, ErrSlashingInvalidRecords
+	}
+	for _, record := range records.Records {
+		if _, err := core.AddressParse(record.Miner); err != nil {
+			return nil, ErrSlashingInvalidRecords
+		}
+	}
+	return records, nil
+}
+
+func checkWitness(record *slashingRecord, block *core.Block) error {
+	if len(record.Witnesses) >= MaxWitnessRecords && block.Height() < record.Witnesses[0].Height {
+		return ErrSlashingWitnessTooOld
+	}
+	for _, v := range record.Witnesses {
+		if v.Height == block.Height() && v.Hash != block.Hash().String() {
+			return ErrSlashingDoubleWitness
+		}
+	}
+	return nil
+}
+
+// addWitness inserts the witness in order of height, the lowest ones out of the limit are dropped.
+func addWitness(witnesses []*witnessedBlock, witness *witnessedBlock) []*witnessedBlock {
+	idx := sort.Search(len(witnesses), func(i int) bool {
+		return witnesses[i].Height >= witness.Height
+	})
+	if idx < len(witnesses) && witnesses[idx].Height == witness.Height {
+		return witnesses
+	}
+	witnesses = append(witnesses, nil)
+	copy(witnesses[idx+1:], witnesses[idx:])
+	witnesses[idx] = witness
+	if len(witnesses) > MaxWitnessRecords {
+		witnesses = witnesses[len(witnesses)-MaxWitnessRecords:]
+	}
+	return witnesses
+}
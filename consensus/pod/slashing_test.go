@@ -0,0 +1,125 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package pod
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSealedBlock(t *testing.T, chain *core.BlockChain, coinbase string, parent *core.Block) *core.Block {
+	addr, err := core.AddressParse(coinbase)
+	assert.Nil(t, err)
+	block, err := chain.NewBlockFromParent(addr, parent)
+	assert.Nil(t, err)
+	assert.Nil(t, block.Seal())
+	return block
+}
+
+func TestSlashingProtection(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	sp, err := NewSlashingProtection(stor)
+	assert.Nil(t, err)
+	miner, err := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, err)
+
+	// blocks.
+	hash1, hash2 := byteutils.Hash([]byte("hash1")), byteutils.Hash([]byte("hash2"))
+	assert.Nil(t, sp.CheckAndRecordBlock(miner, 15, hash1))
+	assert.Nil(t, sp.CheckAndRecordBlock(miner, 15, hash1))
+	assert.Equal(t, ErrSlashingDoubleSign, sp.CheckAndRecordBlock(miner, 15, hash2))
+	assert.Nil(t, sp.CheckAndRecordBlock(miner, 30, hash2))
+	assert.Equal(t, ErrSlashingSlotTooOld, sp.CheckAndRecordBlock(miner, 15, hash1))
+
+	// witnesses.
+	chain := core.NewMockNeb(nil, nil, nil).BlockChain()
+	a2 := newSealedBlock(t, chain, "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", chain.GenesisBlock())
+	a3 := newSealedBlock(t, chain, "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE", a2)
+	b2 := newSealedBlock(t, chain, "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s", chain.GenesisBlock())
+	assert.Nil(t, sp.CheckAndRecordWitness(miner, []*core.Block{a2}))
+	assert.Nil(t, sp.CheckAndRecordWitness(miner, []*core.Block{a2, a3}))
+	assert.Equal(t, ErrSlashingDoubleWitness, sp.CheckAndRecordWitness(miner, []*core.Block{b2}))
+	assert.Equal(t, []*core.Block{a3}, sp.FilterWitness(miner, []*core.Block{b2, a3}))
+
+	// the records are persisted.
+	sp, err = NewSlashingProtection(stor)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrSlashingDoubleSign, sp.CheckAndRecordBlock(miner, 30, hash1))
+	assert.Equal(t, ErrSlashingDoubleWitness, sp.CheckAndRecordWitness(miner, []*core.Block{b2}))
+
+	// the exported records are merged on another machine.
+	data, err := sp.Export()
+	assert.Nil(t, err)
+	stor2, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	sp2, err := NewSlashingProtection(stor2)
+	assert.Nil(t, err)
+	assert.Nil(t, sp2.CheckAndRecordBlock(miner, 45, hash1))
+	assert.Nil(t, sp2.Import(data))
+	assert.Equal(t, ErrSlashingSlotTooOld, sp2.CheckAndRecordBlock(miner, 30, hash2))
+	assert.Equal(t, ErrSlashingDoubleSign, sp2.CheckAndRecordBlock(miner, 45, hash2))
+	assert.Equal(t, ErrSlashingDoubleWitness, sp2.CheckAndRecordWitness(miner, []*core.Block{b2}))
+
+	// the conflicting records are not imported.
+	stor3, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	sp3, err := NewSlashingProtection(stor3)
+	assert.Nil(t, err)
+	assert.Nil(t, sp3.CheckAndRecordWitness(miner, []*core.Block{b2}))
+	assert.Equal(t, ErrSlashingImportConflict, sp3.Import(data))
+	assert.Nil(t, sp3.CheckAndRecordBlock(miner, 15, hash2))
+	assert.Equal(t, ErrSlashingInvalidRecords, sp3.Import([]byte(`{"version":2}`)))
+}
+
+func TestSlashingProtection_witnessLimit(t *testing.T) {
+	witnesses := []*witnessedBlock{}
+	for i := MaxWitnessRecords + 10; i > 0; i-- {
+		witnesses = addWitness(witnesses, &witnessedBlock{Height: uint64(i), Hash: "hash"})
+	}
+	assert.Equal(t, MaxWitnessRecords, len(witnesses))
+	assert.Equal(t, uint64(11), witnesses[0].Height)
+	assert.Equal(t, uint64(MaxWitnessRecords+10), witnesses[MaxWitnessRecords-1].Height)
+}
+
+func TestSlashingProtection_datadir(t *testing.T) {
+	pod := newTestPoD(t)
+	neb := core.NewMockNeb(nil, pod, nil)
+
+	// the slashing protection is persisted in the datadir without the storage set.
+	pod.SetSlashingStorage(nil)
+	assert.Equal(t, ErrSlashingNoDatadir, pod.Setup(neb))
+
+	datadir := t.TempDir()
+	neb.Config().Chain.Datadir = datadir
+	assert.Nil(t, pod.Setup(neb))
+	assert.NotNil(t, pod.slashingDB)
+
+	// the storage is closed on stop, and can be opened again.
+	pod.Stop()
+	assert.Nil(t, pod.slashingDB)
+	stor, err := storage.NewDiskStorage(filepath.Join(datadir, SlashingProtectionDir))
+	assert.Nil(t, err)
+	assert.Nil(t, stor.Close())
+}
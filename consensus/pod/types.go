@@ -20,7 +20,6 @@ package pod
 
 import (
 	"errors"
-	"strconv"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/metrics"
@@ -36,14 +35,12 @@ const (
 
 // Consensus Related Constants
 const (
-	SecondInMs               = int64(1000)
-	BlockIntervalInMs        = int64(15000)
-	AcceptedNetWorkDelayInMs = int64(3750)
-	MaxMintDurationInMs      = int64(5250)
-	MinMintDurationInMs      = int64(2250)
-	DynastyIntervalInMs      = int64(3150000) //3150000, test:525000
-	DynastySize              = 21             //21, test: 7
-	ConsensusSize            = DynastySize*2/3 + 1
+	SecondInMs = int64(1000)
+
+	// defaults of mainnet, overridden by the params in genesis.
+	DefaultBlockIntervalInMs   = int64(15000)
+	DefaultDynastyIntervalInMs = int64(3150000)
+	DefaultDynastySize         = 21
 )
 
 // Errors in PoD Consensus
@@ -57,6 +54,7 @@ var (
 	ErrMissingConfigForDpos       = errors.New("missing configuration for Dpos")
 	ErrCannotMintWhenPending      = errors.New("cannot mint block now, waiting for cancel pending again")
 	ErrCannotMintWhenDisable      = errors.New("cannot mint block now, waiting for enable it again")
+	ErrCannotMintWhenStandby      = errors.New("cannot mint block now, waiting for the lease of the active miner")
 	ErrWaitingBlockInLastSlot     = errors.New("cannot mint block now, waiting for last block")
 	ErrBlockMintedInNextSlot      = errors.New("cannot mint block now, there is a block minted in current slot")
 	ErrGenerateNextConsensusState = errors.New("Failed to generate next consensus state")
@@ -65,13 +63,15 @@ var (
 	ErrInvalidArgument            = errors.New("invalid argument")
 	ErrInvalidProtoToWitness      = errors.New("protobuf message cannot be converted into Witness")
 	ErrInvalidWitnessSign         = errors.New("invalid witness sign")
+	ErrInvalidProtoToMinerLease   = errors.New("protobuf message cannot be converted into MinerLease")
+	ErrInvalidMinerLeaseSign      = errors.New("invalid miner lease sign")
 )
 
 // Errors in PoD state
 var (
-	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(ConsensusSize))
-	ErrInvalidDynasty          = errors.New("the size of dynasty is invalid, should be equal " + strconv.Itoa(DynastySize))
+	ErrTooFewCandidates        = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal the consensus size")
+	ErrInitialDynastyNotEnough = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal the consensus size")
+	ErrInvalidDynasty          = errors.New("the size of dynasty is invalid, should be equal the dynasty size")
 	ErrCloneDynastyTrie        = errors.New("Failed to clone dynasty trie")
 	ErrCloneNextDynastyTrie    = errors.New("Failed to clone next dynasty trie")
 	ErrCloneDelegateTrie       = errors.New("Failed to clone delegate trie")
@@ -96,9 +96,19 @@ var (
 	metricsBlockWaitingTime = metrics.NewGauge("neb.block.waiting")
 	metricsLruPoolSlotBlock = metrics.NewGauge("neb.block.lru.poolslot")
 	metricsMintBlock        = metrics.NewCounter("neb.block.mint")
+	metricsMinerActive      = metrics.NewGauge("neb.miner.active")
+	metricsDynastySerial    = metrics.NewGauge("neb.dynasty.serial")
+	metricsDynastyMissed    = metrics.NewGauge("neb.dynasty.missed")
 )
 
 // MessageType
 const (
-	MessageTypeWitness = "witness"
+	MessageTypeWitness           = "witness"
+	MessageTypeAggregatedWitness = "aggwitness"
+	MessageTypeMinerLease        = "minerlease"
+	MessageTypeEvidence          = "evidence"
 )
+
+// CapabilityAggregatedWitness is advertised by the nodes aggregating the witnesses,
+// the others receive the witness of each signer.
+const CapabilityAggregatedWitness = "aggwitness"
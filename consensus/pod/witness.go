@@ -23,7 +23,7 @@ import (
 	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
-	"github.com/nebulasio/go-nebulas/crypto/sha3"
+	"github.com/nebulasio/go-nebulas/signer"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
@@ -38,11 +38,7 @@ type Witness struct {
 }
 
 func (w *Witness) Hash() byteutils.Hash {
-	hasher := sha3.New256()
-	for _, v := range w.blockHashs {
-		hasher.Write(v)
-	}
-	return hasher.Sum(nil)
+	return signer.WitnessHash(w.blockHashs)
 }
 
 // ToProto converts domain BlockHeader to proto BlockHeader
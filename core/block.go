@@ -1388,34 +1388,43 @@ func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 
 // CalHash calculate the hash of block.
 func (block *Block) calHash() (byteutils.Hash, error) {
+	txHashes := make([]byteutils.Hash, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return calBlockHash(block.header, block.dependency, txHashes)
+}
+
+// calBlockHash calculate the hash of block from its header, dependency and tx hashes.
+func calBlockHash(header *BlockHeader, dependency *dag.Dag, txHashes []byteutils.Hash) (byteutils.Hash, error) {
 	hasher := sha3.New256()
 
-	consensusRoot, err := proto.Marshal(block.ConsensusRoot())
+	consensusRoot, err := proto.Marshal(header.consensusRoot)
 	if err != nil {
 		return nil, err
 	}
 
-	pbDep, err := block.dependency.ToProto()
+	pbDep, err := dependency.ToProto()
 	if err != nil {
 		return nil, err
 	}
-	dependency, err := proto.Marshal(pbDep)
+	pbDependency, err := proto.Marshal(pbDep)
 	if err != nil {
 		return nil, err
 	}
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
+	hasher.Write(header.parentHash)
+	hasher.Write(header.stateRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.eventsRoot)
 	hasher.Write(consensusRoot)
-	hasher.Write(dependency)
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+	hasher.Write(pbDependency)
+	hasher.Write(header.coinbase.address)
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	for _, hash := range txHashes {
+		hasher.Write(hash)
 	}
 
 	return hasher.Sum(nil), nil
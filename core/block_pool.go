@@ -39,6 +39,17 @@ import (
 // constants
 const (
 	NoSender = ""
+
+	// MaxBlocksByRange is the max count of blocks served in a range request.
+	MaxBlocksByRange = ChunkSize
+	// MaxBlocksByRangeWindows is the max count of consecutive range requests sent at once.
+	MaxBlocksByRangeWindows = 16
+	// BlocksByRangeTimeout is the time to wait before requesting the same range again.
+	BlocksByRangeTimeout = 5 * time.Second
+	// MaxBlocksByRangeFailures is the count of range requests not moving the tail before a full sync.
+	MaxBlocksByRangeFailures = 3
+	// CompactBlockTxsTimeout is the time to wait for the missing txs before asking another announcer.
+	CompactBlockTxsTimeout = 2 * time.Second
 )
 
 // BlockPool a pool of all received blocks from network.
@@ -52,6 +63,18 @@ type BlockPool struct {
 	bc    *BlockChain
 	cache *lru.Cache
 
+	compactRelay         bool
+	pendingCompactBlocks *lru.Cache
+
+	skipTimeoutCheck bool
+
+	lastRangeFrom     uint64
+	lastRangeAt       time.Time
+	lastRangeSender   string
+	lastRangeEnd      uint64
+	lastRangeTarget   uint64
+	lastRangeFailures int
+
 	ns net.Service
 	mu sync.RWMutex
 }
@@ -82,6 +105,11 @@ func NewBlockPool(size int) (*BlockPool, error) {
 		}
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	bp.pendingCompactBlocks, err = lru.New(size)
 	if err != nil {
 		return nil, err
 	}
@@ -93,9 +121,28 @@ func (pool *BlockPool) RegisterInNetwork(ns net.Service) {
 	ns.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, true, MessageTypeNewBlock, net.MessageWeightNewBlock))
 	ns.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, false, MessageTypeBlockDownloadResponse, net.MessageWeightZero))
 	ns.Register(net.NewSubscriber(pool, pool.receiveDownloadBlockMessageCh, false, MessageTypeParentBlockDownloadRequest, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, true, MessageTypeNewCompactBlock, net.MessageWeightNewBlock))
+	ns.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, false, MessageTypeCompactBlockTxsResponse, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(pool, pool.receiveDownloadBlockMessageCh, false, MessageTypeCompactBlockTxsRequest, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(pool, pool.receiveBlockMessageCh, false, MessageTypeBlocksByRangeResponse, net.MessageWeightZero))
+	ns.Register(net.NewSubscriber(pool, pool.receiveDownloadBlockMessageCh, false, MessageTypeBlocksByRangeRequest, net.MessageWeightZero))
+	ns.RegisterPeerFilterPolicy(PeerFilterPolicyBlock, MessageTypeNewBlock, MessageTypeNewCompactBlock)
+	ns.RegisterCapability(CapabilityCompactBlock)
 	pool.ns = ns
 }
 
+// SetCompactRelay set whether new blocks are relayed as compact blocks,
+// the peers not advertising CapabilityCompactBlock still get the full blocks.
+func (pool *BlockPool) SetCompactRelay(enable bool) {
+	pool.compactRelay = enable
+}
+
+// SetTimeoutCheck set whether the expired new blocks are dropped,
+// it is turned off to replay a capture whose blocks are old.
+func (pool *BlockPool) SetTimeoutCheck(enable bool) {
+	pool.skipTimeoutCheck = !enable
+}
+
 // Start start loop.
 func (pool *BlockPool) Start() {
 	logging.CLog().WithFields(logrus.Fields{
@@ -115,7 +162,18 @@ func (pool *BlockPool) Stop() {
 }
 
 func (pool *BlockPool) handleReceivedBlock(msg net.Message) {
-	if msg.MessageType() != MessageTypeNewBlock && msg.MessageType() != MessageTypeBlockDownloadResponse {
+	var block *Block
+	switch msg.MessageType() {
+	case MessageTypeNewBlock, MessageTypeBlockDownloadResponse:
+		block = pool.blockFromMessage(msg)
+	case MessageTypeNewCompactBlock:
+		block = pool.blockFromCompactMessage(msg)
+	case MessageTypeCompactBlockTxsResponse:
+		block = pool.blockFromCompactTxsMessage(msg)
+	case MessageTypeBlocksByRangeResponse:
+		pool.handleBlocksByRangeResponse(msg)
+		return
+	default:
 		logging.VLog().WithFields(logrus.Fields{
 			"msgType": msg.MessageType(),
 			"msg":     msg,
@@ -123,7 +181,29 @@ func (pool *BlockPool) handleReceivedBlock(msg net.Message) {
 		}).Debug("Received unregistered message.")
 		return
 	}
+	if block == nil {
+		return
+	}
+
+	if msg.MessageType() != MessageTypeBlockDownloadResponse && !pool.skipTimeoutCheck &&
+		pool.bc.ConsensusHandler().CheckTimeout(block) {
+		return
+	}
+
+	if msg.MessageType() != MessageTypeBlockDownloadResponse &&
+		pool.bc.ConsensusHandler().CheckDoubleMint(block) {
+		return
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block": block,
+		"type":  msg.MessageType(),
+	}).Debug("Received a new block.")
 
+	pool.PushAndRelay(msg.MessageFrom(), block)
+}
+
+func (pool *BlockPool) blockFromMessage(msg net.Message) *Block {
 	block := new(Block)
 	pbblock := new(corepb.Block)
 	if err := proto.Unmarshal(msg.Data(), pbblock); err != nil {
@@ -132,7 +212,7 @@ func (pool *BlockPool) handleReceivedBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Debug("Failed to unmarshal data.")
-		return
+		return nil
 	}
 	if err := block.FromProto(pbblock); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -140,25 +220,240 @@ func (pool *BlockPool) handleReceivedBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Debug("Failed to recover a block from proto data.")
-		return
+		return nil
 	}
+	return block
+}
 
-	if msg.MessageType() == MessageTypeNewBlock &&
-		pool.bc.ConsensusHandler().CheckTimeout(block) {
+// blockFromCompactMessage rebuild the block from the local tx pool,
+// and ask the sender for the missing txs if there are any.
+func (pool *BlockPool) blockFromCompactMessage(msg net.Message) *Block {
+	compact := new(CompactBlock)
+	pbCompact := new(corepb.CompactBlock)
+	if err := proto.Unmarshal(msg.Data(), pbCompact); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return nil
+	}
+	if err := compact.FromProto(pbCompact); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to recover a compact block from proto data.")
+		return nil
+	}
+
+	hash := compact.Hash()
+	if pool.cache.Contains(hash.Hex()) || pool.bc.GetBlock(hash) != nil {
+		metricsDuplicatedBlock.Inc(1)
+		return nil
+	}
+	if v, ok := pool.pendingCompactBlocks.Get(hash.Hex()); ok {
+		// another announcer to ask if the first one doesn't respond.
+		v.(*pendingCompactBlock).announce(msg.MessageFrom())
+		metricsDuplicatedBlock.Inc(1)
+		return nil
+	}
+
+	txs, missing := compact.reconstruct(pool.bc.TransactionPool())
+	if len(missing) == 0 {
+		metricsCompactBlockReconstructed.Inc(1)
+		return compact.toBlock(txs)
+	}
+
+	if err := pool.requestCompactBlockTxs(msg.MessageFrom(), compact, missing); err != nil {
+		return nil
+	}
+	pool.pendingCompactBlocks.Add(hash.Hex(), &pendingCompactBlock{
+		compact:     compact,
+		txs:         txs,
+		missing:     missing,
+		announcers:  []string{msg.MessageFrom()},
+		asked:       map[string]bool{msg.MessageFrom(): true},
+		requestedAt: time.Now(),
+	})
+	metricsCompactBlockMissingTx.Inc(int64(len(missing)))
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block":   hash.Hex(),
+		"txs":     len(txs),
+		"missing": len(missing),
+		"sender":  msg.MessageFrom(),
+	}).Debug("Request missing txs of compact block.")
+	return nil
+}
+
+// blockFromCompactTxsMessage complete a pending compact block with the received txs.
+func (pool *BlockPool) blockFromCompactTxsMessage(msg net.Message) *Block {
+	pbBlockTxs := new(corepb.BlockTxs)
+	if err := proto.Unmarshal(msg.Data(), pbBlockTxs); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return nil
+	}
+
+	key := byteutils.Hash(pbBlockTxs.BlockHash).Hex()
+	v, ok := pool.pendingCompactBlocks.Get(key)
+	if !ok {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": key,
+		}).Debug("No pending compact block for the received txs.")
+		return nil
+	}
+	pcb := v.(*pendingCompactBlock)
+	if !pcb.isAsked(msg.MessageFrom()) {
+		return nil
+	}
+
+	txs := make(Transactions, len(pbBlockTxs.Transactions))
+	for idx, v := range pbBlockTxs.Transactions {
+		tx := new(Transaction)
+		if err := tx.FromProto(v); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": key,
+				"err":   err,
+			}).Debug("Failed to recover a tx of compact block.")
+			return nil
+		}
+		txs[idx] = tx
+	}
+
+	if !pool.pendingCompactBlocks.Remove(key) {
+		// completed by another announcer.
+		return nil
+	}
+	if !pcb.fill(pbBlockTxs.Indexes, txs) {
+		logging.VLog().WithFields(logrus.Fields{
+			"block":  key,
+			"sender": msg.MessageFrom(),
+		}).Debug("Received mismatched txs of compact block.")
+		return nil
+	}
+	metricsCompactBlockReconstructed.Inc(1)
+	return pcb.compact.toBlock(pcb.txs)
+}
+
+// retryPendingCompactBlocks ask another announcer for the missing txs of the compact blocks
+// pending too long, the block is dropped when all of them are asked, to be announced again.
+func (pool *BlockPool) retryPendingCompactBlocks() {
+	now := time.Now()
+	for _, key := range pool.pendingCompactBlocks.Keys() {
+		v, ok := pool.pendingCompactBlocks.Peek(key)
+		if !ok {
+			continue
+		}
+		pcb := v.(*pendingCompactBlock)
+		announcer, expired := pcb.retry(now)
+		if !expired {
+			continue
+		}
+		if announcer == "" {
+			pool.pendingCompactBlocks.Remove(key)
+			logging.VLog().WithFields(logrus.Fields{
+				"block": key,
+			}).Debug("No announcer responded with the missing txs of compact block.")
+			continue
+		}
+		if err := pool.requestCompactBlockTxs(announcer, pcb.compact, pcb.missing); err != nil {
+			continue
+		}
+		logging.VLog().WithFields(logrus.Fields{
+			"block":     key,
+			"missing":   len(pcb.missing),
+			"announcer": announcer,
+		}).Debug("Request missing txs of compact block from another announcer.")
+	}
+}
+
+func (pool *BlockPool) requestCompactBlockTxs(sender string, compact *CompactBlock, missing []uint32) error {
+	request := &corepb.GetBlockTxs{
+		BlockHash: compact.Hash(),
+		Indexes:   missing,
+	}
+	bytes, err := proto.Marshal(request)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": compact.Hash().Hex(),
+			"err":   err,
+		}).Debug("Failed to marshal compact block txs request.")
+		return err
+	}
+	return pool.ns.SendMsg(MessageTypeCompactBlockTxsRequest, bytes, sender, net.MessagePriorityHigh)
+}
+
+func (pool *BlockPool) handleCompactBlockTxsRequest(msg net.Message) {
+	request := new(corepb.GetBlockTxs)
+	if err := proto.Unmarshal(msg.Data(), request); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
 		return
 	}
 
-	if msg.MessageType() == MessageTypeNewBlock &&
-		pool.bc.ConsensusHandler().CheckDoubleMint(block) {
+	var block *Block
+	if v, ok := pool.cache.Get(byteutils.Hash(request.BlockHash).Hex()); ok {
+		block = v.(*linkedBlock).block
+	}
+	if block == nil {
+		block = pool.bc.GetBlock(request.BlockHash)
+	}
+	if block == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": byteutils.Hex(request.BlockHash),
+		}).Debug("Failed to find the block asked for txs.")
 		return
 	}
 
-	logging.VLog().WithFields(logrus.Fields{
-		"block": block,
-		"type":  msg.MessageType(),
-	}).Debug("Received a new block.")
+	resp := &corepb.BlockTxs{
+		BlockHash:    request.BlockHash,
+		Indexes:      make([]uint32, 0, len(request.Indexes)),
+		Transactions: make([]*corepb.Transaction, 0, len(request.Indexes)),
+	}
+	for _, idx := range request.Indexes {
+		if int(idx) >= len(block.transactions) {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"index": idx,
+			}).Debug("Asked for a tx out of the block.")
+			return
+		}
+		pbTx, err := block.transactions[idx].ToProto()
+		if err != nil {
+			return
+		}
+		resp.Indexes = append(resp.Indexes, idx)
+		resp.Transactions = append(resp.Transactions, pbTx.(*corepb.Transaction))
+	}
 
-	pool.PushAndRelay(msg.MessageFrom(), block)
+	bytes, err := proto.Marshal(resp)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+			"err":   err,
+		}).Debug("Failed to marshal compact block txs.")
+		return
+	}
+	pool.ns.SendMsg(MessageTypeCompactBlockTxsResponse, bytes, msg.MessageFrom(), net.MessagePriorityHigh)
+}
+
+func (pool *BlockPool) handleDownloadRequest(msg net.Message) {
+	switch msg.MessageType() {
+	case MessageTypeCompactBlockTxsRequest:
+		pool.handleCompactBlockTxsRequest(msg)
+	case MessageTypeBlocksByRangeRequest:
+		pool.handleBlocksByRangeRequest(msg)
+	default:
+		pool.handleParentDownloadRequest(msg)
+	}
 }
 
 func (pool *BlockPool) handleParentDownloadRequest(msg net.Message) {
@@ -237,6 +532,74 @@ func (pool *BlockPool) handleParentDownloadRequest(msg net.Message) {
 	}).Debug("Responsed to the download request.")
 }
 
+func (pool *BlockPool) handleBlocksByRangeRequest(msg net.Message) {
+	request := new(corepb.GetBlocksByRange)
+	if err := proto.Unmarshal(msg.Data(), request); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return
+	}
+
+	resp, err := pool.bc.generateBlocksByRange(request)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"height": request.Height,
+			"count":  request.Count,
+			"skip":   request.Skip,
+			"err":    err,
+		}).Debug("Failed to generate the blocks by range.")
+		return
+	}
+	bytes, err := proto.Marshal(resp)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Failed to marshal the blocks by range.")
+		return
+	}
+	pool.ns.SendMsg(MessageTypeBlocksByRangeResponse, bytes, msg.MessageFrom(), net.MessagePriorityNormal)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"height": request.Height,
+		"count":  len(resp.Blocks),
+		"skip":   request.Skip,
+	}).Debug("Responsed to the blocks by range request.")
+}
+
+func (pool *BlockPool) handleBlocksByRangeResponse(msg net.Message) {
+	resp := new(corepb.BlocksByRange)
+	if err := proto.Unmarshal(msg.Data(), resp); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"msgType": msg.MessageType(),
+			"msg":     msg,
+			"err":     err,
+		}).Debug("Failed to unmarshal data.")
+		return
+	}
+
+	for _, pbBlock := range resp.Blocks {
+		block := new(Block)
+		if err := block.FromProto(pbBlock); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"msgType": msg.MessageType(),
+				"err":     err,
+			}).Debug("Failed to recover a block from the range.")
+			return
+		}
+		if err := pool.PushAndRelay(msg.MessageFrom(), block); err != nil && err != ErrDuplicatedBlock {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Debug("Failed to push a block from the range.")
+			return
+		}
+	}
+	pool.continueRange(msg.MessageFrom())
+}
+
 func (pool *BlockPool) loop() {
 	logging.CLog().Info("Started BlockPool.")
 	timerChan := time.NewTicker(time.Second).C
@@ -246,13 +609,14 @@ func (pool *BlockPool) loop() {
 			metricsCachedNewBlock.Update(int64(len(pool.receiveBlockMessageCh)))
 			metricsCachedDownloadBlock.Update(int64(len(pool.receiveDownloadBlockMessageCh)))
 			metricsLruPoolCacheBlock.Update(int64(pool.cache.Len()))
+			pool.retryPendingCompactBlocks()
 		case <-pool.quitCh:
 			logging.CLog().Info("Stopped BlockPool.")
 			return
 		case msg := <-pool.receiveBlockMessageCh:
 			go pool.handleReceivedBlock(msg)
 		case msg := <-pool.receiveDownloadBlockMessageCh:
-			go pool.handleParentDownloadRequest(msg)
+			go pool.handleDownloadRequest(msg)
 		}
 	}
 }
@@ -318,7 +682,12 @@ func (pool *BlockPool) PushAndBroadcast(block *Block) error {
 		return err
 	}
 
-	pool.ns.Broadcast(MessageTypeNewBlock, block, net.MessagePriorityHigh)
+	if pool.compactRelay {
+		pool.ns.BroadcastByCapability(CapabilityCompactBlock, MessageTypeNewCompactBlock, NewCompactBlock(block),
+			MessageTypeNewBlock, block, net.MessagePriorityHigh)
+	} else {
+		pool.ns.Broadcast(MessageTypeNewBlock, block, net.MessagePriorityHigh)
+	}
 
 	return pool.push(NoSender, block)
 }
@@ -379,6 +748,15 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 		return err
 	}
 
+	// the chains not containing the checkpoints are rejected.
+	if err := pool.bc.VerifyCheckpoint(block.height, block.Hash()); err != nil {
+		metricsInvalidBlock.Inc(1)
+		if sender != NoSender {
+			pool.ns.ClosePeer(sender, err)
+		}
+		return err
+	}
+
 	bc := pool.bc
 	cache := pool.cache
 
@@ -427,7 +805,12 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 	}
 
 	if sender != NoSender {
-		pool.ns.Relay(MessageTypeNewBlock, block, net.MessagePriorityHigh)
+		if pool.compactRelay {
+			pool.ns.BroadcastByCapability(CapabilityCompactBlock, MessageTypeNewCompactBlock, NewCompactBlock(block),
+				MessageTypeNewBlock, block, net.MessagePriorityHigh)
+		} else {
+			pool.ns.Relay(MessageTypeNewBlock, block, net.MessagePriorityHigh)
+		}
 	}
 
 	// found in BlockChain, then we can verify the state root, and tell the Consensus all the tails.
@@ -456,27 +839,100 @@ func (pool *BlockPool) startChainSync(gap int, sender string, block *Block) erro
 	if sender == NoSender {
 		return ErrMissingParentBlock
 	}
+	if pool.bc.IsActiveSyncing() {
+		return ErrInvalidBlockCannotFindParentInLocalAndTrySync
+	}
 
-	// do sync if there are so many empty slots.
+	// fill the missing blocks above the tail by consecutive ranges.
+	tail := pool.bc.TailBlock()
+	if block.height > tail.height+2 {
+		return pool.downloadRange(sender, tail.height+1, block.height)
+	}
+	// do sync if the fork is deeper than a chunk.
 	if gap > ChunkSize {
-		if pool.bc.StartActiveSync() {
-			logging.CLog().WithFields(logrus.Fields{
-				"tail":    pool.bc.tailBlock,
-				"block":   block,
-				"offline": gap,
-				"limit":   ChunkSize,
-			}).Warn("Offline too long, pend mining and restart sync from others.")
+		return pool.startActiveSync(block.height, "fork deeper than a chunk")
+	}
+	if err := pool.downloadParent(sender, block); err != nil {
+		return err
+	}
+	return ErrInvalidBlockCannotFindParentInLocalAndTryDownload
+}
+
+// startActiveSync pends mining and syncs from others.
+func (pool *BlockPool) startActiveSync(target uint64, reason string) error {
+	if pool.bc.StartActiveSync() {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":   pool.bc.tailBlock,
+			"target": target,
+			"reason": reason,
+		}).Warn("Pend mining and restart sync from others.")
+	}
+	return ErrInvalidBlockCannotFindParentInLocalAndTrySync
+}
+
+// downloadRange requests the blocks from the height up to the target, exclusive, by consecutive windows.
+// A full sync is started if the tail does not move after a few requests.
+func (pool *BlockPool) downloadRange(sender string, from uint64, target uint64) error {
+	if pool.lastRangeFrom == from {
+		// the range is being downloaded.
+		if time.Since(pool.lastRangeAt) < BlocksByRangeTimeout {
+			return ErrInvalidBlockCannotFindParentInLocalAndTryDownload
 		}
-		return ErrInvalidBlockCannotFindParentInLocalAndTrySync
+		pool.lastRangeFailures++
+		if pool.lastRangeFailures >= MaxBlocksByRangeFailures {
+			pool.lastRangeFrom = 0
+			pool.lastRangeFailures = 0
+			return pool.startActiveSync(target, "range requests failed")
+		}
+	} else {
+		pool.lastRangeFailures = 0
+	}
+
+	end := target
+	if max := from + MaxBlocksByRangeWindows*MaxBlocksByRange; end > max {
+		end = max
 	}
-	if !pool.bc.IsActiveSyncing() {
-		if err := pool.downloadParent(sender, block); err != nil {
+	for height := from; height < end; height += MaxBlocksByRange {
+		count := end - height
+		if count > MaxBlocksByRange {
+			count = MaxBlocksByRange
+		}
+		bytes, err := proto.Marshal(&corepb.GetBlocksByRange{Height: height, Count: uint32(count)})
+		if err != nil {
+			return err
+		}
+		if err := pool.ns.SendMsg(MessageTypeBlocksByRangeRequest, bytes, sender, net.MessagePriorityNormal); err != nil {
 			return err
 		}
 	}
+	pool.lastRangeFrom = from
+	pool.lastRangeAt = time.Now()
+	pool.lastRangeSender = sender
+	pool.lastRangeEnd = end
+	pool.lastRangeTarget = target
+
+	logging.VLog().WithFields(logrus.Fields{
+		"target": sender,
+		"from":   from,
+		"end":    end,
+		"height": target,
+	}).Info("Send blocks by range requests.")
+
 	return ErrInvalidBlockCannotFindParentInLocalAndTryDownload
 }
 
+// continueRange requests the next windows once the requested ones are on the chain.
+func (pool *BlockPool) continueRange(sender string) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if sender != pool.lastRangeSender || pool.lastRangeEnd >= pool.lastRangeTarget ||
+		pool.bc.TailBlock().height+1 != pool.lastRangeEnd {
+		return
+	}
+	pool.downloadRange(sender, pool.lastRangeEnd, pool.lastRangeTarget)
+}
+
 func vrfProof(block *Block, ancestorHash, parentSeed []byte) error {
 	signature, err := crypto.NewSignature(block.Alg())
 	if err != nil {
@@ -547,8 +1003,9 @@ func (lb *linkedBlock) travelToLinkAndReturnAllValidBlocks(parentBlock *Block) (
 		return nil, nil, err
 	}
 
-	// verify vrf
-	if RandomAvailableAtHeight(lb.block.height) {
+	// verify vrf, the dev chains seal blocks without it.
+	_, instantSeal := lb.chain.ConsensusHandler().(InstantSealer)
+	if RandomAvailableAtHeight(lb.block.height) && !instantSeal {
 		// prepare vrf inputs
 		var ancestorHash, parentSeed []byte
 
@@ -561,6 +1018,9 @@ func (lb *linkedBlock) travelToLinkAndReturnAllValidBlocks(parentBlock *Block) (
 		}
 		if tmp == nil {
 			if lb.block.height > nob*2 {
+				if err := lb.chain.CheckHistoryAvailable(lb.block.height - nob*2); err != nil {
+					return nil, nil, err
+				}
 				b := lb.chain.GetBlockOnCanonicalChainByHeight(lb.block.height - nob*2)
 				if b == nil {
 					logging.VLog().WithFields(logrus.Fields{
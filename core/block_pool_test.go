@@ -209,6 +209,41 @@ func TestHandleBlock(t *testing.T) {
 	assert.NotNil(t, bc.GetBlock(block.Hash()))
 }
 
+func TestBlockPool_checkpoint(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	assert.Equal(t, ErrInvalidCheckpoint, bc.SetCheckpoints(map[uint64]string{2: "0x01"}))
+	assert.Equal(t, ErrInvalidCheckpoint, bc.SetCheckpoints(map[uint64]string{0: bc.GenesisBlock().Hash().String()}))
+
+	addr, err := AddressParse(MockDynasty[1])
+	assert.Nil(t, err)
+	trusted, err := NewBlock(bc.ChainID(), addr, bc.tailBlock)
+	assert.Nil(t, err)
+	trusted.header.timestamp = bc.tailBlock.header.timestamp + BlockInterval
+	assert.Nil(t, trusted.Seal())
+	signBlock(trusted)
+
+	addr, err = AddressParse(MockDynasty[2])
+	assert.Nil(t, err)
+	forked, err := NewBlock(bc.ChainID(), addr, bc.tailBlock)
+	assert.Nil(t, err)
+	forked.header.timestamp = bc.tailBlock.header.timestamp + BlockInterval
+	assert.Nil(t, forked.Seal())
+	signBlock(forked)
+
+	assert.Nil(t, bc.SetCheckpoints(map[uint64]string{trusted.Height(): trusted.Hash().String()}))
+	assert.Equal(t, ErrCheckpointMismatch, bc.bkPool.Push(forked))
+	assert.Nil(t, bc.GetBlock(forked.Hash()))
+	assert.Nil(t, bc.bkPool.Push(trusted))
+	assert.Equal(t, trusted.Hash(), bc.TailBlock().Hash())
+	assert.Nil(t, bc.verifyCanonicalCheckpoints())
+
+	// the local chain mismatching a new checkpoint is refused at startup.
+	assert.Nil(t, bc.SetCheckpoints(map[uint64]string{forked.Height(): forked.Hash().String()}))
+	assert.Equal(t, ErrCheckpointMismatch, bc.verifyCanonicalCheckpoints())
+}
+
 func TestHandleDownloadedBlock(t *testing.T) {
 	received = []byte{}
 
@@ -290,3 +325,277 @@ func TestHandleDownloadedBlock(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, received, data)
 }
+
+func TestHandleCompactBlock(t *testing.T) {
+	received = []byte{}
+
+	neb := testNeb(t)
+	bc := neb.chain
+	from := mockAddress()
+	ks := keystore.DefaultKS
+	key, err := ks.GetUnlocked(from.String())
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// all txs found locally
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.Seal()
+	block.Sign(signature)
+	pbMsg, err := NewCompactBlock(block).ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+	msg := net.NewBaseMessage(MessageTypeNewCompactBlock, "from", data)
+	bc.bkPool.handleReceivedBlock(msg)
+	assert.NotNil(t, bc.GetBlock(block.Hash()))
+	assert.Equal(t, received, []byte{})
+
+	// missing txs
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, _ := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, gasLimit)
+	assert.Nil(t, tx.Sign(signature))
+	block, err = bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.transactions = append(block.transactions, tx)
+	block.Seal()
+	block.Sign(signature)
+	pbMsg, err = NewCompactBlock(block).ToProto()
+	assert.Nil(t, err)
+	data, err = proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+	msg = net.NewBaseMessage(MessageTypeNewCompactBlock, "from", data)
+	assert.Nil(t, bc.bkPool.blockFromCompactMessage(msg))
+	assert.True(t, bc.bkPool.pendingCompactBlocks.Contains(block.Hash().Hex()))
+	request := new(corepb.GetBlockTxs)
+	assert.Nil(t, proto.Unmarshal(received, request))
+	assert.Equal(t, []byte(block.Hash()), request.BlockHash)
+	assert.Equal(t, []uint32{0}, request.Indexes)
+
+	// duplicated compact block
+	received = []byte{}
+	assert.Nil(t, bc.bkPool.blockFromCompactMessage(msg))
+	assert.Equal(t, received, []byte{})
+
+	// the same compact block from another announcer, asked after the timeout
+	msg = net.NewBaseMessage(MessageTypeNewCompactBlock, "other", data)
+	assert.Nil(t, bc.bkPool.blockFromCompactMessage(msg))
+	assert.Equal(t, received, []byte{})
+	v, _ := bc.bkPool.pendingCompactBlocks.Get(block.Hash().Hex())
+	pcb := v.(*pendingCompactBlock)
+	assert.Equal(t, []string{"from", "other"}, pcb.announcers)
+	bc.bkPool.retryPendingCompactBlocks()
+	assert.Equal(t, received, []byte{})
+	pcb.requestedAt = time.Now().Add(-CompactBlockTxsTimeout)
+	bc.bkPool.retryPendingCompactBlocks()
+	assert.Nil(t, proto.Unmarshal(received, request))
+	assert.Equal(t, []uint32{0}, request.Indexes)
+	assert.True(t, pcb.isAsked("other"))
+
+	// txs from another peer
+	pbTx, err := tx.ToProto()
+	assert.Nil(t, err)
+	data, err = proto.Marshal(&corepb.BlockTxs{
+		BlockHash:    block.Hash(),
+		Indexes:      []uint32{0},
+		Transactions: []*corepb.Transaction{pbTx.(*corepb.Transaction)},
+	})
+	assert.Nil(t, err)
+	msg = net.NewBaseMessage(MessageTypeCompactBlockTxsResponse, "unknown", data)
+	assert.Nil(t, bc.bkPool.blockFromCompactTxsMessage(msg))
+	assert.True(t, bc.bkPool.pendingCompactBlocks.Contains(block.Hash().Hex()))
+
+	// right, from any announcer asked
+	msg = net.NewBaseMessage(MessageTypeCompactBlockTxsResponse, "other", data)
+	recovered := bc.bkPool.blockFromCompactTxsMessage(msg)
+	assert.NotNil(t, recovered)
+	assert.Equal(t, block.Hash(), recovered.Hash())
+	assert.Equal(t, tx.Hash(), recovered.transactions[0].Hash())
+	assert.False(t, bc.bkPool.pendingCompactBlocks.Contains(block.Hash().Hex()))
+	msg = net.NewBaseMessage(MessageTypeCompactBlockTxsResponse, "from", data)
+	assert.Nil(t, bc.bkPool.blockFromCompactTxsMessage(msg))
+
+	// dropped when no announcer responds
+	msg = net.NewBaseMessage(MessageTypeNewCompactBlock, "from", pbData(t, NewCompactBlock(block)))
+	assert.Nil(t, bc.bkPool.blockFromCompactMessage(msg))
+	v, _ = bc.bkPool.pendingCompactBlocks.Get(block.Hash().Hex())
+	v.(*pendingCompactBlock).requestedAt = time.Now().Add(-CompactBlockTxsTimeout)
+	bc.bkPool.retryPendingCompactBlocks()
+	assert.False(t, bc.bkPool.pendingCompactBlocks.Contains(block.Hash().Hex()))
+}
+
+func pbData(t *testing.T, msg net.Serializable) []byte {
+	pbMsg, err := msg.ToProto()
+	assert.Nil(t, err)
+	data, err := proto.Marshal(pbMsg)
+	assert.Nil(t, err)
+	return data
+}
+
+func TestBlockRelayOnSimNetwork(t *testing.T) {
+	sn := net.NewSimNetwork(1)
+	defer sn.Close()
+	sn.SetLatency(10*time.Millisecond, 5*time.Millisecond)
+
+	ns1, ns2 := sn.NewService("node1"), sn.NewService("node2")
+	neb1 := NewMockNebWithNetService(nil, nil, nil, ns1)
+	neb2 := NewMockNebWithNetService(nil, nil, nil, ns2)
+	assert.Equal(t, neb1.chain.GenesisBlock().Hash(), neb2.chain.GenesisBlock().Hash())
+	for _, neb := range []*MockNeb{neb1, neb2} {
+		assert.Nil(t, neb.ns.Start())
+		neb.chain.BlockPool().Start()
+		defer neb.chain.BlockPool().Stop()
+		defer neb.ns.Stop()
+	}
+
+	from := mockAddress()
+	ks := keystore.DefaultKS
+	key, err := ks.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block, err := neb1.chain.NewBlock(from)
+	assert.Nil(t, err)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+
+	for i := 0; i < 100 && neb2.chain.GetBlock(block.Hash()) == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.NotNil(t, neb2.chain.GetBlock(block.Hash()))
+
+	// partitioned nodes do not receive new blocks.
+	sn.Partition([]string{"node1"}, []string{"node2"})
+	block, err = neb1.chain.NewBlock(from)
+	assert.Nil(t, err)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+	time.Sleep(100 * time.Millisecond)
+	assert.Nil(t, neb2.chain.GetBlock(block.Hash()))
+}
+
+func TestBlockPool_blocksByRange(t *testing.T) {
+	sn := net.NewSimNetwork(1)
+	defer sn.Close()
+
+	ns1, ns2 := sn.NewService("node1"), sn.NewService("node2")
+	neb1 := NewMockNebWithNetService(nil, nil, nil, ns1)
+	neb2 := NewMockNebWithNetService(nil, nil, nil, ns2)
+	for _, neb := range []*MockNeb{neb1, neb2} {
+		assert.Nil(t, neb.ns.Start())
+		neb.chain.BlockPool().Start()
+		defer neb.chain.BlockPool().Stop()
+		defer neb.ns.Stop()
+	}
+
+	from := mockAddress()
+	ks := keystore.DefaultKS
+	key, err := ks.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// node2 misses the blocks minted in the partition.
+	sn.Partition([]string{"node1"}, []string{"node2"})
+	now := time.Now().Unix()
+	var block *Block
+	for i := 0; i < 6; i++ {
+		block, err = neb1.chain.NewBlock(from)
+		assert.Nil(t, err)
+		block.SetTimestamp(now + int64(i))
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, block.Sign(signature))
+		assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+	}
+
+	// the canonical blocks are served from the height, every skip+1 heights.
+	resp, err := neb1.chain.generateBlocksByRange(&corepb.GetBlocksByRange{Height: 2, Count: 3, Skip: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp.Blocks))
+	for idx, height := range []uint64{2, 4, 6} {
+		assert.Equal(t, []byte(neb1.chain.GetBlockOnCanonicalChainByHeight(height).Hash()), resp.Blocks[idx].Header.Hash)
+	}
+	resp, err = neb1.chain.generateBlocksByRange(&corepb.GetBlocksByRange{Height: 5, Count: MaxBlocksByRange + 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(resp.Blocks))
+	resp, err = neb1.chain.generateBlocksByRange(&corepb.GetBlocksByRange{Height: 1, Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), resp.Blocks[0].Height)
+
+	// the heights skipped by a state sync are unavailable.
+	assert.Equal(t, uint64(1), neb1.chain.HistoryStart())
+	neb1.chain.historyStart = 4
+	_, err = neb1.chain.generateBlocksByRange(&corepb.GetBlocksByRange{Height: 3, Count: 1})
+	assert.Equal(t, ErrBlockHistoryUnavailable, err)
+	resp, err = neb1.chain.generateBlocksByRange(&corepb.GetBlocksByRange{Height: 4, Count: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), resp.Blocks[0].Height)
+	assert.Nil(t, neb1.chain.CheckHistoryAvailable(1))
+	neb1.chain.historyStart = 1
+
+	// the gap is filled by a range request once a new block is received.
+	sn.Heal()
+	block, err = neb1.chain.NewBlock(from)
+	assert.Nil(t, err)
+	block.SetTimestamp(now + 6)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+
+	for i := 0; i < 100 && !neb2.chain.TailBlock().Hash().Equals(block.Hash()); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, block.Hash(), neb2.chain.TailBlock().Hash())
+	assert.Equal(t, uint64(2), neb2.chain.bkPool.lastRangeFrom)
+
+	// a gap larger than a range is filled by consecutive ranges.
+	sn.Partition([]string{"node1"}, []string{"node2"})
+	for i := 0; i < 2*MaxBlocksByRange+5; i++ {
+		block, err = neb1.chain.NewBlock(from)
+		assert.Nil(t, err)
+		block.SetTimestamp(now + 7 + int64(i))
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, block.Sign(signature))
+		assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+	}
+	sn.Heal()
+	block, err = neb1.chain.NewBlock(from)
+	assert.Nil(t, err)
+	block.SetTimestamp(now + 7 + 2*MaxBlocksByRange + 5)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, neb1.chain.BlockPool().PushAndBroadcast(block))
+
+	for i := 0; i < 300 && !neb2.chain.TailBlock().Hash().Equals(block.Hash()); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(t, block.Hash(), neb2.chain.TailBlock().Hash())
+}
+
+func TestBlockPool_downloadRangeFailures(t *testing.T) {
+	neb := testNeb(t)
+	pool := neb.chain.BlockPool()
+	tail := neb.chain.TailBlock().Height()
+
+	// the windows are capped, the same range is not requested again before the timeout.
+	assert.Equal(t, ErrInvalidBlockCannotFindParentInLocalAndTryDownload, pool.downloadRange("peer", tail+1, tail+1000))
+	assert.Equal(t, tail+1+MaxBlocksByRangeWindows*MaxBlocksByRange, pool.lastRangeEnd)
+	assert.Equal(t, ErrInvalidBlockCannotFindParentInLocalAndTryDownload, pool.downloadRange("peer", tail+1, tail+1000))
+	assert.Equal(t, 0, pool.lastRangeFailures)
+
+	// falls back to a full sync if the tail does not move.
+	for i := 1; i < MaxBlocksByRangeFailures; i++ {
+		pool.lastRangeAt = time.Time{}
+		assert.Equal(t, ErrInvalidBlockCannotFindParentInLocalAndTryDownload, pool.downloadRange("peer", tail+1, tail+1000))
+		assert.Equal(t, i, pool.lastRangeFailures)
+	}
+	pool.lastRangeAt = time.Time{}
+	assert.Equal(t, ErrInvalidBlockCannotFindParentInLocalAndTrySync, pool.downloadRange("peer", tail+1, tail+1000))
+	assert.Equal(t, 0, pool.lastRangeFailures)
+}
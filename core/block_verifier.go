@@ -0,0 +1,173 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors
+var (
+	ErrInvalidVerifyRange = errors.New("invalid range of blocks to verify")
+)
+
+// RootMismatch is a root of the block differing from the re-executed one.
+type RootMismatch struct {
+	Root   string `json:"root"`
+	Expect string `json:"expect"`
+	Actual string `json:"actual"`
+}
+
+// TxMismatch is a transaction whose events differ from the re-executed ones.
+type TxMismatch struct {
+	Index  int            `json:"index"`
+	Hash   string         `json:"hash"`
+	Expect []*state.Event `json:"expect"`
+	Actual []*state.Event `json:"actual"`
+}
+
+// BlockVerification is the result of re-executing a block against its parent world state.
+type BlockVerification struct {
+	Height uint64          `json:"height"`
+	Hash   string          `json:"hash"`
+	Error  string          `json:"error,omitempty"`
+	Roots  []*RootMismatch `json:"roots,omitempty"`
+	Txs    []*TxMismatch   `json:"txs,omitempty"`
+}
+
+// Mismatched return whether the re-executed block differs from the stored one.
+func (v *BlockVerification) Mismatched() bool {
+	return len(v.Error) > 0 || len(v.Roots) > 0 || len(v.Txs) > 0
+}
+
+// VerifyBlocks re-executes the canonical blocks in [from, to] loaded from storage,
+// and return the verification of the first block mismatching its roots, nil if all match.
+// progress is called after each block verified, could be nil.
+func (bc *BlockChain) VerifyBlocks(from, to uint64, progress func(*BlockVerification)) (*BlockVerification, error) {
+	// the genesis block is not executed.
+	if from < 2 || from > to || to > bc.TailBlock().Height() {
+		return nil, ErrInvalidVerifyRange
+	}
+
+	parent := bc.GetBlockOnCanonicalChainByHeight(from - 1)
+	if parent == nil {
+		return nil, ErrMissingParentBlock
+	}
+	for height := from; height <= to; height++ {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, ErrNotBlockInCanonicalChain
+		}
+		verification, err := bc.reExecuteBlock(block, parent)
+		if err != nil {
+			return nil, err
+		}
+		if progress != nil {
+			progress(verification)
+		}
+		if verification.Mismatched() {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"roots": len(verification.Roots),
+				"txs":   len(verification.Txs),
+				"err":   verification.Error,
+			}).Warn("Found the block mismatching the re-execution.")
+			return verification, nil
+		}
+		parent = block
+	}
+	return nil, nil
+}
+
+// reExecuteBlock executes a copy of the block on the world state of the parent and compares the results,
+// the changes are rolled back.
+func (bc *BlockChain) reExecuteBlock(block *Block, parent *Block) (*BlockVerification, error) {
+	verification := &BlockVerification{Height: block.Height(), Hash: block.Hash().String()}
+
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	data, err := proto.Marshal(pbBlock)
+	if err != nil {
+		return nil, err
+	}
+	pbCopy := new(corepb.Block)
+	if err := proto.Unmarshal(data, pbCopy); err != nil {
+		return nil, err
+	}
+	executed := new(Block)
+	if err := executed.FromProto(pbCopy); err != nil {
+		return nil, err
+	}
+	if err := executed.LinkParentBlock(bc, parent); err != nil {
+		return nil, err
+	}
+
+	if err := executed.Begin(); err != nil {
+		return nil, err
+	}
+	defer executed.RollBack()
+
+	if err := executed.execute(); err != nil {
+		verification.Error = err.Error()
+		return verification, nil
+	}
+
+	ws := executed.WorldState()
+	compare := func(root string, expect, actual byteutils.Hash) {
+		if !expect.Equals(actual) {
+			verification.Roots = append(verification.Roots, &RootMismatch{Root: root, Expect: expect.String(), Actual: actual.String()})
+		}
+	}
+	compare("state", block.StateRoot(), ws.AccountsRoot())
+	compare("txs", block.TxsRoot(), ws.TxsRoot())
+	compare("events", block.EventsRoot(), ws.EventsRoot())
+	if !reflect.DeepEqual(block.ConsensusRoot(), ws.ConsensusRoot()) {
+		verification.Roots = append(verification.Roots, &RootMismatch{
+			Root:   "consensus",
+			Expect: block.ConsensusRoot().String(),
+			Actual: ws.ConsensusRoot().String(),
+		})
+	}
+
+	// the events of the transactions locate the diverging execution.
+	for idx, tx := range block.Transactions() {
+		expect, err := block.FetchEvents(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		actual, err := ws.FetchEvents(tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(expect, actual) {
+			verification.Txs = append(verification.Txs, &TxMismatch{Index: idx, Hash: tx.Hash().String(), Expect: expect, Actual: actual})
+		}
+	}
+	return verification, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_VerifyBlocks(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	from := mockAddress()
+	key, err := keystore.DefaultKS.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// the coinbase is rewarded in the first block and pays the transfer in the second.
+	now := time.Now().Unix()
+	block, err := bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.SetTimestamp(now)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, bc.BlockPool().Push(block))
+
+	gasLimit, _ := util.NewUint128FromInt(200000)
+	tx, err := NewTransaction(bc.ChainID(), from, from, util.NewUint128(), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, gasLimit)
+	assert.Nil(t, err)
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.TransactionPool().Push(tx))
+	block, err = bc.NewBlock(from)
+	assert.Nil(t, err)
+	block.SetTimestamp(now + 1)
+	block.CollectTransactions(time.Now().UnixNano()/1e6 + 200)
+	assert.Equal(t, 1, len(block.Transactions()))
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, block.Sign(signature))
+	assert.Nil(t, bc.BlockPool().Push(block))
+	assert.Equal(t, uint64(3), bc.TailBlock().Height())
+
+	verified := []uint64{}
+	mismatch, err := bc.VerifyBlocks(2, 3, func(v *BlockVerification) {
+		verified = append(verified, v.Height)
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, mismatch)
+	assert.Equal(t, []uint64{2, 3}, verified)
+
+	_, err = bc.VerifyBlocks(1, 3, nil)
+	assert.Equal(t, ErrInvalidVerifyRange, err)
+	_, err = bc.VerifyBlocks(3, 4, nil)
+	assert.Equal(t, ErrInvalidVerifyRange, err)
+
+	// the events of the stored block are corrupted.
+	pbBlock, err := block.ToProto()
+	assert.Nil(t, err)
+	pbBlock.(*corepb.Block).Header.EventsRoot = bc.GetBlockOnCanonicalChainByHeight(2).EventsRoot()
+	data, err := proto.Marshal(pbBlock)
+	assert.Nil(t, err)
+	assert.Nil(t, bc.storage.Put(block.Hash(), data))
+	bc.cachedBlocks.Remove(block.Hash().Hex())
+
+	mismatch, err = bc.VerifyBlocks(2, 3, nil)
+	assert.Nil(t, err)
+	assert.NotNil(t, mismatch)
+	assert.Equal(t, uint64(3), mismatch.Height)
+	assert.Equal(t, 1, len(mismatch.Roots))
+	assert.Equal(t, "events", mismatch.Roots[0].Root)
+	assert.Equal(t, 1, len(mismatch.Txs))
+	assert.Equal(t, tx.Hash().String(), mismatch.Txs[0].Hash)
+	assert.Equal(t, 0, len(mismatch.Txs[0].Expect))
+	assert.True(t, len(mismatch.Txs[0].Actual) > 0)
+}
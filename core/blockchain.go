@@ -23,6 +23,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/core/state"
@@ -41,6 +42,8 @@ import (
 // scheme -> scheme version
 // genesis hash -> genesis block
 // blockchain_tail -> tail block hash
+// blockchain_reorg -> number of reorgs
+// blockchain_reorg + index -> reorg json
 // block hash -> block
 // height -> block hash
 
@@ -79,6 +82,16 @@ type BlockChain struct {
 	quitCh chan int
 
 	superNode bool
+
+	// trusted block hashes by height.
+	checkpoints map[uint64]byteutils.Hash
+
+	// the height from which the canonical blocks are kept.
+	historyStart uint64
+
+	reorgMutex      sync.RWMutex
+	reorgAlertDepth uint64
+	reorgAlertHooks []ReorgAlertHook
 }
 
 const (
@@ -95,6 +108,9 @@ const (
 	// LIB (latest irreversible block) in storage
 	LIB = "blockchain_lib"
 
+	// HistoryStart is the height of the first block kept after the genesis by a state sync
+	HistoryStart = "blockchain_history_start"
+
 	// transaction's block height
 	TxBlockHeight = "height"
 )
@@ -130,6 +146,7 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		return nil, err
 	}
 	blockPool.RegisterInNetwork(neb.NetService())
+	blockPool.SetCompactRelay(neb.Config().Chain.CompactBlockRelay)
 
 	txPool, err := NewTransactionPool(327680)
 	if err != nil {
@@ -159,6 +176,13 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		quitCh:       make(chan int, 1),
 		superNode:    neb.Config().Chain.SuperNode,
 	}
+	if neb.Config().Chain.Reorg != nil {
+		bc.reorgAlertDepth = uint64(neb.Config().Chain.Reorg.AlertDepth)
+	}
+
+	if err := bc.SetCheckpoints(neb.Config().Chain.Checkpoints); err != nil {
+		return nil, err
+	}
 
 	bc.cachedBlocks, err = lru.New(128)
 	if err != nil {
@@ -211,6 +235,103 @@ func (bc *BlockChain) Setup(neb Neblet) error {
 		"block": bc.lib,
 	}).Info("Latest Irreversible Block.")
 
+	bc.historyStart, err = bc.LoadHistoryStartFromStorage()
+	if err != nil {
+		return err
+	}
+
+	return bc.verifyCanonicalCheckpoints()
+}
+
+// SetCheckpoints set the trusted checkpoints, block height to block hash in hex.
+func (bc *BlockChain) SetCheckpoints(checkpoints map[uint64]string) error {
+	parsed, err := ParseCheckpoints(checkpoints)
+	if err != nil {
+		return err
+	}
+	bc.checkpoints = parsed
+	return nil
+}
+
+// Checkpoints return the trusted checkpoints, block height to block hash.
+func (bc *BlockChain) Checkpoints() map[uint64]byteutils.Hash {
+	return bc.checkpoints
+}
+
+// HistoryStart return the height from which the canonical blocks are kept,
+// the blocks between the genesis and it are skipped by a state sync. 1 if none is skipped.
+func (bc *BlockChain) HistoryStart() uint64 {
+	return bc.historyStart
+}
+
+// CheckHistoryAvailable return ErrBlockHistoryUnavailable if the canonical block at the height is skipped by a state sync.
+func (bc *BlockChain) CheckHistoryAvailable(height uint64) error {
+	if height > 1 && height < bc.historyStart {
+		return ErrBlockHistoryUnavailable
+	}
+	return nil
+}
+
+// LastCheckpoint return the height of the highest checkpoint, 0 if none.
+func (bc *BlockChain) LastCheckpoint() uint64 {
+	last := uint64(0)
+	for height := range bc.checkpoints {
+		if height > last {
+			last = height
+		}
+	}
+	return last
+}
+
+// ParseCheckpoints parses the checkpoints of block height to block hash in hex.
+func ParseCheckpoints(checkpoints map[uint64]string) (map[uint64]byteutils.Hash, error) {
+	parsed := make(map[uint64]byteutils.Hash)
+	for height, hex := range checkpoints {
+		hash, err := byteutils.FromHex(hex)
+		if err != nil || height == 0 || len(hash) != BlockHashLength {
+			logging.CLog().WithFields(logrus.Fields{
+				"height": height,
+				"hash":   hex,
+			}).Error("Invalid checkpoint.")
+			return nil, ErrInvalidCheckpoint
+		}
+		parsed[height] = hash
+	}
+	return parsed, nil
+}
+
+// VerifyCheckpoint return ErrCheckpointMismatch if there is a different checkpoint at the height.
+func (bc *BlockChain) VerifyCheckpoint(height uint64, hash byteutils.Hash) error {
+	if checkpoint, ok := bc.checkpoints[height]; ok && !checkpoint.Equals(hash) {
+		logging.VLog().WithFields(logrus.Fields{
+			"height":     height,
+			"hash":       hash,
+			"checkpoint": checkpoint,
+		}).Debug("Block mismatches the checkpoint.")
+		return ErrCheckpointMismatch
+	}
+	return nil
+}
+
+// verifyCanonicalCheckpoints checks the local chain contains the checkpoints below the tail.
+func (bc *BlockChain) verifyCanonicalCheckpoints() error {
+	for height, checkpoint := range bc.checkpoints {
+		if height > bc.tailBlock.Height() {
+			continue
+		}
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			// the history blocks are skipped by state sync.
+			continue
+		}
+		if !block.Hash().Equals(checkpoint) {
+			logging.CLog().WithFields(logrus.Fields{
+				"block":      block,
+				"checkpoint": checkpoint,
+			}).Error("Local chain mismatches the checkpoint, please resync.")
+			return ErrCheckpointMismatch
+		}
+	}
 	return nil
 }
 
@@ -270,12 +391,21 @@ func (bc *BlockChain) CheckGenesisConfig(neb Neblet) error {
 			return ErrInvalidConfigChainID
 		}
 
-		return CheckGenesisConfByDB(genesis, neb.Genesis())
+		// the params are compared as the consensus reads them.
+		conf := proto.Clone(neb.Genesis()).(*corepb.Genesis)
+		if conf.Consensus.Params, err = normalizeConsensusParams(bc.consensusHandler, conf.Consensus.Params); err != nil {
+			return err
+		}
+		if genesis.Consensus.Params, err = normalizeConsensusParams(bc.consensusHandler, genesis.Consensus.Params); err != nil {
+			return err
+		}
+		return CheckGenesisConfByDB(genesis, conf)
 	}
 
 	logging.CLog().WithFields(logrus.Fields{
 		"meta.chainid":           neb.Genesis().Meta.ChainId,
 		"consensus.dpos.dynasty": neb.Genesis().Consensus.Dpos.Dynasty,
+		"consensus.params":       neb.Genesis().Consensus.Params,
 		"token.distribution":     neb.Genesis().TokenDistribution,
 	}).Info("Genesis Configuration.")
 	return nil
@@ -326,13 +456,14 @@ func (bc *BlockChain) triggerRevertBlockEvent(blocks []string) {
 	}
 }
 
-func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
+func (bc *BlockChain) revertBlocks(from *Block, to *Block) ([]*Block, error) {
 	reverted := to
 	var revertTimes int64
 	blocks := []string{}
+	revertedBlocks := []*Block{}
 	for revertTimes = 0; !reverted.Hash().Equals(from.Hash()); {
 		if reverted.Hash().Equals(bc.lib.Hash()) {
-			return ErrCannotRevertLIB
+			return nil, ErrCannotRevertLIB
 		}
 
 		reverted.ReturnTransactions()
@@ -342,10 +473,11 @@ func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 		revertTimes++
 		bc.reversibleBlocks.Remove(reverted.Hash().Hex())
 		blocks = append(blocks, reverted.String())
+		revertedBlocks = append(revertedBlocks, reverted)
 
 		reverted = bc.GetBlock(reverted.header.parentHash)
 		if reverted == nil {
-			return ErrMissingParentBlock
+			return nil, ErrMissingParentBlock
 		}
 	}
 	go bc.triggerRevertBlockEvent(blocks)
@@ -354,7 +486,7 @@ func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 		metricsBlockRevertTimesGauge.Update(revertTimes)
 		metricsBlockRevertMeter.Mark(1)
 	}
-	return nil
+	return revertedBlocks, nil
 }
 
 func (bc *BlockChain) dropTxsInBlockFromTxPool(block *Block) {
@@ -427,7 +559,8 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		return err
 	}
 
-	if err := bc.revertBlocks(ancestor, oldTail); err != nil {
+	reverted, err := bc.revertBlocks(ancestor, oldTail)
+	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"from":  ancestor,
 			"to":    oldTail,
@@ -452,12 +585,157 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	}
 	bc.tailBlock = newTail
 
+	if len(reverted) > 0 {
+		bc.recordReorg(oldTail, newTail, ancestor, reverted)
+	}
+
 	metricsBlockHeightGauge.Update(int64(newTail.Height()))
 	metricsBlocktailHashGauge.Update(int64(byteutils.HashBytes(newTail.Hash())))
 
 	return nil
 }
 
+// ResetToSyncedBlock set a block whose state has been synced from peers as the tail and LIB,
+// the ancestors in order of height are stored without execution. Only a chain at genesis can be reset.
+func (bc *BlockChain) ResetToSyncedBlock(block *Block, ancestors []*Block) error {
+	if block == nil {
+		return ErrNilArgument
+	}
+	if !bc.tailBlock.Hash().Equals(bc.genesisBlock.Hash()) {
+		return ErrCannotResetSyncedChain
+	}
+
+	blocks := append(append([]*Block{}, ancestors...), block)
+	for idx, v := range blocks {
+		if idx > 0 && !v.ParentHash().Equals(blocks[idx-1].Hash()) {
+			return ErrMissingParentBlock
+		}
+		if err := bc.StoreBlockToStorage(v); err != nil {
+			return err
+		}
+	}
+
+	// the roots of the states must be in storage.
+	loaded := make([]*Block, len(blocks))
+	for idx, v := range blocks {
+		b, err := LoadBlockFromStorage(v.Hash(), bc)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": v,
+				"err":   err,
+			}).Debug("Failed to load synced block.")
+			return err
+		}
+		loaded[idx] = b
+	}
+
+	for _, v := range loaded {
+		if err := bc.storage.Put(byteutils.FromUint64(v.height), v.Hash()); err != nil {
+			return err
+		}
+	}
+	// the heights below the first ancestor are not indexed.
+	if err := bc.storage.Put([]byte(HistoryStart), byteutils.FromUint64(loaded[0].height)); err != nil {
+		return err
+	}
+	bc.historyStart = loaded[0].height
+
+	tail := loaded[len(loaded)-1]
+	if err := bc.StoreTailHashToStorage(tail); err != nil {
+		return err
+	}
+	if err := bc.StoreLIBHashToStorage(tail); err != nil {
+		return err
+	}
+	bc.tailBlock = tail
+	bc.lib = tail
+
+	metricsBlockHeightGauge.Update(int64(tail.Height()))
+	metricsBlocktailHashGauge.Update(int64(byteutils.HashBytes(tail.Hash())))
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":      tail,
+		"ancestors": len(ancestors),
+	}).Info("Reset to synced block.")
+	return nil
+}
+
+// RollbackPlan return the canonical blocks discarded by resetting the tail to the target, from the tail down,
+// and the common ancestor of the target and the tail.
+func (bc *BlockChain) RollbackPlan(target *Block) ([]*Block, *Block, error) {
+	ancestor, err := bc.FindCommonAncestorWithTail(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ancestor.Hash().Equals(target.Hash()) && bc.GetBlock(target.Hash()) == nil {
+		return nil, nil, ErrMissingParentBlock
+	}
+
+	discarded := []*Block{}
+	for block := bc.tailBlock; !block.Hash().Equals(ancestor.Hash()); {
+		discarded = append(discarded, block)
+		if block = bc.GetBlock(block.ParentHash()); block == nil {
+			return nil, nil, ErrMissingParentBlock
+		}
+	}
+	return discarded, ancestor, nil
+}
+
+// Rollback resets the tail to a block in storage and rebuilds the height index, return the discarded blocks.
+// The LIB is reset to the common ancestor if the rollback goes below it, which is refused unless forced.
+func (bc *BlockChain) Rollback(target *Block, force bool) ([]*Block, error) {
+	discarded, ancestor, err := bc.RollbackPlan(target)
+	if err != nil {
+		return nil, err
+	}
+	belowLIB := ancestor.Height() < bc.lib.Height()
+	if belowLIB && !force {
+		return nil, ErrCannotRollbackBelowLIB
+	}
+
+	for _, block := range discarded {
+		if err := bc.storage.Del(byteutils.FromUint64(block.Height())); err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			if err := bc.storage.Del(append(tx.Hash(), []byte(TxBlockHeight)...)); err != nil && err != storage.ErrKeyNotFound {
+				return nil, err
+			}
+		}
+	}
+	for block := target; !block.Hash().Equals(ancestor.Hash()); {
+		if err := bc.storage.Put(byteutils.FromUint64(block.Height()), block.Hash()); err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			if err := bc.storage.Put(append(tx.Hash(), []byte(TxBlockHeight)...), byteutils.FromUint64(block.Height())); err != nil {
+				return nil, err
+			}
+		}
+		if block = bc.GetBlock(block.ParentHash()); block == nil {
+			return nil, ErrMissingParentBlock
+		}
+	}
+
+	if err := bc.StoreTailHashToStorage(target); err != nil {
+		return nil, err
+	}
+	bc.tailBlock = target
+	if belowLIB {
+		if err := bc.StoreLIBHashToStorage(ancestor); err != nil {
+			return nil, err
+		}
+		bc.lib = ancestor
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":      target,
+		"lib":       bc.lib,
+		"discarded": len(discarded),
+	}).Warn("Rolled back the chain.")
+	return discarded, nil
+}
+
 // GetBlockOnCanonicalChainByHeight return block in given height
 func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 
@@ -472,6 +750,41 @@ func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 	return bc.GetBlock(blockHash)
 }
 
+// generateBlocksByRange return the canonical blocks from the height, every skip+1 heights,
+// stopping at the first missing one. The genesis block is never served.
+func (bc *BlockChain) generateBlocksByRange(request *corepb.GetBlocksByRange) (*corepb.BlocksByRange, error) {
+	count := request.Count
+	if count > MaxBlocksByRange {
+		count = MaxBlocksByRange
+	}
+	step := uint64(request.Skip) + 1
+
+	resp := &corepb.BlocksByRange{Height: request.Height, Skip: request.Skip}
+	height := request.Height
+	if height < 2 {
+		height = 2
+	}
+	if err := bc.CheckHistoryAvailable(height); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < count; i++ {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			break
+		}
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		resp.Blocks = append(resp.Blocks, pbBlock.(*corepb.Block))
+		if height+step < height {
+			break
+		}
+		height += step
+	}
+	return resp, nil
+}
+
 // GetBlockOnCanonicalChainByHash check if a block is on canonical chain
 func (bc *BlockChain) GetBlockOnCanonicalChainByHash(blockHash byteutils.Hash) *Block {
 	blockByHash := bc.GetBlock(blockHash)
@@ -512,6 +825,9 @@ func (bc *BlockChain) GetInputForVRFSigner(parentHash byteutils.Hash, height uin
 
 	nob := bc.consensusHandler.NumberOfBlocksInDynasty()
 	if height > nob*2 {
+		if err := bc.CheckHistoryAvailable(height - nob*2); err != nil {
+			return nil, nil, err
+		}
 		b := bc.GetBlockOnCanonicalChainByHeight(height - nob*2)
 		if b == nil {
 			logging.VLog().WithFields(logrus.Fields{
@@ -607,7 +923,7 @@ func (bc *BlockChain) SetSyncService(syncService SyncService) {
 
 // StartActiveSync start active sync task
 func (bc *BlockChain) StartActiveSync() bool {
-	if bc.syncService.StartActiveSync() {
+	if bc.syncService != nil && bc.syncService.StartActiveSync() {
 		bc.consensusHandler.SuspendMining()
 		go func() {
 			bc.syncService.WaitingForFinish()
@@ -620,9 +936,21 @@ func (bc *BlockChain) StartActiveSync() bool {
 
 // IsActiveSyncing returns true if being syncing
 func (bc *BlockChain) IsActiveSyncing() bool {
+	if bc.syncService == nil {
+		return false
+	}
 	return bc.syncService.IsActiveSyncing()
 }
 
+// SyncProgress returns the progress of the active sync task
+func (bc *BlockChain) SyncProgress() *SyncProgress {
+	if bc.syncService == nil {
+		height := bc.TailBlock().Height()
+		return &SyncProgress{StartingHeight: height, CurrentHeight: height, HighestHeight: height}
+	}
+	return bc.syncService.SyncProgress()
+}
+
 // ConsensusHandler return consensus handler.
 func (bc *BlockChain) ConsensusHandler() Consensus {
 	return bc.consensusHandler
@@ -783,6 +1111,11 @@ type SimulateResult struct {
 
 // SimulateCallContract simulate call contract
 func (bc *BlockChain) SimulateCallContract(contract *Address, function, args string) (*SimulateResult, error) {
+	return bc.SimulateCallContractAt(bc.TailBlock(), contract, function, args)
+}
+
+// SimulateCallContractAt simulate call contract on the state of the block, e.g. a past one.
+func (bc *BlockChain) SimulateCallContractAt(parent *Block, contract *Address, function, args string) (*SimulateResult, error) {
 	callpayload, err := NewCallPayload(function, args)
 	if err != nil {
 		return nil, err
@@ -795,17 +1128,21 @@ func (bc *BlockChain) SimulateCallContract(contract *Address, function, args str
 	if err != nil {
 		return nil, err
 	}
-	return bc.SimulateTransactionExecution(tx)
+	return bc.simulateTransactionExecutionAt(parent, tx)
 }
 
 // SimulateTransactionExecution execute transaction in sandbox and rollback all changes, used to EstimateGas and Call api.
 func (bc *BlockChain) SimulateTransactionExecution(tx *Transaction) (*SimulateResult, error) {
+	return bc.simulateTransactionExecutionAt(bc.TailBlock(), tx)
+}
+
+func (bc *BlockChain) simulateTransactionExecutionAt(parent *Block, tx *Transaction) (*SimulateResult, error) {
 	if tx == nil {
 		return nil, ErrInvalidArgument
 	}
 
 	// create block.
-	block, err := bc.NewBlock(GenesisCoinbase)
+	block, err := bc.NewBlockFromParent(GenesisCoinbase, parent)
 	if err != nil {
 		return nil, err
 	}
@@ -926,6 +1263,18 @@ func (bc *BlockChain) LoadLIBFromStorage() (*Block, error) {
 	return LoadBlockFromStorage(hash, bc)
 }
 
+// LoadHistoryStartFromStorage load the height from which the canonical blocks are kept, 1 if not state synced.
+func (bc *BlockChain) LoadHistoryStartFromStorage() (uint64, error) {
+	bytes, err := bc.storage.Get([]byte(HistoryStart))
+	if err == storage.ErrKeyNotFound {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(bytes), nil
+}
+
 func (bc *BlockChain) statisticalSerial() int64 {
 	if bc.chainID == MainNetID {
 		return 0 //TODO: need to update
@@ -24,7 +24,9 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 
 	"sync"
 	"time"
@@ -262,3 +264,139 @@ func TestGetPrice(t *testing.T) {
 	bc.SetTailBlock(block)
 	assert.Equal(t, bc.GasPrice(), lowerGasPrice)
 }
+
+func TestBlockChain_Rollback(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	from := mockAddress()
+	key, err := keystore.DefaultKS.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	now := time.Now().Unix()
+	for i := 0; i < 5; i++ {
+		block, err := bc.NewBlock(from)
+		assert.Nil(t, err)
+		block.SetTimestamp(now + int64(i))
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, block.Sign(signature))
+		assert.Nil(t, bc.BlockPool().Push(block))
+	}
+	assert.Equal(t, uint64(6), bc.TailBlock().Height())
+	bc.SetLIB(bc.GetBlockOnCanonicalChainByHeight(3))
+
+	// the plan discards the blocks above the target.
+	target := bc.GetBlockOnCanonicalChainByHeight(4)
+	discarded, ancestor, err := bc.RollbackPlan(target)
+	assert.Nil(t, err)
+	assert.Equal(t, target.Hash(), ancestor.Hash())
+	assert.Equal(t, 2, len(discarded))
+	assert.Equal(t, uint64(6), discarded[0].Height())
+	assert.Equal(t, uint64(6), bc.TailBlock().Height())
+
+	discarded, err = bc.Rollback(target, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(discarded))
+	assert.Equal(t, target.Hash(), bc.TailBlock().Hash())
+	assert.Equal(t, uint64(3), bc.LIB().Height())
+	_, err = bc.storage.Get(byteutils.FromUint64(5))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	tail, err := bc.LoadTailFromStorage()
+	assert.Nil(t, err)
+	assert.Equal(t, target.Hash(), tail.Hash())
+
+	// below the LIB only if forced.
+	target = bc.GetBlockOnCanonicalChainByHeight(2)
+	_, err = bc.Rollback(target, false)
+	assert.Equal(t, ErrCannotRollbackBelowLIB, err)
+	assert.Equal(t, uint64(4), bc.TailBlock().Height())
+	_, err = bc.Rollback(target, true)
+	assert.Nil(t, err)
+	assert.Equal(t, target.Hash(), bc.TailBlock().Hash())
+	assert.Equal(t, target.Hash(), bc.LIB().Hash())
+	lib, err := bc.LoadLIBFromStorage()
+	assert.Nil(t, err)
+	assert.Equal(t, target.Hash(), lib.Hash())
+	assert.Nil(t, bc.GetBlockOnCanonicalChainByHeight(3))
+}
+
+func TestBlockChain_ReorgHistory(t *testing.T) {
+	neb := testNeb(t)
+	bc := neb.chain
+
+	coinbase11, _ := AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	coinbase12, _ := AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	coinbase111, _ := AddressParse("n1JAy4X6KKLCNiTd7MWMRsVBjgdVq5WCCpf")
+
+	reorgs, err := bc.ReorgHistory(10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reorgs))
+
+	/*
+		genesis -- 11 -- 111
+		        \_ 12
+	*/
+	block11, err := bc.NewBlock(coinbase11)
+	assert.Nil(t, err)
+	block11.header.timestamp = BlockInterval
+	block11.transactions = append(block11.transactions, mockNormalTransaction(bc.chainID, 1))
+	assert.Nil(t, block11.Seal())
+	block12, err := bc.NewBlock(coinbase12)
+	assert.Nil(t, err)
+	block12.header.timestamp = BlockInterval * 2
+	assert.Nil(t, block12.Seal())
+
+	assert.Nil(t, bc.SetTailBlock(block11))
+	block111, err := bc.NewBlock(coinbase111)
+	assert.Nil(t, err)
+	block111.header.timestamp = BlockInterval * 3
+	assert.Nil(t, block111.Seal())
+	for _, v := range []*Block{block11, block12, block111} {
+		bc.cachedBlocks.Add(v.Hash().Hex(), v)
+	}
+	reorgs, err = bc.ReorgHistory(10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reorgs))
+
+	alerted := make(chan *Reorg, 1)
+	bc.SetReorgAlertDepth(1)
+	bc.RegisterReorgAlertHook(func(reorg *Reorg) {
+		alerted <- reorg
+	})
+
+	assert.Nil(t, bc.SetTailBlock(block12))
+	assert.Nil(t, bc.SetTailBlock(block111))
+	select {
+	case <-alerted:
+		assert.Fail(t, "reorg of depth 1 alerted")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	assert.Nil(t, bc.SetTailBlock(block12))
+	select {
+	case reorg := <-alerted:
+		assert.Equal(t, uint64(2), reorg.Depth)
+	case <-time.After(time.Second):
+		assert.Fail(t, "reorg of depth 2 not alerted")
+	}
+
+	reorgs, err = bc.ReorgHistory(10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(reorgs))
+	assert.Equal(t, block111.Hash().String(), reorgs[0].OldTail.Hash)
+	assert.Equal(t, block12.Hash().String(), reorgs[0].NewTail.Hash)
+	assert.Equal(t, bc.genesisBlock.Hash().String(), reorgs[0].Ancestor.Hash)
+	assert.Equal(t, uint64(2), reorgs[0].Depth)
+	assert.Equal(t, uint64(1), reorgs[0].Applied)
+	assert.Equal(t, uint64(1), reorgs[0].RevertedTxs)
+	assert.Equal(t, uint64(0), reorgs[0].AppliedTxs)
+	assert.Equal(t, uint64(2), reorgs[1].Applied)
+	assert.Equal(t, uint64(1), reorgs[1].AppliedTxs)
+
+	reorgs, err = bc.ReorgHistory(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(reorgs))
+}
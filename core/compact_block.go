@@ -0,0 +1,389 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/dag"
+	dagpb "github.com/nebulasio/go-nebulas/common/dag/pb"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// HeaderVerifier verifies the chain of headers without their states, implemented by the consensus
+type HeaderVerifier interface {
+	// VerifyHeaders verify the headers following the trusted parent, second is the header at height 2,
+	// return the count of the leading headers trusted. The missing consensus states are synced by the fetcher.
+	VerifyHeaders(second, parent *CompactBlock, headers []*CompactBlock, fetcher trie.NodeFetcher) (int, error)
+}
+
+// CompactBlock is a block relayed without transaction bodies.
+// Receivers rebuild the block from their transaction pool and
+// only ask the sender for the transactions they are missing.
+type CompactBlock struct {
+	header     *BlockHeader
+	txHashes   []byteutils.Hash
+	dependency *dag.Dag
+	height     uint64
+}
+
+// NewCompactBlock return a compact block of the given block.
+func NewCompactBlock(block *Block) *CompactBlock {
+	txHashes := make([]byteutils.Hash, len(block.transactions))
+	for idx, tx := range block.transactions {
+		txHashes[idx] = tx.Hash()
+	}
+	return &CompactBlock{
+		header:     block.header,
+		txHashes:   txHashes,
+		dependency: block.dependency,
+		height:     block.height,
+	}
+}
+
+// ToProto converts domain CompactBlock into proto CompactBlock
+func (cb *CompactBlock) ToProto() (proto.Message, error) {
+	header, err := cb.header.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if header, ok := header.(*corepb.BlockHeader); ok {
+		txHashes := make([][]byte, len(cb.txHashes))
+		for idx, v := range cb.txHashes {
+			txHashes[idx] = v
+		}
+		dependency, err := cb.dependency.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		if dependency, ok := dependency.(*dagpb.Dag); ok {
+			return &corepb.CompactBlock{
+				Header:     header,
+				TxHashes:   txHashes,
+				Dependency: dependency,
+				Height:     cb.height,
+			}, nil
+		}
+		return nil, dag.ErrInvalidProtoToDag
+	}
+	return nil, ErrInvalidProtoToCompactBlock
+}
+
+// FromProto converts proto CompactBlock to domain CompactBlock
+func (cb *CompactBlock) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.CompactBlock); ok {
+		if msg != nil {
+			cb.header = new(BlockHeader)
+			if err := cb.header.FromProto(msg.Header); err != nil {
+				return err
+			}
+			if RandomAvailableAtHeight(msg.Height) && (cb.header.random == nil || cb.header.random.VrfSeed == nil) {
+				logging.VLog().WithFields(logrus.Fields{
+					"blockHeight":      msg.Height,
+					"compatibleHeight": NebCompatibility.RandomAvailableHeight(),
+				}).Info("No random found in compact block header.")
+				return ErrInvalidProtoToBlockHeader
+			}
+			cb.txHashes = make([]byteutils.Hash, len(msg.TxHashes))
+			for idx, v := range msg.TxHashes {
+				cb.txHashes[idx] = v
+			}
+			cb.dependency = dag.NewDag()
+			if err := cb.dependency.FromProto(msg.Dependency); err != nil {
+				return err
+			}
+			cb.height = msg.Height
+			return nil
+		}
+		return ErrInvalidProtoToCompactBlock
+	}
+	return ErrInvalidProtoToCompactBlock
+}
+
+// Hash return the hash of the compacted block.
+func (cb *CompactBlock) Hash() byteutils.Hash {
+	return cb.header.hash
+}
+
+// Height return the height of the compacted block.
+func (cb *CompactBlock) Height() uint64 {
+	return cb.height
+}
+
+// TxHashes return the hashes of the transactions in the compacted block.
+func (cb *CompactBlock) TxHashes() []byteutils.Hash {
+	return cb.txHashes
+}
+
+// ParentHash return the parent hash of the compacted block.
+func (cb *CompactBlock) ParentHash() byteutils.Hash {
+	return cb.header.parentHash
+}
+
+// Timestamp return the timestamp of the compacted block.
+func (cb *CompactBlock) Timestamp() int64 {
+	return cb.header.timestamp
+}
+
+// ChainID return the chain id of the compacted block.
+func (cb *CompactBlock) ChainID() uint32 {
+	return cb.header.chainID
+}
+
+// Coinbase return the coinbase of the compacted block.
+func (cb *CompactBlock) Coinbase() *Address {
+	return cb.header.coinbase
+}
+
+// StateRoot return the state root of the compacted block.
+func (cb *CompactBlock) StateRoot() byteutils.Hash {
+	return cb.header.stateRoot
+}
+
+// TxsRoot return the txs root of the compacted block.
+func (cb *CompactBlock) TxsRoot() byteutils.Hash {
+	return cb.header.txsRoot
+}
+
+// EventsRoot return the events root of the compacted block.
+func (cb *CompactBlock) EventsRoot() byteutils.Hash {
+	return cb.header.eventsRoot
+}
+
+// ConsensusRoot return the consensus root of the compacted block.
+func (cb *CompactBlock) ConsensusRoot() *consensuspb.ConsensusRoot {
+	return cb.header.consensusRoot
+}
+
+// Alg return the signature algorithm of the compacted block.
+func (cb *CompactBlock) Alg() keystore.Algorithm {
+	return cb.header.alg
+}
+
+// Signature return the signature of the compacted block.
+func (cb *CompactBlock) Signature() byteutils.Hash {
+	return cb.header.sign
+}
+
+// VerifyHeader verify the compacted block's chainID, hash and whether its header is acceptable by consensus,
+// so that a header can be checked before its transactions are downloaded, without side effects on consensus.
+// The execution result is still verified when the full block is pushed.
+func (cb *CompactBlock) VerifyHeader(chainID uint32, consensus Consensus) error {
+	if consensus == nil {
+		return ErrNilArgument
+	}
+
+	if err := cb.VerifyHash(chainID); err != nil {
+		return err
+	}
+
+	// verify the signature, proposer and random of the header.
+	return consensus.VerifyBlockHeader(cb.toBlock(nil))
+}
+
+// VerifyRandom verify the vrf proof of the compacted block with the inputs of its miner,
+// see BlockChain.GetInputForVRFSigner.
+func (cb *CompactBlock) VerifyRandom(ancestorHash, parentSeed []byte) error {
+	if !RandomAvailableAtHeight(cb.height) {
+		return nil
+	}
+	if err := vrfProof(cb.toBlock(nil), ancestorHash, parentSeed); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"hash":   cb.header.hash,
+			"height": cb.height,
+			"err":    err,
+		}).Debug("Failed to verify compact block's vrf.")
+		return ErrVRFProofFailed
+	}
+	return nil
+}
+
+// VrfSeed return the vrf seed of the compacted block, nil if the block has no random.
+func (cb *CompactBlock) VrfSeed() []byte {
+	if cb.header.random == nil {
+		return nil
+	}
+	return cb.header.random.VrfSeed
+}
+
+// VerifyHash verify the compacted block's chainID and that its hash commits to the header fields.
+func (cb *CompactBlock) VerifyHash(chainID uint32) error {
+	// check ChainID.
+	if cb.header.chainID != chainID {
+		logging.VLog().WithFields(logrus.Fields{
+			"expect": chainID,
+			"actual": cb.header.chainID,
+		}).Info("Failed to check compact block's chainid.")
+		return ErrInvalidChainID
+	}
+
+	// verify block hash.
+	wantedHash, err := calBlockHash(cb.header, cb.dependency, cb.txHashes)
+	if err != nil {
+		return err
+	}
+	if !wantedHash.Equals(cb.header.hash) {
+		logging.VLog().WithFields(logrus.Fields{
+			"expect": wantedHash,
+			"actual": cb.header.hash,
+		}).Info("Failed to check compact block's hash.")
+		return ErrInvalidBlockHash
+	}
+	return nil
+}
+
+// ToPbBlock build the proto block with the given transactions,
+// the transactions must match the hashes in the compacted block.
+func (cb *CompactBlock) ToPbBlock(txs []*corepb.Transaction) (*corepb.Block, error) {
+	if len(txs) != len(cb.txHashes) {
+		return nil, ErrInvalidTransactionData
+	}
+	for idx, v := range txs {
+		tx := new(Transaction)
+		if err := tx.FromProto(v); err != nil {
+			return nil, err
+		}
+		hash, err := tx.HashTransaction()
+		if err != nil {
+			return nil, err
+		}
+		if !hash.Equals(cb.txHashes[idx]) || !hash.Equals(tx.hash) {
+			return nil, ErrInvalidTransactionHash
+		}
+	}
+
+	pbBlock, err := cb.toBlock(nil).ToProto()
+	if err != nil {
+		return nil, err
+	}
+	if pbBlock, ok := pbBlock.(*corepb.Block); ok {
+		pbBlock.Transactions = txs
+		return pbBlock, nil
+	}
+	return nil, ErrInvalidProtoToBlock
+}
+
+// pendingCompactBlock is a compact block waiting for its missing transactions,
+// asked from its announcers one by one.
+type pendingCompactBlock struct {
+	compact *CompactBlock
+	txs     Transactions
+	missing []uint32
+
+	mu          sync.Mutex
+	announcers  []string
+	asked       map[string]bool
+	requestedAt time.Time
+}
+
+// announce record another peer announcing the block.
+func (pcb *pendingCompactBlock) announce(peer string) {
+	pcb.mu.Lock()
+	defer pcb.mu.Unlock()
+
+	for _, v := range pcb.announcers {
+		if v == peer {
+			return
+		}
+	}
+	pcb.announcers = append(pcb.announcers, peer)
+}
+
+func (pcb *pendingCompactBlock) isAsked(peer string) bool {
+	pcb.mu.Lock()
+	defer pcb.mu.Unlock()
+
+	return pcb.asked[peer]
+}
+
+// retry return the next announcer to ask if the request timed out,
+// or an empty one if all the announcers are asked.
+func (pcb *pendingCompactBlock) retry(now time.Time) (string, bool) {
+	pcb.mu.Lock()
+	defer pcb.mu.Unlock()
+
+	if now.Sub(pcb.requestedAt) < CompactBlockTxsTimeout {
+		return "", false
+	}
+	for _, v := range pcb.announcers {
+		if !pcb.asked[v] {
+			pcb.asked[v] = true
+			pcb.requestedAt = now
+			return v, true
+		}
+	}
+	return "", true
+}
+
+// reconstruct fills the transactions of a compact block from the tx pool,
+// returns the indexes of the transactions not found locally.
+func (cb *CompactBlock) reconstruct(txPool *TransactionPool) (Transactions, []uint32) {
+	txs := make(Transactions, len(cb.txHashes))
+	missing := make([]uint32, 0)
+	for idx, hash := range cb.txHashes {
+		var tx *Transaction
+		if txPool != nil {
+			tx = txPool.GetTransaction(hash)
+		}
+		if tx == nil {
+			missing = append(missing, uint32(idx))
+			continue
+		}
+		txs[idx] = tx
+	}
+	return txs, missing
+}
+
+// toBlock build the full block with the given transactions.
+func (cb *CompactBlock) toBlock(txs Transactions) *Block {
+	return &Block{
+		header:       cb.header,
+		transactions: txs,
+		dependency:   cb.dependency,
+		height:       cb.height,
+	}
+}
+
+// fill put the received transactions into the pending compact block,
+// returns false if any of them does not match the expected hash.
+func (pcb *pendingCompactBlock) fill(indexes []uint32, txs Transactions) bool {
+	if len(indexes) != len(txs) {
+		return false
+	}
+	for i, idx := range indexes {
+		if int(idx) >= len(pcb.txs) || !txs[i].Hash().Equals(pcb.compact.txHashes[idx]) {
+			return false
+		}
+		pcb.txs[idx] = txs[i]
+	}
+	for _, tx := range pcb.txs {
+		if tx == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+// ConsensusSnapshot is the consensus state at a block on the canonical chain
+type ConsensusSnapshot struct {
+	Height    uint64 `json:"height"`
+	Hash      string `json:"hash"`
+	Timestamp int64  `json:"timestamp"`
+	Serial    int64  `json:"serial"`
+
+	DynastyRoot string   `json:"dynasty_root"`
+	Dynasty     []string `json:"dynasty"`
+
+	// the nodes in the pod contract at the block, empty before the contract is available.
+	Candidates   []*NodeInfo `json:"candidates"`
+	Participants []*NodeInfo `json:"participants"`
+}
+
+// ProposerSlot is the miner expected to mint the block of a slot
+type ProposerSlot struct {
+	Timestamp int64  `json:"timestamp"`
+	Serial    int64  `json:"serial"`
+	Proposer  string `json:"proposer"`
+}
+
+// ConsensusHistorian queries the past consensus states and predicts the proposers, implemented by the consensus
+type ConsensusHistorian interface {
+	ConsensusSnapshot(height uint64) (*ConsensusSnapshot, error)
+
+	// SerialStartHeight returns the height of the first block in the serial on the canonical chain.
+	SerialStartHeight(serial int64) (uint64, error)
+
+	// ProposerSchedule returns the proposers of the next slots.
+	ProposerSchedule(count int) ([]*ProposerSlot, error)
+}
@@ -0,0 +1,29 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+// InstantSealer is implemented by the consensus of the local dev chains, sealing blocks on demand.
+// Their blocks carry a random seed without VRF proof.
+type InstantSealer interface {
+	// MineBlocks seals n empty blocks on the tail at once.
+	MineBlocks(n int) ([]*Block, error)
+
+	// AdvanceTime moves the clock of the following blocks forward, returns the time of the chain.
+	AdvanceTime(seconds int64) (int64, error)
+}
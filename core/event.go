@@ -44,6 +44,9 @@ const (
 	// TopicRevertBlock the topic of revert block
 	TopicRevertBlock = "chain.revertBlock"
 
+	// TopicReorg the topic of the tail switched to a fork, in json of Reorg
+	TopicReorg = "chain.reorg"
+
 	// TopicDropTransaction drop tx (1): smaller nonce (2) expire txLifeTime
 	TopicDropTransaction = "chain.dropTransaction"
 
@@ -55,6 +58,9 @@ const (
 
 	// TopicPodStateUpdate update pod state
 	TopicPodStateUpdate = "chain.podStateUpdate"
+
+	// TopicSyncProgress the topic of sync progress
+	TopicSyncProgress = "chain.syncProgress"
 )
 
 // EventSubscriber subscriber object
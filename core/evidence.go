@@ -0,0 +1,39 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+// Evidence is a proof of a miner signing conflicting blocks in a slot
+type Evidence struct {
+	Miner     string `json:"miner"`
+	Timestamp int64  `json:"timestamp"`
+	Evil      string `json:"evil"`
+
+	// hashes of the conflicting blocks, empty if only the report is seen on chain.
+	Blocks []string `json:"blocks"`
+
+	// the block including the report and its sender, 0 if pending.
+	Height   uint64 `json:"height"`
+	Reporter string `json:"reporter"`
+}
+
+// EvidenceReporter is implemented by the consensus keeping an evidence pool
+type EvidenceReporter interface {
+	PendingEvidence() []*Evidence
+	CommittedEvidence() []*Evidence
+}
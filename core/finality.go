@@ -0,0 +1,51 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// AggregatedWitness is the witnesses of the same blocks combined
+type AggregatedWitness struct {
+	Blocks []string `json:"blocks"`
+	Serial int64    `json:"serial"`
+
+	// the signers marked in the bitmap of the dynasty members, and their signs.
+	Bitmap  string   `json:"bitmap"`
+	Signers []string `json:"signers"`
+	Signs   []string `json:"signs"`
+}
+
+// FinalityCertificate is the witnesses finalizing a block
+type FinalityCertificate struct {
+	Hash   string `json:"hash"`
+	Height uint64 `json:"height"`
+
+	// the descendant certified, the block is finalized as its ancestor.
+	CertifiedHash   string `json:"certified_hash"`
+	CertifiedHeight uint64 `json:"certified_height"`
+
+	Witnesses []*AggregatedWitness `json:"witnesses"`
+}
+
+// FinalityCertifier returns the finality certificate of a block, implemented by the consensus
+type FinalityCertifier interface {
+	FinalityCertificate(hash byteutils.Hash) (*FinalityCertificate, error)
+}
@@ -29,6 +29,7 @@ import (
 	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
 	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -41,6 +42,23 @@ var (
 	GenesisCoinbase, _ = NewAddressFromPublicKey(make([]byte, PublicKeyDataLength))
 )
 
+// GenesisConsensusParams Key in the storage of genesis coinbase
+const GenesisConsensusParams = "consensus_params"
+
+// ConsensusParamsNormalizer is implemented by the consensus with params in genesis
+type ConsensusParamsNormalizer interface {
+	// NormalizeParams return the validated params filled with the defaults, nil if they equal the defaults.
+	NormalizeParams(params *corepb.GenesisConsensusParams) (*corepb.GenesisConsensusParams, error)
+}
+
+// normalizeConsensusParams return the params normalized by the consensus, the raw ones if it has no params.
+func normalizeConsensusParams(consensus Consensus, params *corepb.GenesisConsensusParams) (*corepb.GenesisConsensusParams, error) {
+	if normalizer, ok := consensus.(ConsensusParamsNormalizer); ok {
+		return normalizer.NormalizeParams(params)
+	}
+	return params, nil
+}
+
 // LoadGenesisConf load genesis conf for file
 func LoadGenesisConf(filePath string) (*corepb.Genesis, error) {
 	b, err := ioutil.ReadFile(filePath)
@@ -132,6 +150,33 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		}
 	}
 
+	// the consensus params are committed to the genesis state,
+	// so that the nodes with mismatched params cannot verify the blocks of each other.
+	// nothing is committed for the defaults, the genesis of the chains without params is unchanged.
+	params, err := normalizeConsensusParams(chain.ConsensusHandler(), conf.Consensus.Params)
+	if err != nil {
+		genesisBlock.RollBack()
+		return nil, err
+	}
+	if params != nil {
+		data, err := proto.Marshal(params)
+		if err != nil {
+			genesisBlock.RollBack()
+			return nil, err
+		}
+		if len(data) > 0 {
+			acc, err := genesisBlock.worldState.GetOrCreateUserAccount(GenesisCoinbase.address)
+			if err != nil {
+				genesisBlock.RollBack()
+				return nil, err
+			}
+			if err := acc.Put([]byte(GenesisConsensusParams), data); err != nil {
+				genesisBlock.RollBack()
+				return nil, err
+			}
+		}
+	}
+
 	// genesis transaction
 	declaration := fmt.Sprintf(
 		"%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n\n\n%s",
@@ -255,10 +300,26 @@ func DumpGenesis(chain *BlockChain) (*corepb.Genesis, error) {
 			Value:   balance.String(),
 		})
 	}
+	var params *corepb.GenesisConsensusParams
+	coinbase, err := genesis.worldState.GetOrCreateUserAccount(GenesisCoinbase.address)
+	if err != nil {
+		return nil, err
+	}
+	data, err := coinbase.Get([]byte(GenesisConsensusParams))
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		params = new(corepb.GenesisConsensusParams)
+		if err := proto.Unmarshal(data, params); err != nil {
+			return nil, err
+		}
+	}
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: genesis.ChainID()},
 		Consensus: &corepb.GenesisConsensus{
-			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
+			Dpos:   &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
+			Params: params,
 		},
 		TokenDistribution: distribution,
 	}, nil
@@ -280,6 +341,18 @@ func CheckGenesisConfByDB(pGenesisDB *corepb.Genesis, pGenesis *corepb.Genesis)
 			return ErrGenesisNotEqualTokenLenInDB
 		}
 
+		// the params not set equal to the empty ones.
+		params, paramsDB := pGenesis.Consensus.Params, pGenesisDB.Consensus.Params
+		if params == nil {
+			params = new(corepb.GenesisConsensusParams)
+		}
+		if paramsDB == nil {
+			paramsDB = new(corepb.GenesisConsensusParams)
+		}
+		if !proto.Equal(params, paramsDB) {
+			return ErrGenesisNotEqualParamsInDB
+		}
+
 		// check dpos equal
 		for _, confDposAddr := range pGenesis.Consensus.Dpos.Dynasty {
 			contains := false
@@ -21,6 +21,8 @@ package core
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -50,3 +52,27 @@ func TestInvalidAddressInTokenDistribution(t *testing.T) {
 	_, err := NewGenesisBlock(mockConf, chain)
 	assert.Equal(t, err, ErrInvalidAddressFormat)
 }
+
+func TestGenesisConsensusParams(t *testing.T) {
+	conf := MockGenesisConf()
+	conf.Consensus.Params = &corepb.GenesisConsensusParams{BlockInterval: 5000, DynastyInterval: 105000, DynastySize: 6}
+	neb := testNeb(t)
+	genesis, err := NewGenesisBlock(conf, neb.chain)
+	assert.Nil(t, err)
+	assert.NotEqual(t, neb.chain.genesisBlock.StateRoot(), genesis.StateRoot())
+
+	// params are committed to the genesis state.
+	assert.Nil(t, neb.chain.StoreBlockToStorage(genesis))
+	dumpConf, err := DumpGenesis(neb.chain)
+	assert.Nil(t, err)
+	assert.True(t, proto.Equal(conf.Consensus.Params, dumpConf.Consensus.Params))
+
+	// the mock consensus keeps no dynasty in the genesis state.
+	dumpConf.Consensus.Dpos = conf.Consensus.Dpos
+	assert.Nil(t, CheckGenesisConfByDB(dumpConf, conf))
+
+	mismatched := MockGenesisConf()
+	assert.Equal(t, ErrGenesisNotEqualParamsInDB, CheckGenesisConfByDB(dumpConf, mismatched))
+	mismatched.Consensus.Params = &corepb.GenesisConsensusParams{BlockInterval: 15000, DynastyInterval: 105000, DynastySize: 6}
+	assert.Equal(t, ErrGenesisNotEqualParamsInDB, CheckGenesisConfByDB(dumpConf, mismatched))
+}
@@ -29,6 +29,8 @@ var (
 	metricsBlocktailHashGauge    = metrics.NewGauge("neb.block.tailhash")
 	metricsBlockRevertTimesGauge = metrics.NewGauge("neb.block.revertcount")
 	metricsBlockRevertMeter      = metrics.NewMeter("neb.block.revert")
+	metricsReorgDepth            = metrics.NewHistogramWithUniformSample("neb.block.reorg.depth", 100)
+	metricsReorgAlert            = metrics.NewCounter("neb.block.reorg.alert")
 	metricsBlockOnchainTimer     = metrics.NewTimer("neb.block.onchain")
 	metricsTxOnchainTimer        = metrics.NewTimer("neb.transaction.onchain")
 	metricsBlockPackTxTime       = metrics.NewGauge("neb.block.packtx")
@@ -40,6 +42,9 @@ var (
 	metricsLruCacheBlock       = metrics.NewGauge("neb.block.lru.blocks")
 	metricsLruTailBlock        = metrics.NewGauge("neb.block.lru.tailblock")
 
+	metricsCompactBlockReconstructed = metrics.NewCounter("neb.block.compact.reconstructed")
+	metricsCompactBlockMissingTx     = metrics.NewCounter("neb.block.compact.missingtx")
+
 	metricsDuplicatedBlock   = metrics.NewCounter("neb.block.duplicated")
 	metricsInvalidBlock      = metrics.NewCounter("neb.block.invalid")
 	metricsTxsInBlock        = metrics.NewGauge("neb.block.txs")
@@ -164,6 +164,10 @@ func (c *mockConsensus) VerifyBlock(block *Block) error {
 	return nil
 }
 
+func (c *mockConsensus) VerifyBlockHeader(block *Block) error {
+	return nil
+}
+
 func mockLess(a *Block, b *Block) bool {
 	if a.Height() != b.Height() {
 		return a.Height() < b.Height()
@@ -265,6 +269,10 @@ func (n MockNetService) Deregister(...*net.Subscriber) {}
 
 func (n MockNetService) Broadcast(name string, msg net.Serializable, priority int) {}
 func (n MockNetService) Relay(name string, msg net.Serializable, priority int)     {}
+func (n MockNetService) BroadcastByCapability(capability string, name string, msg net.Serializable, fallbackName string, fallback net.Serializable, priority int) {
+}
+func (n MockNetService) RegisterCapability(capability string)                           {}
+func (n MockNetService) RegisterPeerFilterPolicy(policy string, messageNames ...string) {}
 func (n MockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
 	received = msg
 	return nil
@@ -425,6 +433,12 @@ func (m *mockDip) CheckReward(tx *Transaction) error {
 
 // NewMockNeb create mock neb for unit testing
 func NewMockNeb(am AccountManager, consensus Consensus, nvm NVM) *MockNeb {
+	var ns MockNetService
+	return NewMockNebWithNetService(am, consensus, nvm, ns)
+}
+
+// NewMockNebWithNetService create mock neb on the given net service, e.g. a simulated network
+func NewMockNebWithNetService(am AccountManager, consensus Consensus, nvm NVM, ns net.Service) *MockNeb {
 	storage, _ := storage.NewMemoryStorage()
 	eventEmitter := NewEventEmitter(1024)
 	if am == nil {
@@ -438,7 +452,6 @@ func NewMockNeb(am AccountManager, consensus Consensus, nvm NVM) *MockNeb {
 	}
 
 	dip := &mockDip{}
-	var ns MockNetService
 	neb := &MockNeb{
 		genesis: MockGenesisConf(),
 		config: &nebletpb.Config{Chain: &nebletpb.ChainConfig{
@@ -460,7 +473,9 @@ func NewMockNeb(am AccountManager, consensus Consensus, nvm NVM) *MockNeb {
 	chain, _ := NewBlockChain(neb)
 	chain.BlockPool().RegisterInNetwork(neb.ns)
 	neb.chain = chain
-	consensus.Setup(neb)
+	if err := consensus.Setup(neb); err != nil {
+		panic(err)
+	}
 	chain.Setup(neb)
 
 	return neb
@@ -18,6 +18,11 @@ It has these top-level messages:
 	NetBlock
 	DownloadBlock
 	Random
+	CompactBlock
+	GetBlockTxs
+	BlockTxs
+	GetBlocksByRange
+	BlocksByRange
 */
 package corepb
 
@@ -500,6 +505,166 @@ func (m *Random) GetVrfProof() []byte {
 	return nil
 }
 
+type CompactBlock struct {
+	Header     *BlockHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	TxHashes   [][]byte     `protobuf:"bytes,2,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+	Dependency *dagpb.Dag   `protobuf:"bytes,3,opt,name=dependency" json:"dependency,omitempty"`
+	Height     uint64       `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CompactBlock) Reset()                    { *m = CompactBlock{} }
+func (m *CompactBlock) String() string            { return proto.CompactTextString(m) }
+func (*CompactBlock) ProtoMessage()               {}
+func (*CompactBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
+
+func (m *CompactBlock) GetHeader() *BlockHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetDependency() *dagpb.Dag {
+	if m != nil {
+		return m.Dependency
+	}
+	return nil
+}
+
+func (m *CompactBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetBlockTxs struct {
+	BlockHash []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Indexes   []uint32 `protobuf:"varint,2,rep,packed,name=indexes" json:"indexes,omitempty"`
+}
+
+func (m *GetBlockTxs) Reset()                    { *m = GetBlockTxs{} }
+func (m *GetBlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockTxs) ProtoMessage()               {}
+func (*GetBlockTxs) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
+
+func (m *GetBlockTxs) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetBlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+type BlockTxs struct {
+	BlockHash    []byte         `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Indexes      []uint32       `protobuf:"varint,2,rep,packed,name=indexes" json:"indexes,omitempty"`
+	Transactions []*Transaction `protobuf:"bytes,3,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *BlockTxs) Reset()                    { *m = BlockTxs{} }
+func (m *BlockTxs) String() string            { return proto.CompactTextString(m) }
+func (*BlockTxs) ProtoMessage()               {}
+func (*BlockTxs) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
+
+func (m *BlockTxs) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BlockTxs) GetIndexes() []uint32 {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
+}
+
+func (m *BlockTxs) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type GetBlocksByRange struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Count  uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Skip   uint32 `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
+}
+
+func (m *GetBlocksByRange) Reset()                    { *m = GetBlocksByRange{} }
+func (m *GetBlocksByRange) String() string            { return proto.CompactTextString(m) }
+func (*GetBlocksByRange) ProtoMessage()               {}
+func (*GetBlocksByRange) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{13} }
+
+func (m *GetBlocksByRange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlocksByRange) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetBlocksByRange) GetSkip() uint32 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+type BlocksByRange struct {
+	Height uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Skip   uint32   `protobuf:"varint,2,opt,name=skip,proto3" json:"skip,omitempty"`
+	Blocks []*Block `protobuf:"bytes,3,rep,name=blocks" json:"blocks,omitempty"`
+}
+
+func (m *BlocksByRange) Reset()                    { *m = BlocksByRange{} }
+func (m *BlocksByRange) String() string            { return proto.CompactTextString(m) }
+func (*BlocksByRange) ProtoMessage()               {}
+func (*BlocksByRange) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{14} }
+
+func (m *BlocksByRange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlocksByRange) GetSkip() uint32 {
+	if m != nil {
+		return m.Skip
+	}
+	return 0
+}
+
+func (m *BlocksByRange) GetBlocks() []*Block {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*ContractMeta)(nil), "corepb.ContractMeta")
@@ -511,60 +676,72 @@ func init() {
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
 	proto.RegisterType((*Random)(nil), "corepb.Random")
+	proto.RegisterType((*CompactBlock)(nil), "corepb.CompactBlock")
+	proto.RegisterType((*GetBlockTxs)(nil), "corepb.GetBlockTxs")
+	proto.RegisterType((*BlockTxs)(nil), "corepb.BlockTxs")
+	proto.RegisterType((*GetBlocksByRange)(nil), "corepb.GetBlocksByRange")
+	proto.RegisterType((*BlocksByRange)(nil), "corepb.BlocksByRange")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x8a, 0x2b, 0x45,
-	0x10, 0x26, 0xc9, 0xe4, 0xaf, 0x26, 0x59, 0x0e, 0xed, 0x22, 0xe3, 0xaa, 0x6c, 0x18, 0x51, 0x82,
-	0x62, 0x02, 0xab, 0xb0, 0x7a, 0xe7, 0xd1, 0x73, 0x71, 0x14, 0x95, 0xa5, 0xf5, 0x46, 0x10, 0x42,
-	0x4d, 0x4f, 0xef, 0x64, 0x30, 0xd3, 0x3d, 0x74, 0x77, 0xe2, 0xd9, 0x47, 0xf0, 0x5d, 0xbc, 0xf1,
-	0x3d, 0x7c, 0x28, 0xe9, 0xea, 0x9e, 0xec, 0xec, 0xf1, 0x80, 0x78, 0x35, 0xfd, 0xd5, 0x37, 0x5f,
-	0x53, 0xf5, 0x55, 0x55, 0x43, 0x5a, 0x1c, 0xb4, 0xf8, 0x6d, 0xd3, 0x1a, 0xed, 0x34, 0x9b, 0x08,
-	0x6d, 0x64, 0x5b, 0x5c, 0xdd, 0x56, 0xb5, 0xdb, 0x1f, 0x8b, 0x8d, 0xd0, 0xcd, 0x56, 0xc9, 0xe2,
-	0x78, 0x40, 0x5b, 0xeb, 0x6d, 0xa5, 0x3f, 0x8d, 0x60, 0x2b, 0x74, 0xd3, 0x68, 0xb5, 0x2d, 0xb1,
-	0xda, 0xb6, 0x85, 0xff, 0x84, 0x0b, 0xae, 0xbe, 0xf8, 0x6f, 0xa1, 0xb2, 0x52, 0xd9, 0xa3, 0xf5,
-	0x3a, 0xeb, 0xd0, 0xc9, 0xa0, 0xcc, 0xff, 0x1e, 0xc0, 0xf4, 0xb9, 0x10, 0xfa, 0xa8, 0x1c, 0xcb,
-	0x60, 0x8a, 0x65, 0x69, 0xa4, 0xb5, 0xd9, 0x60, 0x35, 0x58, 0x2f, 0x78, 0x07, 0x3d, 0x53, 0xe0,
-	0x01, 0x95, 0x90, 0xd9, 0x30, 0x30, 0x11, 0xb2, 0x4b, 0x18, 0x2b, 0xed, 0xe3, 0xa3, 0xd5, 0x60,
-	0x9d, 0xf0, 0x00, 0xd8, 0xbb, 0x30, 0x3f, 0xa1, 0xb1, 0xbb, 0x3d, 0xda, 0x7d, 0x96, 0x90, 0x62,
-	0xe6, 0x03, 0x2f, 0xd1, 0xee, 0xd9, 0x35, 0xa4, 0x45, 0x6d, 0xdc, 0x7e, 0xd7, 0x1e, 0x50, 0xc8,
-	0x6c, 0x4c, 0x34, 0x50, 0xe8, 0xce, 0x47, 0xd8, 0x97, 0xb0, 0x14, 0x5a, 0x39, 0x83, 0xc2, 0xed,
-	0x1a, 0xe9, 0x30, 0x9b, 0xac, 0x06, 0xeb, 0xf4, 0xe6, 0x72, 0x13, 0x6c, 0xda, 0x7c, 0x13, 0xc9,
-	0x1f, 0xa4, 0x43, 0xbe, 0x10, 0x3d, 0x94, 0xaf, 0x61, 0xd1, 0x67, 0x7d, 0xe2, 0x27, 0x69, 0x6c,
-	0xad, 0x15, 0x95, 0x34, 0xe7, 0x1d, 0xcc, 0x3f, 0x87, 0xe4, 0x05, 0x3a, 0x64, 0x0c, 0x12, 0xf7,
-	0xd0, 0xca, 0x48, 0xd3, 0xd9, 0xab, 0x5a, 0x7c, 0x38, 0x68, 0x2c, 0xbb, 0x72, 0x23, 0xcc, 0xff,
-	0x1c, 0x42, 0xfa, 0xb3, 0x41, 0x65, 0x51, 0xb8, 0x5a, 0x2b, 0xaf, 0xa6, 0x1a, 0x83, 0x5f, 0x74,
-	0xf6, 0xb1, 0x7b, 0xa3, 0x9b, 0x28, 0xa5, 0x33, 0xbb, 0x80, 0xa1, 0xd3, 0xe4, 0xd1, 0x82, 0x0f,
-	0x9d, 0xf6, 0xb6, 0x9d, 0xf0, 0x70, 0x94, 0xd1, 0x9c, 0x00, 0x1e, 0xcd, 0x1c, 0xf7, 0xcd, 0x7c,
-	0x0f, 0xe6, 0xae, 0x6e, 0xa4, 0x75, 0xd8, 0xb4, 0x64, 0xc5, 0x88, 0x3f, 0x06, 0xd8, 0x0a, 0x92,
-	0x12, 0x1d, 0x66, 0x53, 0xf2, 0x68, 0xd1, 0x79, 0xe4, 0x6b, 0xe3, 0xc4, 0xb0, 0x77, 0x60, 0x26,
-	0xf6, 0x58, 0xab, 0x5d, 0x5d, 0x66, 0xb3, 0xd5, 0x60, 0xbd, 0xe4, 0x53, 0xc2, 0xdf, 0x96, 0xbe,
-	0x4f, 0x15, 0xda, 0x5d, 0x6b, 0x6a, 0x21, 0xb3, 0x79, 0xe8, 0x53, 0x85, 0xf6, 0xce, 0xe3, 0x8e,
-	0x3c, 0xd4, 0x4d, 0xed, 0x32, 0x38, 0x93, 0xdf, 0x7b, 0xcc, 0x9e, 0xc1, 0x08, 0x0f, 0x55, 0x96,
-	0xd2, 0x7d, 0xfe, 0xe8, 0xcb, 0xb6, 0x75, 0xa5, 0xb2, 0x45, 0x28, 0xdb, 0x9f, 0xf3, 0x3f, 0x46,
-	0x90, 0x7e, 0xed, 0x07, 0xfd, 0xa5, 0xc4, 0x52, 0x9a, 0x37, 0xda, 0x75, 0x0d, 0x69, 0x8b, 0x46,
-	0x2a, 0x17, 0xa6, 0x25, 0xb8, 0x06, 0x21, 0x44, 0xf3, 0x72, 0x05, 0x33, 0xa1, 0x6b, 0x55, 0xa0,
-	0xed, 0xec, 0x3a, 0xe3, 0xa7, 0xde, 0x8c, 0x5f, 0xf7, 0xa6, 0x5f, 0xf9, 0xe4, 0x69, 0xe5, 0x31,
-	0xff, 0xe9, 0xbf, 0xf3, 0x9f, 0x3d, 0xe6, 0xcf, 0xde, 0x07, 0xa0, 0x65, 0xd9, 0x19, 0xad, 0x5d,
-	0x34, 0x68, 0x4e, 0x11, 0xae, 0xb5, 0xf3, 0xf7, 0xbb, 0x57, 0x36, 0x90, 0xc1, 0xa0, 0xa9, 0x7b,
-	0x65, 0x89, 0xba, 0x86, 0x54, 0x9e, 0xa4, 0x72, 0x91, 0x4d, 0x43, 0x55, 0x21, 0x44, 0x3f, 0x3c,
-	0x87, 0x8b, 0xf3, 0x52, 0x86, 0x7f, 0x16, 0xd4, 0xc1, 0xab, 0xcd, 0x39, 0x1c, 0x46, 0x3d, 0x9c,
-	0xbd, 0x86, 0x2f, 0x45, 0x1f, 0xb2, 0x8f, 0x60, 0x62, 0x50, 0x95, 0xba, 0xc9, 0x96, 0x24, 0xbd,
-	0xe8, 0x9a, 0xcf, 0x29, 0xca, 0x23, 0xfb, 0x5d, 0x32, 0x1b, 0x3d, 0x4b, 0xf2, 0xbf, 0x06, 0x30,
-	0xa6, 0x5e, 0xb0, 0x4f, 0x60, 0xb2, 0xa7, 0x7e, 0x50, 0x1f, 0xd2, 0x9b, 0xb7, 0x3a, 0x5d, 0xaf,
-	0x55, 0x3c, 0xfe, 0xc2, 0x6e, 0x61, 0xe1, 0x1e, 0x07, 0xde, 0x66, 0xc3, 0xd5, 0xa8, 0x2f, 0xe9,
-	0x2d, 0x03, 0x7f, 0xf2, 0x23, 0xfb, 0x18, 0xa0, 0x94, 0xad, 0x54, 0xa5, 0x54, 0xe2, 0x81, 0x46,
-	0x3f, 0xbd, 0x81, 0x4d, 0x89, 0x15, 0x4d, 0x67, 0xc5, 0x7b, 0x2c, 0x7b, 0xdb, 0x67, 0x54, 0x57,
-	0x7b, 0x47, 0x0d, 0x4e, 0x78, 0x44, 0xf9, 0xaf, 0x30, 0xff, 0x51, 0x3a, 0x4a, 0xcb, 0x9e, 0xf7,
-	0x2a, 0x6e, 0x2a, 0xed, 0xd5, 0x25, 0x8c, 0x0b, 0x74, 0x22, 0x8c, 0x4d, 0xc2, 0x03, 0x60, 0x1f,
-	0xc2, 0x84, 0x9e, 0x57, 0x9b, 0x8d, 0x28, 0xdb, 0xe5, 0x93, 0x02, 0x79, 0x24, 0xf3, 0x5f, 0x60,
-	0xd6, 0xdd, 0xfe, 0x3f, 0x2e, 0xff, 0x00, 0xc6, 0xa4, 0x8f, 0x25, 0xbd, 0x76, 0x77, 0xe0, 0xf2,
-	0x5b, 0x58, 0xbe, 0xd0, 0xbf, 0x2b, 0xff, 0x66, 0x9c, 0xef, 0x7f, 0xd3, 0x43, 0x41, 0x13, 0x37,
-	0xec, 0x6d, 0xcc, 0x57, 0x30, 0x09, 0xdd, 0xf3, 0xc3, 0x75, 0x32, 0xf7, 0x3b, 0x2b, 0x65, 0xd9,
-	0x3d, 0xc7, 0x27, 0x73, 0xff, 0x93, 0x94, 0xb4, 0xb6, 0x9e, 0x6a, 0x8d, 0xd6, 0xf7, 0x51, 0xed,
-	0xff, 0xbd, 0xf3, 0xb8, 0x98, 0xd0, 0xc3, 0xfe, 0xd9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa9,
-	0xc7, 0x49, 0xf3, 0x62, 0x06, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x8a, 0x2b, 0x45,
+	0x10, 0x26, 0xff, 0x93, 0x9a, 0x99, 0x65, 0x69, 0x0f, 0x32, 0xee, 0x51, 0x36, 0x8c, 0x28, 0x41,
+	0x31, 0x81, 0x55, 0x58, 0xbd, 0xf3, 0x1c, 0x0f, 0xba, 0x8a, 0xca, 0xd2, 0xee, 0x8d, 0x20, 0x84,
+	0x9e, 0x9e, 0xde, 0xc9, 0x70, 0x32, 0xdd, 0xc3, 0x74, 0x27, 0x66, 0xc1, 0x17, 0xf0, 0x1d, 0x7c,
+	0x04, 0x6f, 0x7c, 0x0f, 0x1f, 0x4a, 0xba, 0xba, 0x27, 0x99, 0x3d, 0x67, 0xc1, 0xf5, 0xe7, 0x2a,
+	0xf5, 0xd5, 0x37, 0x55, 0x54, 0x7d, 0x55, 0xd5, 0x81, 0x30, 0xdb, 0x28, 0xfe, 0x72, 0x51, 0x37,
+	0xca, 0x28, 0x32, 0xe6, 0xaa, 0x11, 0x75, 0x76, 0x76, 0x59, 0x94, 0x66, 0xbd, 0xcd, 0x16, 0x5c,
+	0x55, 0x4b, 0x29, 0xb2, 0xed, 0x86, 0xe9, 0x52, 0x2d, 0x0b, 0xf5, 0x91, 0x07, 0x4b, 0xae, 0xaa,
+	0x4a, 0xc9, 0x65, 0xce, 0x8a, 0x65, 0x9d, 0xd9, 0x1f, 0x97, 0xe0, 0xec, 0xd3, 0xbf, 0x0f, 0x94,
+	0x5a, 0x48, 0xbd, 0xd5, 0x36, 0x4e, 0x1b, 0x66, 0x84, 0x8b, 0x4c, 0xff, 0xec, 0xc1, 0xe4, 0x19,
+	0xe7, 0x6a, 0x2b, 0x0d, 0x49, 0x60, 0xc2, 0xf2, 0xbc, 0x11, 0x5a, 0x27, 0xbd, 0x59, 0x6f, 0x1e,
+	0xd1, 0x16, 0x5a, 0x26, 0x63, 0x1b, 0x26, 0xb9, 0x48, 0xfa, 0x8e, 0xf1, 0x90, 0x3c, 0x81, 0x91,
+	0x54, 0xd6, 0x3f, 0x98, 0xf5, 0xe6, 0x43, 0xea, 0x00, 0x79, 0x0a, 0xd3, 0x1d, 0x6b, 0xf4, 0x6a,
+	0xcd, 0xf4, 0x3a, 0x19, 0x62, 0x44, 0x60, 0x1d, 0x57, 0x4c, 0xaf, 0xc9, 0x39, 0x84, 0x59, 0xd9,
+	0x98, 0xf5, 0xaa, 0xde, 0x30, 0x2e, 0x92, 0x11, 0xd2, 0x80, 0xae, 0x6b, 0xeb, 0x21, 0x9f, 0x41,
+	0xcc, 0x95, 0x34, 0x0d, 0xe3, 0x66, 0x55, 0x09, 0xc3, 0x92, 0xf1, 0xac, 0x37, 0x0f, 0x2f, 0x9e,
+	0x2c, 0x9c, 0x4c, 0x8b, 0x2f, 0x3c, 0xf9, 0x9d, 0x30, 0x8c, 0x46, 0xbc, 0x83, 0xd2, 0x39, 0x44,
+	0x5d, 0xd6, 0x16, 0xbe, 0x13, 0x8d, 0x2e, 0x95, 0xc4, 0x96, 0xa6, 0xb4, 0x85, 0xe9, 0x27, 0x30,
+	0x7c, 0xc1, 0x0c, 0x23, 0x04, 0x86, 0xe6, 0xae, 0x16, 0x9e, 0x46, 0xdb, 0x46, 0xd5, 0xec, 0x6e,
+	0xa3, 0x58, 0xde, 0xb6, 0xeb, 0x61, 0xfa, 0x7b, 0x1f, 0xc2, 0x9b, 0x86, 0x49, 0xcd, 0xb8, 0x29,
+	0x95, 0xb4, 0xd1, 0xd8, 0xa3, 0xd3, 0x0b, 0x6d, 0xeb, 0xbb, 0x6d, 0x54, 0xe5, 0x43, 0xd1, 0x26,
+	0x27, 0xd0, 0x37, 0x0a, 0x35, 0x8a, 0x68, 0xdf, 0x28, 0x2b, 0xdb, 0x8e, 0x6d, 0xb6, 0xc2, 0x8b,
+	0xe3, 0xc0, 0x51, 0xcc, 0x51, 0x57, 0xcc, 0xb7, 0x61, 0x6a, 0xca, 0x4a, 0x68, 0xc3, 0xaa, 0x1a,
+	0xa5, 0x18, 0xd0, 0xa3, 0x83, 0xcc, 0x60, 0x98, 0x33, 0xc3, 0x92, 0x09, 0x6a, 0x14, 0xb5, 0x1a,
+	0xd9, 0xde, 0x28, 0x32, 0xe4, 0x2d, 0x08, 0xf8, 0x9a, 0x95, 0x72, 0x55, 0xe6, 0x49, 0x30, 0xeb,
+	0xcd, 0x63, 0x3a, 0x41, 0xfc, 0x75, 0x6e, 0xe7, 0x54, 0x30, 0xbd, 0xaa, 0x9b, 0x92, 0x8b, 0x64,
+	0xea, 0xe6, 0x54, 0x30, 0x7d, 0x6d, 0x71, 0x4b, 0x6e, 0xca, 0xaa, 0x34, 0x09, 0x1c, 0xc8, 0x6f,
+	0x2d, 0x26, 0xa7, 0x30, 0x60, 0x9b, 0x22, 0x09, 0x31, 0x9f, 0x35, 0x6d, 0xdb, 0xba, 0x2c, 0x64,
+	0x12, 0xb9, 0xb6, 0xad, 0x9d, 0xfe, 0x3a, 0x80, 0xf0, 0xb9, 0x5d, 0xf4, 0x2b, 0xc1, 0x72, 0xd1,
+	0x3c, 0x28, 0xd7, 0x39, 0x84, 0x35, 0x6b, 0x84, 0x34, 0x6e, 0x5b, 0x9c, 0x6a, 0xe0, 0x5c, 0xb8,
+	0x2f, 0x67, 0x10, 0x70, 0x55, 0xca, 0x8c, 0xe9, 0x56, 0xae, 0x03, 0xbe, 0xaf, 0xcd, 0xe8, 0x55,
+	0x6d, 0xba, 0x9d, 0x8f, 0xef, 0x77, 0xee, 0xeb, 0x9f, 0xbc, 0x5e, 0x7f, 0x70, 0xac, 0x9f, 0xbc,
+	0x03, 0x80, 0xc7, 0xb2, 0x6a, 0x94, 0x32, 0x5e, 0xa0, 0x29, 0x7a, 0xa8, 0x52, 0xc6, 0xe6, 0x37,
+	0x7b, 0xed, 0x48, 0x27, 0xd0, 0xc4, 0xec, 0x35, 0x52, 0xe7, 0x10, 0x8a, 0x9d, 0x90, 0xc6, 0xb3,
+	0xa1, 0xeb, 0xca, 0xb9, 0xf0, 0x83, 0x67, 0x70, 0x72, 0x38, 0x4a, 0xf7, 0x4d, 0x84, 0x13, 0x3c,
+	0x5b, 0x1c, 0xdc, 0x6e, 0xd5, 0x9d, 0x6d, 0x63, 0x68, 0xcc, 0xbb, 0x90, 0xbc, 0x0f, 0xe3, 0x86,
+	0xc9, 0x5c, 0x55, 0x49, 0x8c, 0xa1, 0x27, 0xed, 0xf0, 0x29, 0x7a, 0xa9, 0x67, 0xbf, 0x19, 0x06,
+	0x83, 0xd3, 0x61, 0xfa, 0x47, 0x0f, 0x46, 0x38, 0x0b, 0xf2, 0x21, 0x8c, 0xd7, 0x38, 0x0f, 0x9c,
+	0x43, 0x78, 0xf1, 0x46, 0x1b, 0xd7, 0x19, 0x15, 0xf5, 0x9f, 0x90, 0x4b, 0x88, 0xcc, 0x71, 0xe1,
+	0x75, 0xd2, 0x9f, 0x0d, 0xba, 0x21, 0x9d, 0x63, 0xa0, 0xf7, 0x3e, 0x24, 0x1f, 0x00, 0xe4, 0xa2,
+	0x16, 0x32, 0x17, 0x92, 0xdf, 0xe1, 0xea, 0x87, 0x17, 0xb0, 0xc8, 0x59, 0x81, 0xdb, 0x59, 0xd0,
+	0x0e, 0x4b, 0xde, 0xb4, 0x15, 0x95, 0xc5, 0xda, 0xe0, 0x80, 0x87, 0xd4, 0xa3, 0xf4, 0x27, 0x98,
+	0x7e, 0x2f, 0x0c, 0x96, 0xa5, 0x0f, 0x77, 0xe5, 0x2f, 0xd5, 0xda, 0xf6, 0x62, 0x32, 0x66, 0xb8,
+	0x5b, 0x9b, 0x21, 0x75, 0x80, 0xbc, 0x07, 0x63, 0x7c, 0x5e, 0x75, 0x32, 0xc0, 0x6a, 0xe3, 0x7b,
+	0x0d, 0x52, 0x4f, 0xa6, 0x3f, 0x42, 0xd0, 0x66, 0xff, 0x07, 0xc9, 0xdf, 0x85, 0x11, 0xc6, 0xfb,
+	0x96, 0x5e, 0xc9, 0xed, 0xb8, 0xf4, 0x12, 0xe2, 0x17, 0xea, 0x67, 0x69, 0xdf, 0x8c, 0x43, 0xfe,
+	0x87, 0x1e, 0x0a, 0xdc, 0xb8, 0x7e, 0xe7, 0x62, 0x3e, 0x87, 0xb1, 0x9b, 0x9e, 0x5d, 0xae, 0x5d,
+	0x73, 0xbb, 0xd2, 0x42, 0xe4, 0xed, 0x73, 0xbc, 0x6b, 0x6e, 0x7f, 0x10, 0x02, 0xcf, 0xd6, 0x52,
+	0x75, 0xa3, 0xd4, 0xad, 0x8f, 0xb6, 0xdf, 0x5e, 0x5b, 0x9c, 0xfe, 0xd6, 0xb3, 0x6f, 0x60, 0x55,
+	0x33, 0x6e, 0xfe, 0xc5, 0xb8, 0x9f, 0xc2, 0xd4, 0xec, 0xf1, 0x12, 0x85, 0x9b, 0x75, 0x44, 0x03,
+	0xb3, 0xbf, 0x42, 0xfc, 0xbf, 0x8c, 0xf4, 0x4b, 0x08, 0xbf, 0xf2, 0xa2, 0xdf, 0xec, 0xb5, 0xbd,
+	0x30, 0x54, 0x6c, 0xd5, 0x51, 0x67, 0x8a, 0x1e, 0xbc, 0xfd, 0x04, 0x26, 0xa5, 0xcc, 0xc5, 0xde,
+	0x17, 0x13, 0xd3, 0x16, 0xa6, 0xbf, 0x40, 0xf0, 0x9f, 0x93, 0xbc, 0xb6, 0xdc, 0x83, 0x47, 0x2e,
+	0x77, 0x7a, 0x03, 0xa7, 0x6d, 0x17, 0xfa, 0xf9, 0x1d, 0x65, 0xb2, 0x10, 0x9d, 0x8e, 0x7b, 0xdd,
+	0x8e, 0xed, 0x1a, 0xe1, 0xff, 0x2b, 0x4e, 0x2a, 0xa6, 0x0e, 0xe0, 0xf0, 0x5f, 0x96, 0x35, 0xaa,
+	0x18, 0x53, 0xb4, 0xd3, 0x0c, 0xe2, 0xc7, 0xa5, 0x6c, 0x83, 0xfb, 0xc7, 0xe0, 0x47, 0x2e, 0x7d,
+	0x36, 0xc6, 0xff, 0xfd, 0x8f, 0xff, 0x1a, 0x00, 0xcd, 0xb4, 0x21, 0x45, 0x81, 0x08, 0x00, 0x00,
 }
@@ -12,6 +12,7 @@ It has these top-level messages:
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	GenesisConsensusParams
 	GenesisTokenDistribution
 */
 package corepb
@@ -91,6 +92,8 @@ func (m *GenesisMeta) GetChainId() uint32 {
 type GenesisConsensus struct {
 	// ChainID.
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
+	// consensus timing, the defaults of mainnet if not set.
+	Params *GenesisConsensusParams `protobuf:"bytes,2,opt,name=params" json:"params,omitempty"`
 }
 
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
@@ -105,6 +108,13 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	return nil
 }
 
+func (m *GenesisConsensus) GetParams() *GenesisConsensusParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
@@ -122,6 +132,50 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+type GenesisConsensusParams struct {
+	// block interval in ms, a multiple of 1000, default 15000.
+	BlockInterval int64 `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// dynasty interval in ms, a multiple of block interval, default 3150000.
+	DynastyInterval int64 `protobuf:"varint,2,opt,name=dynasty_interval,json=dynastyInterval,proto3" json:"dynasty_interval,omitempty"`
+	// number of miners in a dynasty, default 21.
+	DynastySize uint32 `protobuf:"varint,3,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// number of witnesses to finalize a block, default dynasty_size*2/3+1.
+	ConsensusSize uint32 `protobuf:"varint,4,opt,name=consensus_size,json=consensusSize,proto3" json:"consensus_size,omitempty"`
+}
+
+func (m *GenesisConsensusParams) Reset()                    { *m = GenesisConsensusParams{} }
+func (m *GenesisConsensusParams) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusParams) ProtoMessage()               {}
+func (*GenesisConsensusParams) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+
+func (m *GenesisConsensusParams) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusParams) GetDynastyInterval() int64 {
+	if m != nil {
+		return m.DynastyInterval
+	}
+	return 0
+}
+
+func (m *GenesisConsensusParams) GetDynastySize() uint32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *GenesisConsensusParams) GetConsensusSize() uint32 {
+	if m != nil {
+		return m.ConsensusSize
+	}
+	return 0
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -130,7 +184,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -151,28 +205,35 @@ func init() {
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*GenesisConsensusParams)(nil), "corepb.GenesisConsensusParams")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 353 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xd1, 0x4a, 0xc3, 0x30,
+	0x18, 0x85, 0xe9, 0x3a, 0x37, 0xfb, 0xd7, 0xea, 0x8c, 0x43, 0x22, 0x88, 0xd4, 0x82, 0x58, 0x6f,
+	0xc6, 0x98, 0xb0, 0x17, 0x70, 0x20, 0x13, 0x44, 0x89, 0xde, 0x8f, 0xb4, 0x0d, 0x1a, 0xb6, 0x25,
+	0xa5, 0xc9, 0x06, 0x1b, 0x3e, 0x96, 0x77, 0xbe, 0x9c, 0x34, 0x6d, 0xb7, 0x51, 0xb6, 0xcb, 0x73,
+	0xfe, 0xaf, 0xa7, 0xff, 0x49, 0x02, 0xde, 0x17, 0x13, 0x4c, 0x71, 0xd5, 0x4b, 0x33, 0xa9, 0x25,
+	0x6a, 0xc5, 0x32, 0x63, 0x69, 0x14, 0xfc, 0x59, 0xd0, 0x7e, 0x2e, 0x26, 0xe8, 0x1e, 0x9a, 0x73,
+	0xa6, 0x29, 0xb6, 0x7c, 0x2b, 0x74, 0x07, 0x17, 0xbd, 0x02, 0xe9, 0x95, 0xe3, 0x57, 0xa6, 0x29,
+	0x31, 0x00, 0x1a, 0x82, 0x13, 0x4b, 0xa1, 0x98, 0x50, 0x0b, 0x85, 0x1b, 0x86, 0xc6, 0x35, 0xfa,
+	0xa9, 0x9a, 0x93, 0x2d, 0x8a, 0xde, 0x00, 0x69, 0x39, 0x65, 0x62, 0x92, 0x70, 0xa5, 0x33, 0x1e,
+	0x2d, 0x34, 0x97, 0x02, 0xdb, 0xbe, 0x1d, 0xba, 0x03, 0xbf, 0x16, 0xf0, 0x99, 0x83, 0xa3, 0x1d,
+	0x8e, 0x9c, 0xeb, 0xba, 0x15, 0x84, 0xe0, 0xee, 0x6c, 0x87, 0xae, 0xe0, 0x38, 0xfe, 0xa6, 0x5c,
+	0x4c, 0x78, 0x62, 0x4a, 0x78, 0xa4, 0x6d, 0xf4, 0x38, 0x09, 0x7e, 0xa0, 0x53, 0xdf, 0x0c, 0xf5,
+	0xa1, 0x99, 0xa4, 0x52, 0x95, 0x7d, 0xaf, 0x0f, 0x35, 0x18, 0xa5, 0x52, 0x11, 0x43, 0xa2, 0x21,
+	0xb4, 0x52, 0x9a, 0xd1, 0x79, 0xd5, 0xfa, 0xe6, 0xd0, 0x37, 0xef, 0x86, 0x22, 0x25, 0x1d, 0xf4,
+	0xa1, 0xbb, 0x2f, 0x15, 0x61, 0x68, 0x27, 0x2b, 0x41, 0x95, 0x5e, 0x61, 0xcb, 0xb7, 0x43, 0x87,
+	0x54, 0x32, 0xf8, 0xb5, 0xe0, 0x72, 0x7f, 0x28, 0xba, 0x83, 0xd3, 0x68, 0x26, 0xe3, 0xe9, 0x84,
+	0x0b, 0xcd, 0xb2, 0x25, 0x9d, 0x99, 0x02, 0x36, 0xf1, 0x8c, 0x3b, 0x2e, 0x4d, 0xf4, 0x00, 0x9d,
+	0x32, 0x6c, 0x0b, 0x36, 0x0c, 0x78, 0x56, 0xfa, 0x1b, 0xf4, 0x16, 0x4e, 0x2a, 0x54, 0xf1, 0x35,
+	0xc3, 0xb6, 0x39, 0x3b, 0xb7, 0xf4, 0x3e, 0xf8, 0x9a, 0xe5, 0x3f, 0xdd, 0xdc, 0x63, 0x01, 0x35,
+	0x0d, 0xe4, 0x6d, 0xdc, 0x1c, 0x0b, 0x5e, 0x00, 0x1f, 0xba, 0xbf, 0xbc, 0x2c, 0x4d, 0x92, 0x8c,
+	0xa9, 0xe2, 0xc4, 0x1d, 0x52, 0x49, 0xd4, 0x85, 0xa3, 0x25, 0x9d, 0x2d, 0x98, 0xd9, 0xcf, 0x21,
+	0x85, 0x88, 0x5a, 0xe6, 0xa5, 0x3e, 0xfe, 0x0f, 0x00, 0xf1, 0x22, 0xb1, 0xe3, 0xba, 0x02, 0x00,
+	0x00,
 }
@@ -0,0 +1,53 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+// MinerPerformance is the performance of a miner in a dynasty serial
+type MinerPerformance struct {
+	Miner string `json:"miner"`
+
+	// blocks expected by the slots, produced and missed.
+	Expected int `json:"expected"`
+	Produced int `json:"produced"`
+	Missed   int `json:"missed"`
+
+	// the latest heartbeat and score of the node.
+	HeartbeatSerial int64  `json:"heartbeat_serial"`
+	Score           string `json:"score"`
+
+	// blocks witnessed and evil reports submitted.
+	Witnesses int `json:"witnesses"`
+	Reports   int `json:"reports"`
+}
+
+// DynastyPerformance is the performance of the miners in a dynasty serial
+type DynastyPerformance struct {
+	Serial      int64               `json:"serial"`
+	StartHeight uint64              `json:"start_height"`
+	EndHeight   uint64              `json:"end_height"`
+	Miners      []*MinerPerformance `json:"miners"`
+
+	// false if the witnesses are not counted, they are only kept for the recent serials.
+	WitnessesRecorded bool `json:"witnesses_recorded"`
+}
+
+// PerformanceReporter reports the performance of the miners, implemented by the consensus
+type PerformanceReporter interface {
+	DynastyPerformance(serial int64) (*DynastyPerformance, error)
+}
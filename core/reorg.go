@@ -0,0 +1,184 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// ReorgHistory Key in storage, the number of recorded reorgs.
+	ReorgHistory = "blockchain_reorg"
+
+	// MaxReorgHistory the max number of reorgs kept in storage.
+	MaxReorgHistory = 1024
+)
+
+// ReorgBlock is a block involved in a reorg
+type ReorgBlock struct {
+	Height uint64 `json:"height"`
+	Hash   string `json:"hash"`
+}
+
+// Reorg is a switch of the tail to a fork
+type Reorg struct {
+	OldTail  *ReorgBlock `json:"old_tail"`
+	NewTail  *ReorgBlock `json:"new_tail"`
+	Ancestor *ReorgBlock `json:"ancestor"`
+
+	// blocks reverted from the old tail and applied to the new tail.
+	Depth   uint64 `json:"depth"`
+	Applied uint64 `json:"applied"`
+
+	RevertedTxs uint64 `json:"reverted_txs"`
+	AppliedTxs  uint64 `json:"applied_txs"`
+
+	Timestamp int64 `json:"timestamp"`
+}
+
+// ReorgAlertHook is called with the reorgs deeper than the alert depth
+type ReorgAlertHook func(*Reorg)
+
+func newReorgBlock(block *Block) *ReorgBlock {
+	return &ReorgBlock{
+		Height: block.Height(),
+		Hash:   block.Hash().String(),
+	}
+}
+
+// SetReorgAlertDepth set the depth above which the reorgs are alerted, 0 to disable.
+func (bc *BlockChain) SetReorgAlertDepth(depth uint64) {
+	bc.reorgMutex.Lock()
+	defer bc.reorgMutex.Unlock()
+	bc.reorgAlertDepth = depth
+}
+
+// RegisterReorgAlertHook register a hook called with the alerted reorgs.
+func (bc *BlockChain) RegisterReorgAlertHook(hook ReorgAlertHook) {
+	bc.reorgMutex.Lock()
+	defer bc.reorgMutex.Unlock()
+	bc.reorgAlertHooks = append(bc.reorgAlertHooks, hook)
+}
+
+func (bc *BlockChain) recordReorg(oldTail, newTail, ancestor *Block, reverted []*Block) {
+	reorg := &Reorg{
+		OldTail:   newReorgBlock(oldTail),
+		NewTail:   newReorgBlock(newTail),
+		Ancestor:  newReorgBlock(ancestor),
+		Depth:     uint64(len(reverted)),
+		Applied:   newTail.Height() - ancestor.Height(),
+		Timestamp: time.Now().Unix(),
+	}
+	for _, v := range reverted {
+		reorg.RevertedTxs += uint64(len(v.transactions))
+	}
+	for b := newTail; b != nil && !b.Hash().Equals(ancestor.Hash()); b = bc.GetBlock(b.ParentHash()) {
+		reorg.AppliedTxs += uint64(len(b.transactions))
+	}
+
+	metricsReorgDepth.Update(int64(reorg.Depth))
+
+	data, err := json.Marshal(reorg)
+	if err != nil {
+		return
+	}
+	if err := bc.storeReorg(data); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"reorg": string(data),
+			"err":   err,
+		}).Error("Failed to store reorg.")
+	}
+	go bc.eventEmitter.Trigger(&state.Event{
+		Topic: TopicReorg,
+		Data:  string(data),
+	})
+
+	bc.reorgMutex.RLock()
+	depth, hooks := bc.reorgAlertDepth, bc.reorgAlertHooks
+	bc.reorgMutex.RUnlock()
+	if depth == 0 || reorg.Depth <= depth {
+		return
+	}
+	metricsReorgAlert.Inc(1)
+	logging.CLog().WithFields(logrus.Fields{
+		"reorg": string(data),
+		"limit": depth,
+	}).Error("Chain reorg is deeper than the alert depth.")
+	for _, hook := range hooks {
+		go hook(reorg)
+	}
+}
+
+func (bc *BlockChain) reorgCount() (uint64, error) {
+	bytes, err := bc.storage.Get([]byte(ReorgHistory))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(bytes), nil
+}
+
+func reorgKey(idx uint64) []byte {
+	return append([]byte(ReorgHistory), byteutils.FromUint64(idx%MaxReorgHistory)...)
+}
+
+func (bc *BlockChain) storeReorg(data []byte) error {
+	count, err := bc.reorgCount()
+	if err != nil {
+		return err
+	}
+	if err := bc.storage.Put(reorgKey(count), data); err != nil {
+		return err
+	}
+	return bc.storage.Put([]byte(ReorgHistory), byteutils.FromUint64(count+1))
+}
+
+// ReorgHistory return the latest reorgs at most limit, newest first.
+func (bc *BlockChain) ReorgHistory(limit int) ([]*Reorg, error) {
+	count, err := bc.reorgCount()
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > MaxReorgHistory {
+		limit = MaxReorgHistory
+	}
+
+	reorgs := []*Reorg{}
+	for idx := count; idx > 0 && len(reorgs) < limit && count-idx < MaxReorgHistory; idx-- {
+		data, err := bc.storage.Get(reorgKey(idx - 1))
+		if err != nil {
+			return nil, err
+		}
+		reorg := new(Reorg)
+		if err := json.Unmarshal(data, reorg); err != nil {
+			return nil, err
+		}
+		reorgs = append(reorgs, reorg)
+	}
+	return reorgs, nil
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+)
+
+// SyncProgress is the progress of the active sync task.
+type SyncProgress struct {
+	Syncing        bool   `json:"syncing"`
+	StartingHeight uint64 `json:"starting_height"`
+	CurrentHeight  uint64 `json:"current_height"`
+	HighestHeight  uint64 `json:"highest_height"`
+	PendingChunks  int    `json:"pending_chunks"`
+	InflightChunks int    `json:"inflight_chunks"`
+
+	// rates in blocks per second.
+	DownloadRate  float64 `json:"download_rate"`
+	ExecutionRate float64 `json:"execution_rate"`
+
+	// estimated seconds to reach the highest height, 0 if unknown.
+	ETA int64 `json:"eta"`
+}
+
+func (p *SyncProgress) String() string {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
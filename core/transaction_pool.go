@@ -118,6 +118,7 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) erro
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(ns net.Service) {
 	ns.Register(net.NewSubscriber(pool, pool.receivedMessageCh, true, MessageTypeNewTx, net.MessageWeightNewTx))
+	ns.RegisterPeerFilterPolicy(PeerFilterPolicyTx, MessageTypeNewTx)
 	pool.ns = ns
 }
 
@@ -94,6 +94,11 @@ var (
 	ErrInvalidBlockCannotFindParentInLocalAndTryDownload = errors.New("invalid block received, download its parent from others")
 	ErrInvalidBlockCannotFindParentInLocalAndTrySync     = errors.New("invalid block received, sync its parent from others")
 	ErrBlockNotFound                                     = errors.New("block not found in blockchain cache nor chain")
+	ErrCannotResetSyncedChain                            = errors.New("cannot reset to a synced state unless the tail is genesis")
+	ErrCannotRollbackBelowLIB                            = errors.New("cannot rollback below the latest irreversible block unless forced")
+	ErrInvalidCheckpoint                                 = errors.New("invalid checkpoint")
+	ErrCheckpointMismatch                                = errors.New("block mismatches the checkpoint")
+	ErrBlockHistoryUnavailable                           = errors.New("block history before the state synced block is unavailable")
 
 	ErrInvalidConfigChainID          = errors.New("invalid chainID, genesis chainID not equal to chainID in config")
 	ErrCannotLoadGenesisConf         = errors.New("cannot load genesis conf")
@@ -102,6 +107,7 @@ var (
 	ErrGenesisNotEqualTokenInDB      = errors.New("Failed to check. genesis TokenDistribution not equal in db")
 	ErrGenesisNotEqualDynastyLenInDB = errors.New("Failed to check. genesis dynasty length not equal in db")
 	ErrGenesisNotEqualTokenLenInDB   = errors.New("Failed to check. genesis TokenDistribution length not equal in db")
+	ErrGenesisNotEqualParamsInDB     = errors.New("Failed to check. genesis consensus params not equal in db")
 
 	ErrLinkToWrongParentBlock = errors.New("link the block to a block who is not its parent")
 	ErrMissingParentBlock     = errors.New("cannot find the block's parent block in storage")
@@ -155,19 +161,20 @@ var (
 	ErrInvalidDelegateToNonCandidate     = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee = errors.New("cannot un-delegate from non-delegatee")
 
-	ErrCloneWorldState           = errors.New("Failed to clone world state")
-	ErrCloneAccountState         = errors.New("Failed to clone account state")
-	ErrCloneTxsState             = errors.New("Failed to clone txs state")
-	ErrCloneEventsState          = errors.New("Failed to clone events state")
-	ErrInvalidBlockStateRoot     = errors.New("invalid block state root hash")
-	ErrInvalidBlockTxsRoot       = errors.New("invalid block txs root hash")
-	ErrInvalidBlockEventsRoot    = errors.New("invalid block events root hash")
-	ErrInvalidBlockConsensusRoot = errors.New("invalid block consensus root hash")
-	ErrInvalidProtoToBlock       = errors.New("protobuf message cannot be converted into Block")
-	ErrInvalidProtoToBlockHeader = errors.New("protobuf message cannot be converted into BlockHeader")
-	ErrInvalidProtoToTransaction = errors.New("protobuf message cannot be converted into Transaction")
-	ErrInvalidTransactionData    = errors.New("invalid data in tx from Proto")
-	ErrInvalidDagBlock           = errors.New("block's dag is incorrect")
+	ErrCloneWorldState            = errors.New("Failed to clone world state")
+	ErrCloneAccountState          = errors.New("Failed to clone account state")
+	ErrCloneTxsState              = errors.New("Failed to clone txs state")
+	ErrCloneEventsState           = errors.New("Failed to clone events state")
+	ErrInvalidBlockStateRoot      = errors.New("invalid block state root hash")
+	ErrInvalidBlockTxsRoot        = errors.New("invalid block txs root hash")
+	ErrInvalidBlockEventsRoot     = errors.New("invalid block events root hash")
+	ErrInvalidBlockConsensusRoot  = errors.New("invalid block consensus root hash")
+	ErrInvalidProtoToBlock        = errors.New("protobuf message cannot be converted into Block")
+	ErrInvalidProtoToBlockHeader  = errors.New("protobuf message cannot be converted into BlockHeader")
+	ErrInvalidProtoToCompactBlock = errors.New("protobuf message cannot be converted into CompactBlock")
+	ErrInvalidProtoToTransaction  = errors.New("protobuf message cannot be converted into Transaction")
+	ErrInvalidTransactionData     = errors.New("invalid data in tx from Proto")
+	ErrInvalidDagBlock            = errors.New("block's dag is incorrect")
 
 	ErrCannotRevertLIB        = errors.New("cannot revert latest irreversible block")
 	ErrCannotLoadGenesisBlock = errors.New("cannot load genesis block from storage")
@@ -225,8 +232,23 @@ const (
 	MessageTypeParentBlockDownloadRequest = "dlblock"
 	MessageTypeBlockDownloadResponse      = "dlreply"
 	MessageTypeNewTx                      = "newtx"
+	MessageTypeNewCompactBlock            = "newcblock"
+	MessageTypeCompactBlockTxsRequest     = "getcbtxs"
+	MessageTypeCompactBlockTxsResponse    = "cbtxs"
+	MessageTypeBlocksByRangeRequest       = "getrange"
+	MessageTypeBlocksByRangeResponse      = "blockrange"
 )
 
+// Peer filter policies of the network.peer_filters config.
+const (
+	PeerFilterPolicyBlock = "block"
+	PeerFilterPolicyTx    = "tx"
+)
+
+// CapabilityCompactBlock is advertised in the handshake by the nodes receiving compact blocks,
+// the others are sent the full blocks.
+const CapabilityCompactBlock = "cblock"
+
 // Consensus interface of consensus algorithm.
 type Consensus interface {
 	Setup(Neblet) error
@@ -243,6 +265,7 @@ type Consensus interface {
 
 	Serial(timestamp int64) int64
 	VerifyBlock(*Block) error
+	VerifyBlockHeader(*Block) error
 	ForkChoice() error
 	UpdateLIB([]byteutils.Hash)
 
@@ -263,6 +286,16 @@ type SyncService interface {
 	StopActiveSync()
 	WaitingForFinish()
 	IsActiveSyncing() bool
+	SyncProgress() *SyncProgress
+}
+
+// LightClient interface of the light client following the verified headers
+type LightClient interface {
+	ChainID() uint32
+	Tail() *CompactBlock
+	GetHeaderByHash(hash byteutils.Hash) *CompactBlock
+	GetHeaderByHeight(height uint64) *CompactBlock
+	GetAccount(address byteutils.Hash, height uint64) (*corepb.Account, *CompactBlock, error)
 }
 
 // AccountManager interface of account mananger
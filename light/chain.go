@@ -0,0 +1,320 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	lightpb "github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	tailKey = []byte("light_tail")
+)
+
+func headerKey(hash byteutils.Hash) []byte {
+	return append([]byte("light_header_"), hash...)
+}
+
+func heightKey(height uint64) []byte {
+	return append([]byte("light_height_"), byteutils.FromUint64(height)...)
+}
+
+func dynastyKey(root byteutils.Hash) []byte {
+	return append([]byte("light_dynasty_"), root...)
+}
+
+// DynastyFetcher fetches the miners of a dynasty root from other nodes.
+type DynastyFetcher interface {
+	FetchDynasty(root byteutils.Hash) ([]byteutils.Hash, error)
+}
+
+// HeaderChain is a chain of verified block headers without transactions and states.
+// A header is accepted when it links to its parent and is signed by the proposer of
+// its slot in the dynasty committed by its consensus root, see pod.HeaderVerifier.
+// The genesis header is the one whose hash is core.GenesisHash, pin the later headers
+// with checkpoints to avoid following a forged chain from the very beginning.
+type HeaderChain struct {
+	chainID     uint32
+	storage     storage.Storage
+	checkpoints map[uint64]byteutils.Hash
+	verifier    *pod.HeaderVerifier
+
+	mu   sync.RWMutex
+	tail *core.CompactBlock
+}
+
+// NewHeaderChain return new HeaderChain, restoring the tail from the storage.
+func NewHeaderChain(chainID uint32, params *pod.Params, stor storage.Storage, checkpoints map[uint64]string) (*HeaderChain, error) {
+	parsed, err := core.ParseCheckpoints(checkpoints)
+	if err != nil {
+		return nil, err
+	}
+	hc := &HeaderChain{
+		chainID:     chainID,
+		storage:     stor,
+		checkpoints: parsed,
+	}
+	hc.verifier = pod.NewHeaderVerifier(params, parsed, hc.storedDynasty)
+
+	tailHash, err := stor.Get(tailKey)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+	if err == nil {
+		if hc.tail = hc.GetHeaderByHash(tailHash); hc.tail == nil {
+			return nil, ErrHeaderNotFound
+		}
+	}
+	return hc, nil
+}
+
+// ChainID return the chain id.
+func (hc *HeaderChain) ChainID() uint32 {
+	return hc.chainID
+}
+
+// Tail return the tail header, nil before the genesis header is accepted.
+func (hc *HeaderChain) Tail() *core.CompactBlock {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+	return hc.tail
+}
+
+// GetHeaderByHash return the header of the given hash.
+func (hc *HeaderChain) GetHeaderByHash(hash byteutils.Hash) *core.CompactBlock {
+	data, err := hc.storage.Get(headerKey(hash))
+	if err != nil {
+		return nil
+	}
+	pbHeader := new(corepb.CompactBlock)
+	if err := proto.Unmarshal(data, pbHeader); err != nil {
+		return nil
+	}
+	header := new(core.CompactBlock)
+	if err := header.FromProto(pbHeader); err != nil {
+		return nil
+	}
+	return header
+}
+
+// GetHeaderByHeight return the header at the given height of the chain.
+func (hc *HeaderChain) GetHeaderByHeight(height uint64) *core.CompactBlock {
+	hc.mu.RLock()
+	defer hc.mu.RUnlock()
+
+	if hc.tail == nil || height > hc.tail.Height() {
+		return nil
+	}
+	hash, err := hc.storage.Get(heightKey(height))
+	if err != nil {
+		return nil
+	}
+	return hc.GetHeaderByHash(hash)
+}
+
+// Append verifies the continuous headers and makes the last trusted one the tail. The headers
+// may fork from the chain below the tail, as long as they make the chain longer. The headers
+// following a dynasty not endorsed yet are dropped, they are appended again with more headers.
+func (hc *HeaderChain) Append(headers []*core.CompactBlock, fetcher DynastyFetcher) error {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	// the dynasties are fetched without holding the lock, they are verified by their roots.
+	for _, header := range headers {
+		if header.ConsensusRoot() == nil || header.Height() == 1 {
+			continue
+		}
+		if _, err := hc.dynasty(header.ConsensusRoot().DynastyRoot, fetcher); err != nil {
+			return err
+		}
+	}
+
+	hc.mu.Lock()
+	defer hc.mu.Unlock()
+
+	last := headers[len(headers)-1]
+	if hc.tail != nil && last.Height() <= hc.tail.Height() {
+		return ErrInvalidHeaderHeight
+	}
+
+	var parent *core.CompactBlock
+	if first := headers[0]; first.Height() > 1 {
+		if hc.tail == nil || first.Height() > hc.tail.Height()+1 {
+			return ErrInvalidHeaderHeight
+		}
+		hash, err := hc.storage.Get(heightKey(first.Height() - 1))
+		if err != nil {
+			return err
+		}
+		if parent = hc.GetHeaderByHash(hash); parent == nil {
+			return ErrHeaderNotFound
+		}
+	} else {
+		if err := hc.verifyGenesis(first); err != nil {
+			return err
+		}
+		parent = first
+	}
+
+	verified := headers
+	if parent == headers[0] {
+		verified = headers[1:]
+	}
+	var second *core.CompactBlock
+	if hash, err := hc.storage.Get(heightKey(2)); err == nil && parent.Height() >= 2 {
+		second = hc.GetHeaderByHash(hash)
+	}
+	trusted, err := hc.verifier.Verify(second, parent, verified)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":    err,
+			"parent": parent.Hash(),
+			"height": parent.Height(),
+		}).Debug("Failed to verify light headers.")
+		return err
+	}
+
+	headers = headers[:len(headers)-len(verified)+trusted]
+	if len(headers) == 0 || (hc.tail != nil && headers[len(headers)-1].Height() <= hc.tail.Height()) {
+		return ErrDynastyNotEndorsed
+	}
+	last = headers[len(headers)-1]
+	for _, header := range headers {
+		if err := hc.storeHeader(header); err != nil {
+			return err
+		}
+	}
+	if err := hc.storage.Put(tailKey, last.Hash()); err != nil {
+		return err
+	}
+	hc.tail = last
+
+	logging.VLog().WithFields(logrus.Fields{
+		"tail":   last.Hash(),
+		"height": last.Height(),
+	}).Debug("Appended light headers.")
+	return nil
+}
+
+func (hc *HeaderChain) verifyGenesis(header *core.CompactBlock) error {
+	if checkpoint, ok := hc.checkpoints[header.Height()]; ok && !checkpoint.Equals(header.Hash()) {
+		return core.ErrCheckpointMismatch
+	}
+	if header.Height() != 1 || header.ChainID() != hc.chainID || !header.Hash().Equals(core.GenesisHash) {
+		return ErrInvalidGenesisHeader
+	}
+	return nil
+}
+
+func (hc *HeaderChain) storeHeader(header *core.CompactBlock) error {
+	pbHeader, err := header.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(pbHeader)
+	if err != nil {
+		return err
+	}
+	if err := hc.storage.Put(headerKey(header.Hash()), data); err != nil {
+		return err
+	}
+	return hc.storage.Put(heightKey(header.Height()), header.Hash())
+}
+
+// storedDynasty return the miners of the dynasty root in storage, in the order of the trie.
+func (hc *HeaderChain) storedDynasty(root byteutils.Hash) ([]byteutils.Hash, error) {
+	data, err := hc.storage.Get(dynastyKey(root))
+	if err != nil {
+		return nil, err
+	}
+	dynasty := new(lightpb.Dynasty)
+	if err := proto.Unmarshal(data, dynasty); err != nil {
+		return nil, err
+	}
+	miners := make([]byteutils.Hash, len(dynasty.Miners))
+	for idx, miner := range dynasty.Miners {
+		miners[idx] = miner
+	}
+	return miners, nil
+}
+
+// dynasty return the miners of the dynasty root, fetching and verifying them if not stored.
+func (hc *HeaderChain) dynasty(root byteutils.Hash, fetcher DynastyFetcher) ([]byteutils.Hash, error) {
+	if miners, err := hc.storedDynasty(root); err == nil {
+		return miners, nil
+	}
+
+	fetched, err := fetcher.FetchDynasty(root)
+	if err != nil {
+		return nil, err
+	}
+	miners, err := verifyDynasty(root, fetched)
+	if err != nil {
+		return nil, err
+	}
+
+	dynasty := &lightpb.Dynasty{Root: root}
+	for _, miner := range miners {
+		dynasty.Miners = append(dynasty.Miners, miner)
+	}
+	data, err := proto.Marshal(dynasty)
+	if err != nil {
+		return nil, err
+	}
+	if err := hc.storage.Put(dynastyKey(root), data); err != nil {
+		return nil, err
+	}
+	return miners, nil
+}
+
+// verifyDynasty rebuilds the dynasty trie, keyed and valued by the miner addresses, and checks its root,
+// return the miners in the order of the trie.
+func verifyDynasty(root byteutils.Hash, miners []byteutils.Hash) ([]byteutils.Hash, error) {
+	if len(miners) == 0 {
+		return nil, ErrWrongDynastyRoot
+	}
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	dynastyTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, miner := range miners {
+		if _, err := dynastyTrie.Put(miner, miner); err != nil {
+			return nil, err
+		}
+	}
+	if !root.Equals(dynastyTrie.RootHash()) {
+		return nil, ErrWrongDynastyRoot
+	}
+	return pod.TraverseDynasty(dynastyTrie)
+}
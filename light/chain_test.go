@@ -0,0 +1,279 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	lightpb "github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mintBlocks mints count blocks on the chain of neb, every fourth one packs a transfer.
+func mintBlocks(t *testing.T, neb *core.MockNeb, count int) {
+	chain := neb.BlockChain()
+	from, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	to, _ := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	assert.Nil(t, neb.AccountManager().Unlock(from, []byte("passphrase"), time.Second*60*60*24*365))
+
+	for i := 0; i < count; i++ {
+		context, err := chain.TailBlock().WorldState().NextConsensusState(dpos.BlockIntervalInMs / dpos.SecondInMs)
+		assert.Nil(t, err)
+		coinbase, err := core.AddressParseFromBytes(context.Proposer())
+		assert.Nil(t, err)
+		assert.Nil(t, neb.AccountManager().Unlock(coinbase, []byte("passphrase"), time.Second*60*60*24*365))
+
+		block, err := chain.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(context)
+		block.SetTimestamp(chain.TailBlock().Timestamp() + dpos.BlockIntervalInMs/dpos.SecondInMs)
+		if i%4 == 0 {
+			nonce := chain.TailBlock().GetAccount
+			acc, err := nonce(from.Bytes())
+			assert.Nil(t, err)
+			value, _ := util.NewUint128FromInt(1)
+			gasLimit, _ := util.NewUint128FromInt(200000)
+			tx, _ := core.NewTransaction(chain.ChainID(), from, to, value, acc.Nonce()+1, core.TxPayloadBinaryType, []byte("nas"), core.TransactionGasPrice, gasLimit)
+			assert.Nil(t, neb.AccountManager().SignTransaction(from, tx))
+			assert.Nil(t, chain.TransactionPool().Push(tx))
+			block.CollectTransactions(time.Now().UnixNano()/1e6 + 200)
+			assert.Equal(t, 1, len(block.Transactions()))
+		}
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, neb.AccountManager().SignBlock(coinbase, block))
+		assert.Nil(t, chain.BlockPool().Push(block))
+	}
+}
+
+// newPodNeb return the mock neb of the default PoD consensus.
+func newPodNeb(t *testing.T, am *account.Manager, ns net.Service) *core.MockNeb {
+	consensus := pod.NewPoD()
+	consensus.SetSlashingStorage(newMemoryStorage(t))
+	if ns == nil {
+		return core.NewMockNeb(am, consensus, nil)
+	}
+	return core.NewMockNebWithNetService(am, consensus, nil, ns)
+}
+
+// serverFetcher fetches the dynasties from the server directly.
+type serverFetcher struct {
+	server *Server
+	miners []byteutils.Hash
+	during func()
+}
+
+func (f *serverFetcher) FetchDynasty(root byteutils.Hash) ([]byteutils.Hash, error) {
+	if f.during != nil {
+		f.during()
+	}
+	if f.miners != nil {
+		return f.miners, nil
+	}
+	dynasty, err := f.server.generateDynasty(&lightpb.GetDynasty{Root: root})
+	if err != nil {
+		return nil, err
+	}
+	miners := make([]byteutils.Hash, len(dynasty.Miners))
+	for idx, miner := range dynasty.Miners {
+		miners[idx] = miner
+	}
+	return miners, nil
+}
+
+func generateHeaders(t *testing.T, server *Server, from uint64, count uint32) []*core.CompactBlock {
+	resp, err := server.generateHeaders(&lightpb.GetHeaders{From: from, Count: count})
+	assert.Nil(t, err)
+	headers := make([]*core.CompactBlock, len(resp.Headers))
+	for idx, pbHeader := range resp.Headers {
+		headers[idx] = new(core.CompactBlock)
+		assert.Nil(t, headers[idx].FromProto(pbHeader))
+	}
+	return headers
+}
+
+func TestHeaderChain_Append(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := newPodNeb(t, am, nil)
+	mintBlocks(t, neb, 12)
+	chain := neb.BlockChain()
+	server := NewServer(chain, nil)
+	fetcher := &serverFetcher{server: server}
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	hc, err := NewHeaderChain(chain.ChainID(), newTestParams(t, neb), stor, map[uint64]string{5: chain.GetBlockOnCanonicalChainByHeight(5).Hash().String()})
+	assert.Nil(t, err)
+	assert.Nil(t, hc.Tail())
+
+	// the chain starts from the genesis.
+	headers := generateHeaders(t, server, 1, 8)
+	assert.Equal(t, 8, len(headers))
+	assert.Equal(t, ErrInvalidHeaderHeight, hc.Append(headers[1:], fetcher))
+	assert.Nil(t, hc.Append(headers[:4], fetcher))
+	assert.Equal(t, uint64(4), hc.Tail().Height())
+
+	// the headers must link to the chain and make it longer.
+	assert.Equal(t, ErrInvalidHeaderHeight, hc.Append(headers[1:3], fetcher))
+	pbHeader, err := headers[4].ToProto()
+	assert.Nil(t, err)
+	pbHeader.(*corepb.CompactBlock).Header.ParentHash = headers[2].Hash()
+	forged := new(core.CompactBlock)
+	assert.Nil(t, forged.FromProto(pbHeader))
+	assert.Equal(t, pod.ErrInvalidHeaderParent, hc.Append([]*core.CompactBlock{forged}, fetcher))
+
+	// the dynasty must match its root.
+	other := &serverFetcher{miners: []byteutils.Hash{chain.TailBlock().Coinbase().Bytes()}}
+	assert.Equal(t, ErrWrongDynastyRoot, newTestHeaderChain(t, neb).Append(headers, other))
+
+	// the dynasties are fetched without holding the lock.
+	unlocked := &serverFetcher{server: server, during: func() {
+		done := make(chan struct{})
+		go func() {
+			hc.Tail()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Error("header chain is locked while fetching the dynasty")
+		}
+	}}
+	assert.Nil(t, newTestHeaderChain(t, neb).Append(headers, unlocked))
+
+	assert.Nil(t, hc.Append(headers[3:], fetcher))
+	assert.Equal(t, chain.GetBlockOnCanonicalChainByHeight(8).Hash(), hc.Tail().Hash())
+	assert.Equal(t, chain.GetBlockOnCanonicalChainByHeight(6).Hash(), hc.GetHeaderByHeight(6).Hash())
+	assert.Nil(t, hc.GetHeaderByHeight(9))
+
+	// the signer must be the proposer of the slot.
+	parent := chain.GetBlockOnCanonicalChainByHeight(8)
+	context, err := parent.WorldState().NextConsensusState(dpos.BlockIntervalInMs / dpos.SecondInMs)
+	assert.Nil(t, err)
+	proposer, err := core.AddressParseFromBytes(context.Proposer())
+	assert.Nil(t, err)
+	signer := parent.Coinbase()
+	assert.False(t, signer.Equals(proposer))
+	block, err := chain.NewBlockFromParent(proposer, parent)
+	assert.Nil(t, err)
+	block.WorldState().SetConsensusState(context)
+	block.SetTimestamp(parent.Timestamp() + dpos.BlockIntervalInMs/dpos.SecondInMs)
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, am.SignBlock(signer, block))
+	assert.Equal(t, core.ErrInvalidBlockProposer, hc.Append([]*core.CompactBlock{core.NewCompactBlock(block)}, fetcher))
+
+	// the tail is restored from the storage.
+	restored, err := NewHeaderChain(chain.ChainID(), newTestParams(t, neb), stor, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, hc.Tail().Hash(), restored.Tail().Hash())
+
+	// the headers mismatching the checkpoints are rejected.
+	checkpointed, err := NewHeaderChain(chain.ChainID(), newTestParams(t, neb), newMemoryStorage(t), map[uint64]string{5: chain.GetBlockOnCanonicalChainByHeight(4).Hash().String()})
+	assert.Nil(t, err)
+	assert.Equal(t, core.ErrCheckpointMismatch, checkpointed.Append(headers, fetcher))
+	_, err = NewHeaderChain(chain.ChainID(), newTestParams(t, neb), newMemoryStorage(t), map[uint64]string{5: "00"})
+	assert.Equal(t, core.ErrInvalidCheckpoint, err)
+
+	// the headers of another chain are rejected.
+	another, err := NewHeaderChain(chain.ChainID()+1, newTestParams(t, neb), newMemoryStorage(t), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidGenesisHeader, another.Append(headers, fetcher))
+}
+
+func TestClient_verifyAccountProof(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := newPodNeb(t, am, nil)
+	mintBlocks(t, neb, 4)
+	chain := neb.BlockChain()
+	server := NewServer(chain, nil)
+
+	tail := chain.TailBlock()
+	from, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	resp, err := server.generateAccountProof(&lightpb.GetAccountProof{BlockHash: tail.Hash(), Address: from.Bytes()})
+	assert.Nil(t, err)
+	assert.True(t, len(resp.Proof) > 0)
+
+	acc, err := verifyAccountProof(tail.StateRoot(), from.Bytes(), resp)
+	assert.Nil(t, err)
+	expect, err := tail.GetAccount(from.Bytes())
+	assert.Nil(t, err)
+	balance, err := util.NewUint128FromFixedSizeByteSlice(acc.Balance)
+	assert.Nil(t, err)
+	assert.Equal(t, expect.Balance(), balance)
+	assert.Equal(t, expect.Nonce(), acc.Nonce)
+
+	// the proof must match the state root and the address.
+	_, err = verifyAccountProof(tail.ParentHash(), from.Bytes(), resp)
+	assert.Equal(t, ErrWrongAccountProof, err)
+	to, _ := core.AddressParse("n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s")
+	_, err = verifyAccountProof(tail.StateRoot(), to.Bytes(), resp)
+	assert.Equal(t, ErrWrongAccountProof, err)
+	resp.Proof = resp.Proof[:len(resp.Proof)-1]
+	_, err = verifyAccountProof(tail.StateRoot(), from.Bytes(), resp)
+	assert.Equal(t, ErrWrongAccountProof, err)
+
+	// the missing account is returned empty with the exclusion proof.
+	missing, _ := core.AddressParse("n1Z6SbjLuAEXfhX1UJvXT6BB5osWYxVg3F3")
+	resp, err = server.generateAccountProof(&lightpb.GetAccountProof{BlockHash: tail.Hash(), Address: missing.Bytes()})
+	assert.Nil(t, err)
+	assert.True(t, len(resp.Proof) > 0)
+	acc, err = verifyAccountProof(tail.StateRoot(), missing.Bytes(), resp)
+	assert.Nil(t, err)
+	assert.Equal(t, &corepb.Account{Address: missing.Bytes()}, acc)
+	_, err = verifyAccountProof(tail.StateRoot(), from.Bytes(), resp)
+	assert.Equal(t, ErrWrongAccountProof, err)
+
+	// the empty proof is rejected.
+	resp.Proof = nil
+	_, err = verifyAccountProof(tail.StateRoot(), missing.Bytes(), resp)
+	assert.Equal(t, ErrWrongAccountProof, err)
+
+	// the unknown block is not served.
+	_, err = server.generateAccountProof(&lightpb.GetAccountProof{BlockHash: tail.StateRoot(), Address: from.Bytes()})
+	assert.Equal(t, ErrBlockNotFound, err)
+}
+
+func newMemoryStorage(t *testing.T) storage.Storage {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	return stor
+}
+
+func newTestParams(t *testing.T, neb *core.MockNeb) *pod.Params {
+	params, err := pod.NewParams(neb.Genesis())
+	assert.Nil(t, err)
+	return params
+}
+
+func newTestHeaderChain(t *testing.T, neb *core.MockNeb) *HeaderChain {
+	hc, err := NewHeaderChain(neb.BlockChain().ChainID(), newTestParams(t, neb), newMemoryStorage(t), nil)
+	assert.Nil(t, err)
+	return hc
+}
@@ -0,0 +1,421 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	lightpb "github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Client follows the headers served by full nodes and
+// fetches the account states on demand with merkle proofs.
+type Client struct {
+	chain      *HeaderChain
+	netService net.Service
+	quitCh     chan bool
+	messageCh  chan net.Message
+
+	pendingMu sync.Mutex
+	pending   map[string][]chan net.Message
+}
+
+// NewClient return new Client.
+func NewClient(chain *HeaderChain, netService net.Service) *Client {
+	return &Client{
+		chain:      chain,
+		netService: netService,
+		quitCh:     make(chan bool, 2),
+		messageCh:  make(chan net.Message, 128),
+		pending:    make(map[string][]chan net.Message),
+	}
+}
+
+// Start start light client.
+func (c *Client) Start() {
+	logging.VLog().Info("Starting Light Client.")
+
+	netService := c.netService
+	netService.Register(net.NewSubscriber(c, c.messageCh, false, net.LightHeadersResponse, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(c, c.messageCh, false, net.LightProofResponse, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(c, c.messageCh, false, net.LightDynastyResponse, net.MessageWeightZero))
+
+	go c.loop()
+	go c.followLoop()
+}
+
+// Stop stop light client.
+func (c *Client) Stop() {
+	netService := c.netService
+	netService.Deregister(net.NewSubscriber(c, c.messageCh, false, net.LightHeadersResponse, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(c, c.messageCh, false, net.LightProofResponse, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(c, c.messageCh, false, net.LightDynastyResponse, net.MessageWeightZero))
+
+	c.quitCh <- true
+	c.quitCh <- true
+}
+
+// Chain return the header chain.
+func (c *Client) Chain() *HeaderChain {
+	return c.chain
+}
+
+// ChainID return the chain id.
+func (c *Client) ChainID() uint32 {
+	return c.chain.ChainID()
+}
+
+// Tail return the tail header.
+func (c *Client) Tail() *core.CompactBlock {
+	return c.chain.Tail()
+}
+
+// GetHeaderByHash return the header of the given hash.
+func (c *Client) GetHeaderByHash(hash byteutils.Hash) *core.CompactBlock {
+	return c.chain.GetHeaderByHash(hash)
+}
+
+// GetHeaderByHeight return the header at the given height of the chain.
+func (c *Client) GetHeaderByHeight(height uint64) *core.CompactBlock {
+	return c.chain.GetHeaderByHeight(height)
+}
+
+func (c *Client) loop() {
+	logging.CLog().Info("Started Light Client.")
+
+	for {
+		select {
+		case <-c.quitCh:
+			logging.CLog().Info("Stopped Light Client.")
+			return
+		case message := <-c.messageCh:
+			var (
+				key string
+				err error
+			)
+			switch message.MessageType() {
+			case net.LightHeadersResponse:
+				resp := new(lightpb.Headers)
+				if err = proto.Unmarshal(message.Data(), resp); err == nil {
+					key = headersRequestKey(resp.From)
+				}
+			case net.LightProofResponse:
+				resp := new(lightpb.AccountProof)
+				if err = proto.Unmarshal(message.Data(), resp); err == nil {
+					key = proofRequestKey(resp.BlockHash, resp.Address)
+				}
+			case net.LightDynastyResponse:
+				resp := new(lightpb.Dynasty)
+				if err = proto.Unmarshal(message.Data(), resp); err == nil {
+					key = dynastyRequestKey(resp.Root)
+				}
+			default:
+				logging.VLog().WithFields(logrus.Fields{
+					"messageName": message.MessageType(),
+				}).Warn("Received unknown message.")
+				continue
+			}
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err":  err,
+					"pid":  message.MessageFrom(),
+					"type": message.MessageType(),
+				}).Debug("Invalid light response message data.")
+				c.netService.ClosePeer(message.MessageFrom(), ErrInvalidMessageData)
+				continue
+			}
+
+			c.pendingMu.Lock()
+			for _, ch := range c.pending[key] {
+				select {
+				case ch <- message:
+				default:
+				}
+			}
+			c.pendingMu.Unlock()
+		}
+	}
+}
+
+func (c *Client) followLoop() {
+	ticker := time.NewTicker(FollowInterval * time.Second)
+	defer ticker.Stop()
+
+	for {
+		if err := c.SyncHeaders(); err != nil && err != ErrNoPeerAvailable {
+			logging.VLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Debug("Failed to follow light headers.")
+		}
+
+		select {
+		case <-c.quitCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SyncHeaders downloads the headers after the tail until the peer has no more. When the
+// peer's chain forks below the tail, the client rewinds to find the fork point.
+func (c *Client) SyncHeaders() error {
+	rewind := uint64(0)
+	for {
+		from := uint64(1)
+		if tail := c.chain.Tail(); tail != nil {
+			from = tail.Height() + 1
+		}
+		if rewind > 0 {
+			if from > rewind+1 {
+				from -= rewind
+			} else {
+				from = 2
+			}
+		}
+
+		var headers []*core.CompactBlock
+		err := c.request(net.LightHeadersRequest, &lightpb.GetHeaders{From: from, Count: MaxHeadersPerRequest},
+			headersRequestKey(from), func(message net.Message) error {
+				resp := new(lightpb.Headers)
+				if err := proto.Unmarshal(message.Data(), resp); err != nil {
+					return err
+				}
+				headers = make([]*core.CompactBlock, len(resp.Headers))
+				for idx, pbHeader := range resp.Headers {
+					headers[idx] = new(core.CompactBlock)
+					if err := headers[idx].FromProto(pbHeader); err != nil {
+						return err
+					}
+					if headers[idx].Height() != from+uint64(idx) {
+						return ErrInvalidHeaderHeight
+					}
+				}
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+
+		if first := headers[0]; first.Height() > 1 {
+			if parent := c.chain.GetHeaderByHeight(first.Height() - 1); parent == nil || !parent.Hash().Equals(first.ParentHash()) {
+				// the peer forks below the tail.
+				if rewind >= MaxRewindHeaders || from <= 2 {
+					return ErrInvalidHeaderParent
+				}
+				rewind += MaxRewindHeaders / 4
+				continue
+			}
+		}
+
+		err = c.chain.Append(headers, c)
+		if err == ErrInvalidHeaderHeight && rewind > 0 {
+			// the peer's fork is not longer than the chain.
+			return nil
+		}
+		if err == ErrDynastyNotEndorsed {
+			// wait for more headers signed under the new dynasty.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rewind = 0
+	}
+}
+
+// FetchDynasty implements DynastyFetcher, the miners are verified by the header chain.
+func (c *Client) FetchDynasty(root byteutils.Hash) ([]byteutils.Hash, error) {
+	var miners []byteutils.Hash
+	err := c.request(net.LightDynastyRequest, &lightpb.GetDynasty{Root: root},
+		dynastyRequestKey(root), func(message net.Message) error {
+			resp := new(lightpb.Dynasty)
+			if err := proto.Unmarshal(message.Data(), resp); err != nil {
+				return err
+			}
+			fetched := make([]byteutils.Hash, len(resp.Miners))
+			for idx, miner := range resp.Miners {
+				fetched[idx] = miner
+			}
+			var err error
+			miners, err = verifyDynasty(root, fetched)
+			return err
+		})
+	return miners, err
+}
+
+// GetAccount return the account in the state of the header at the given height, the tail if height is 0.
+// The account is verified with the merkle proof against the state root. An account missing in
+// the state is verified with the exclusion proof, it is returned as a new account.
+func (c *Client) GetAccount(address byteutils.Hash, height uint64) (*corepb.Account, *core.CompactBlock, error) {
+	header := c.chain.Tail()
+	if height > 0 {
+		header = c.chain.GetHeaderByHeight(height)
+	}
+	if header == nil {
+		return nil, nil, ErrHeaderNotFound
+	}
+
+	var account *corepb.Account
+	err := c.request(net.LightProofRequest, &lightpb.GetAccountProof{BlockHash: header.Hash(), Address: address},
+		proofRequestKey(header.Hash(), address), func(message net.Message) error {
+			resp := new(lightpb.AccountProof)
+			if err := proto.Unmarshal(message.Data(), resp); err != nil {
+				return err
+			}
+			var err error
+			account, err = verifyAccountProof(header.StateRoot(), address, resp)
+			return err
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, header, nil
+}
+
+// verifyAccountProof verifies the merkle proof of the account against the state root.
+func verifyAccountProof(stateRoot byteutils.Hash, address byteutils.Hash, resp *lightpb.AccountProof) (*corepb.Account, error) {
+	if len(resp.Proof) == 0 {
+		return nil, ErrWrongAccountProof
+	}
+
+	proof := make(trie.MerkleProof, len(resp.Proof))
+	for idx, node := range resp.Proof {
+		proof[idx] = node.Values
+	}
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifier.VerifyExclusion(stateRoot, address, proof); err == nil {
+		return &corepb.Account{Address: address}, nil
+	}
+	if err := verifier.Verify(stateRoot, address, proof); err != nil {
+		return nil, ErrWrongAccountProof
+	}
+	value, err := trie.ProvedValue(proof)
+	if err != nil {
+		return nil, ErrWrongAccountProof
+	}
+
+	account := new(corepb.Account)
+	if err := proto.Unmarshal(value, account); err != nil {
+		return nil, ErrWrongAccountProof
+	}
+	if !address.Equals(account.Address) {
+		return nil, ErrWrongAccountProof
+	}
+	return account, nil
+}
+
+// request sends the request to a random peer and waits for the response accepted, the
+// peers responding wrong data are closed and the request is retried with others.
+func (c *Client) request(messageName string, msg proto.Message, key string, accept func(net.Message) error) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	respCh := make(chan net.Message, 16)
+	c.pendingMu.Lock()
+	c.pending[key] = append(c.pending[key], respCh)
+	c.pendingMu.Unlock()
+	defer func() {
+		c.pendingMu.Lock()
+		defer c.pendingMu.Unlock()
+		waiters := c.pending[key]
+		for idx, ch := range waiters {
+			if ch == respCh {
+				waiters = append(waiters[:idx], waiters[idx+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(c.pending, key)
+		} else {
+			c.pending[key] = waiters
+		}
+	}()
+
+	for retry := 0; retry < 3; retry++ {
+		peers := c.netService.SendMessageToPeers(messageName, data, net.MessagePriorityLow, new(net.RandomPeerFilter))
+		if len(peers) == 0 {
+			return ErrNoPeerAvailable
+		}
+		peer := peers[0]
+
+		timeout := time.NewTimer(RequestTimeout * time.Second)
+	WAIT:
+		for {
+			select {
+			case <-timeout.C:
+				logging.VLog().WithFields(logrus.Fields{
+					"pid":  peer,
+					"type": messageName,
+				}).Debug("Light client request timeout, retry.")
+				break WAIT
+			case message := <-respCh:
+				if message.MessageFrom() != peer {
+					continue
+				}
+				timeout.Stop()
+				if err := accept(message); err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"err":  err,
+						"pid":  peer,
+						"type": messageName,
+					}).Debug("Wrong light response, retry.")
+					c.netService.ClosePeer(peer, err)
+					break WAIT
+				}
+				return nil
+			}
+		}
+	}
+	return ErrRequestTimeout
+}
+
+func headersRequestKey(from uint64) string {
+	return fmt.Sprintf("%s:%d", net.LightHeadersRequest, from)
+}
+
+func proofRequestKey(blockHash, address byteutils.Hash) string {
+	return fmt.Sprintf("%s:%s:%s", net.LightProofRequest, blockHash.Hex(), address.Hex())
+}
+
+func dynastyRequestKey(root byteutils.Hash) string {
+	return fmt.Sprintf("%s:%s", net.LightDynastyRequest, root.Hex())
+}
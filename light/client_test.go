@@ -0,0 +1,93 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func waitForTail(t *testing.T, client *Client, expect *core.Block) {
+	deadline := time.Now().Add(3 * FollowInterval * time.Second)
+	for time.Now().Before(deadline) {
+		if tail := client.Chain().Tail(); tail != nil && tail.Hash().Equals(expect.Hash()) {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatal("light client doesn't follow the tail")
+}
+
+func TestClient_follow(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+
+	network := net.NewSimNetwork(1)
+	defer network.Close()
+
+	full := network.NewService("full")
+	assert.Nil(t, full.Start())
+	defer full.Stop()
+	neb := newPodNeb(t, am, full)
+	server := NewServer(neb.BlockChain(), full)
+	server.Start()
+	defer server.Stop()
+	mintBlocks(t, neb, 10)
+
+	ns := network.NewService("light")
+	assert.Nil(t, ns.Start())
+	defer ns.Stop()
+	hc, err := NewHeaderChain(neb.BlockChain().ChainID(), newTestParams(t, neb), newMemoryStorage(t), nil)
+	assert.Nil(t, err)
+	client := NewClient(hc, ns)
+	client.Start()
+	defer client.Stop()
+
+	chain := neb.BlockChain()
+	waitForTail(t, client, chain.TailBlock())
+
+	// the account is verified against the state root of the header.
+	from, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	acc, header, err := client.GetAccount(from.Bytes(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, chain.TailBlock().Hash(), header.Hash())
+	expect, err := chain.TailBlock().GetAccount(from.Bytes())
+	assert.Nil(t, err)
+	balance, err := util.NewUint128FromFixedSizeByteSlice(acc.Balance)
+	assert.Nil(t, err)
+	assert.Equal(t, expect.Balance(), balance)
+	assert.Equal(t, expect.Nonce(), acc.Nonce)
+
+	acc, header, err = client.GetAccount(from.Bytes(), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), header.Height())
+	assert.Equal(t, uint64(1), acc.Nonce)
+	_, _, err = client.GetAccount(from.Bytes(), 100)
+	assert.Equal(t, ErrHeaderNotFound, err)
+
+	// the new headers are followed.
+	mintBlocks(t, neb, 3)
+	waitForTail(t, client, chain.TailBlock())
+}
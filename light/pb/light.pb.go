@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: light.proto
+
+/*
+Package lightpb is a generated protocol buffer package.
+
+It is generated from these files:
+	light.proto
+
+It has these top-level messages:
+	GetHeaders
+	Headers
+	GetAccountProof
+	ProofNode
+	AccountProof
+	GetDynasty
+	Dynasty
+*/
+package lightpb
+
+import (
+	fmt "fmt"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	math "math"
+
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetHeaders struct {
+	From  uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GetHeaders) Reset()                    { *m = GetHeaders{} }
+func (m *GetHeaders) String() string            { return proto.CompactTextString(m) }
+func (*GetHeaders) ProtoMessage()               {}
+func (*GetHeaders) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{0} }
+
+func (m *GetHeaders) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *GetHeaders) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type Headers struct {
+	From    uint64                 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Headers []*corepb.CompactBlock `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
+}
+
+func (m *Headers) Reset()                    { *m = Headers{} }
+func (m *Headers) String() string            { return proto.CompactTextString(m) }
+func (*Headers) ProtoMessage()               {}
+func (*Headers) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{1} }
+
+func (m *Headers) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *Headers) GetHeaders() []*corepb.CompactBlock {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type GetAccountProof struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Address   []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetAccountProof) Reset()                    { *m = GetAccountProof{} }
+func (m *GetAccountProof) String() string            { return proto.CompactTextString(m) }
+func (*GetAccountProof) ProtoMessage()               {}
+func (*GetAccountProof) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{2} }
+
+func (m *GetAccountProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetAccountProof) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type ProofNode struct {
+	Values [][]byte `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+}
+
+func (m *ProofNode) Reset()                    { *m = ProofNode{} }
+func (m *ProofNode) String() string            { return proto.CompactTextString(m) }
+func (*ProofNode) ProtoMessage()               {}
+func (*ProofNode) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{3} }
+
+func (m *ProofNode) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type AccountProof struct {
+	BlockHash []byte       `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Address   []byte       `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Proof     []*ProofNode `protobuf:"bytes,3,rep,name=proof" json:"proof,omitempty"`
+}
+
+func (m *AccountProof) Reset()                    { *m = AccountProof{} }
+func (m *AccountProof) String() string            { return proto.CompactTextString(m) }
+func (*AccountProof) ProtoMessage()               {}
+func (*AccountProof) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{4} }
+
+func (m *AccountProof) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *AccountProof) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountProof) GetProof() []*ProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetDynasty struct {
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *GetDynasty) Reset()                    { *m = GetDynasty{} }
+func (m *GetDynasty) String() string            { return proto.CompactTextString(m) }
+func (*GetDynasty) ProtoMessage()               {}
+func (*GetDynasty) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{5} }
+
+func (m *GetDynasty) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type Dynasty struct {
+	Root   []byte   `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Miners [][]byte `protobuf:"bytes,2,rep,name=miners" json:"miners,omitempty"`
+}
+
+func (m *Dynasty) Reset()                    { *m = Dynasty{} }
+func (m *Dynasty) String() string            { return proto.CompactTextString(m) }
+func (*Dynasty) ProtoMessage()               {}
+func (*Dynasty) Descriptor() ([]byte, []int) { return fileDescriptorLight, []int{6} }
+
+func (m *Dynasty) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *Dynasty) GetMiners() [][]byte {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetHeaders)(nil), "lightpb.GetHeaders")
+	proto.RegisterType((*Headers)(nil), "lightpb.Headers")
+	proto.RegisterType((*GetAccountProof)(nil), "lightpb.GetAccountProof")
+	proto.RegisterType((*ProofNode)(nil), "lightpb.ProofNode")
+	proto.RegisterType((*AccountProof)(nil), "lightpb.AccountProof")
+	proto.RegisterType((*GetDynasty)(nil), "lightpb.GetDynasty")
+	proto.RegisterType((*Dynasty)(nil), "lightpb.Dynasty")
+}
+
+func init() { proto.RegisterFile("light.proto", fileDescriptorLight) }
+
+var fileDescriptorLight = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x4d, 0x6b, 0xf3, 0x30,
+	0x0c, 0xc7, 0x49, 0xdf, 0x42, 0xd5, 0x3c, 0x3c, 0x60, 0xca, 0x08, 0x83, 0x41, 0xf0, 0x2e, 0xb9,
+	0xcc, 0x81, 0x95, 0xed, 0xbe, 0x17, 0x68, 0x19, 0x6c, 0x0c, 0x7f, 0x81, 0x61, 0x27, 0x6e, 0x13,
+	0x96, 0x44, 0x9e, 0xed, 0x0c, 0xfa, 0xed, 0x47, 0x9c, 0x74, 0xc7, 0x9e, 0x76, 0xf3, 0xcf, 0x92,
+	0xfe, 0xfa, 0x4b, 0x82, 0x55, 0x5d, 0x1d, 0x4a, 0xc7, 0xb4, 0x41, 0x87, 0x24, 0xf4, 0xa0, 0xe5,
+	0xe5, 0xe6, 0x50, 0xb9, 0xb2, 0x93, 0x2c, 0xc7, 0x26, 0x6b, 0x95, 0xec, 0x6a, 0x61, 0x2b, 0xcc,
+	0x0e, 0x78, 0x33, 0x42, 0x96, 0xa3, 0x51, 0x99, 0x96, 0x99, 0xac, 0x31, 0xff, 0x1c, 0xaa, 0xe9,
+	0x3d, 0xc0, 0x56, 0xb9, 0x9d, 0x12, 0x85, 0x32, 0x96, 0x10, 0x98, 0xed, 0x0d, 0x36, 0x71, 0x90,
+	0x04, 0xe9, 0x8c, 0xfb, 0x37, 0x59, 0xc3, 0x3c, 0xc7, 0xae, 0x75, 0xf1, 0x24, 0x09, 0xd2, 0x7f,
+	0x7c, 0x00, 0xfa, 0x0a, 0xe1, 0xb9, 0x22, 0x06, 0x61, 0x39, 0x84, 0xe3, 0x49, 0x32, 0x4d, 0x57,
+	0xb7, 0x6b, 0xd6, 0x77, 0xd7, 0x92, 0x3d, 0x61, 0xa3, 0x45, 0xee, 0x1e, 0x7b, 0x0f, 0xfc, 0x94,
+	0x44, 0x5f, 0xe0, 0xff, 0x56, 0xb9, 0x87, 0xdc, 0x8b, 0xbf, 0x1b, 0xc4, 0x3d, 0xb9, 0x02, 0xf0,
+	0x46, 0x3f, 0x4a, 0x61, 0x4b, 0x2f, 0x1e, 0xf1, 0xa5, 0xff, 0xd9, 0x09, 0x5b, 0x92, 0x18, 0x42,
+	0x51, 0x14, 0x46, 0x59, 0xeb, 0x8d, 0x45, 0xfc, 0x84, 0xf4, 0x1a, 0x96, 0x5e, 0xe1, 0x0d, 0x0b,
+	0x45, 0x2e, 0x60, 0xf1, 0x2d, 0xea, 0x4e, 0xd9, 0x38, 0x48, 0xa6, 0x69, 0xc4, 0x47, 0xa2, 0x5f,
+	0x10, 0xfd, 0x49, 0x37, 0x92, 0xc2, 0x5c, 0xf7, 0x0a, 0xf1, 0xd4, 0xcf, 0x49, 0xd8, 0x78, 0x0e,
+	0xf6, 0xeb, 0x81, 0x0f, 0x09, 0x34, 0xf1, 0xab, 0x7e, 0x3e, 0xb6, 0xc2, 0xba, 0x63, 0xbf, 0x35,
+	0x83, 0xe8, 0xc6, 0x56, 0xfe, 0x4d, 0xef, 0x20, 0x3c, 0x13, 0xee, 0x67, 0x69, 0xaa, 0xf6, 0xb4,
+	0xd3, 0x88, 0x8f, 0x24, 0x17, 0xfe, 0x94, 0x9b, 0x9f, 0x01, 0x00, 0x0e, 0xe8, 0xc2, 0xc0, 0x17,
+	0x02, 0x00, 0x00,
+}
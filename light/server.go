@@ -0,0 +1,218 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	lightpb "github.com/nebulasio/go-nebulas/light/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Server serves the headers, account proofs and dynasties of a full node to light clients.
+type Server struct {
+	blockChain *core.BlockChain
+	netService net.Service
+	quitCh     chan bool
+	messageCh  chan net.Message
+}
+
+// NewServer return new Server.
+func NewServer(blockChain *core.BlockChain, netService net.Service) *Server {
+	return &Server{
+		blockChain: blockChain,
+		netService: netService,
+		quitCh:     make(chan bool, 1),
+		messageCh:  make(chan net.Message, 128),
+	}
+}
+
+// Start start light server.
+func (s *Server) Start() {
+	logging.VLog().Info("Starting Light Server.")
+
+	netService := s.netService
+	netService.Register(net.NewSubscriber(s, s.messageCh, false, net.LightHeadersRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(s, s.messageCh, false, net.LightProofRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(s, s.messageCh, false, net.LightDynastyRequest, net.MessageWeightZero))
+
+	go s.loop()
+}
+
+// Stop stop light server.
+func (s *Server) Stop() {
+	netService := s.netService
+	netService.Deregister(net.NewSubscriber(s, s.messageCh, false, net.LightHeadersRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(s, s.messageCh, false, net.LightProofRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(s, s.messageCh, false, net.LightDynastyRequest, net.MessageWeightZero))
+
+	s.quitCh <- true
+}
+
+func (s *Server) loop() {
+	logging.CLog().Info("Started Light Server.")
+
+	for {
+		select {
+		case <-s.quitCh:
+			logging.CLog().Info("Stopped Light Server.")
+			return
+		case message := <-s.messageCh:
+			var (
+				resp proto.Message
+				name string
+				err  error
+			)
+			switch message.MessageType() {
+			case net.LightHeadersRequest:
+				request := new(lightpb.GetHeaders)
+				if err = proto.Unmarshal(message.Data(), request); err == nil {
+					name = net.LightHeadersResponse
+					resp, err = s.generateHeaders(request)
+				}
+			case net.LightProofRequest:
+				request := new(lightpb.GetAccountProof)
+				if err = proto.Unmarshal(message.Data(), request); err == nil {
+					name = net.LightProofResponse
+					resp, err = s.generateAccountProof(request)
+				}
+			case net.LightDynastyRequest:
+				request := new(lightpb.GetDynasty)
+				if err = proto.Unmarshal(message.Data(), request); err == nil {
+					name = net.LightDynastyResponse
+					resp, err = s.generateDynasty(request)
+				}
+			default:
+				logging.VLog().WithFields(logrus.Fields{
+					"messageName": message.MessageType(),
+				}).Warn("Received unknown message.")
+				continue
+			}
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"err":  err,
+					"pid":  message.MessageFrom(),
+					"type": message.MessageType(),
+				}).Debug("Failed to serve light client request.")
+				if name == "" {
+					s.netService.ClosePeer(message.MessageFrom(), ErrInvalidMessageData)
+				}
+				continue
+			}
+			s.sendResponse(message.MessageFrom(), name, resp)
+		}
+	}
+}
+
+// generateHeaders return the canonical headers from the given height.
+func (s *Server) generateHeaders(request *lightpb.GetHeaders) (*lightpb.Headers, error) {
+	count := uint64(request.Count)
+	if count > MaxHeadersPerRequest {
+		count = MaxHeadersPerRequest
+	}
+
+	headers := &lightpb.Headers{From: request.From}
+	for height := request.From; height < request.From+count; height++ {
+		block := s.blockChain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			break
+		}
+		pbHeader, err := core.NewCompactBlock(block).ToProto()
+		if err != nil {
+			return nil, err
+		}
+		headers.Headers = append(headers.Headers, pbHeader.(*corepb.CompactBlock))
+	}
+	return headers, nil
+}
+
+// generateAccountProof return the merkle proof of the account in the state of the given block,
+// or the exclusion proof if the account is not found.
+func (s *Server) generateAccountProof(request *lightpb.GetAccountProof) (*lightpb.AccountProof, error) {
+	block := s.blockChain.GetBlock(request.BlockHash)
+	if block == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	stateTrie, err := trie.NewTrie(block.StateRoot(), s.blockChain.Storage(), false)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := stateTrie.Prove(request.Address)
+	if err == trie.ErrNotFound {
+		proof, err = stateTrie.ProveExclusion(request.Address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lightpb.AccountProof{BlockHash: request.BlockHash, Address: request.Address}
+	for _, node := range proof {
+		resp.Proof = append(resp.Proof, &lightpb.ProofNode{Values: node})
+	}
+	return resp, nil
+}
+
+// generateDynasty return the miners in the dynasty trie of the given root,
+// the miners are empty if the dynasty is not found.
+func (s *Server) generateDynasty(request *lightpb.GetDynasty) (*lightpb.Dynasty, error) {
+	resp := &lightpb.Dynasty{Root: request.Root}
+	dynastyTrie, err := trie.NewTrie(request.Root, s.blockChain.Storage(), false)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return resp, nil
+		}
+		return nil, err
+	}
+
+	iter, err := dynastyTrie.Iterator(nil)
+	if err != nil {
+		if err == storage.ErrKeyNotFound {
+			return resp, nil
+		}
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		resp.Miners = append(resp.Miners, iter.Value())
+		exist, err = iter.Next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Server) sendResponse(peerID string, messageName string, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"type": messageName,
+		}).Debug("Failed to marshal light response.")
+		return
+	}
+
+	s.netService.SendMessageToPeer(messageName, data, net.MessagePriorityLow, peerID)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package light
+
+import (
+	"errors"
+)
+
+// Error Types
+var (
+	ErrInvalidGenesisHeader = errors.New("invalid genesis header")
+	ErrInvalidHeaderHeight  = errors.New("invalid header height")
+	ErrInvalidHeaderParent  = errors.New("header doesn't link to its parent")
+	ErrDynastyNotEndorsed   = errors.New("header dynasty isn't endorsed by the former dynasty yet")
+	ErrWrongDynastyRoot     = errors.New("dynasty miners don't match the dynasty root")
+	ErrWrongAccountProof    = errors.New("wrong account proof")
+	ErrHeaderNotFound       = errors.New("header not found")
+	ErrBlockNotFound        = errors.New("block not found")
+	ErrNoPeerAvailable      = errors.New("no peer available to serve the light client")
+	ErrRequestTimeout       = errors.New("light client request timeout")
+	ErrInvalidMessageData   = errors.New("invalid light client message data")
+	ErrClientStopped        = errors.New("light client is stopped")
+)
+
+// Contants
+const (
+	MaxHeadersPerRequest = 128
+	RequestTimeout       = 10 // 10s.
+	FollowInterval       = 5  // 5s.
+	MaxRewindHeaders     = 128
+)
@@ -291,6 +291,13 @@ func (n mockNetService) Relay(name string, msg net.Serializable, priority int) {
 	bytes, _ := proto.Marshal(pb)
 	received = bytes
 }
+func (n mockNetService) BroadcastByCapability(capability string, name string, msg net.Serializable, fallbackName string, fallback net.Serializable, priority int) {
+	pb, _ := fallback.ToProto()
+	bytes, _ := proto.Marshal(pb)
+	received = bytes
+}
+func (n mockNetService) RegisterCapability(capability string)                           {}
+func (n mockNetService) RegisterPeerFilterPolicy(policy string, messageNames ...string) {}
 func (n mockNetService) SendMsg(name string, msg []byte, target string, priority int) error {
 	received = msg
 	return nil
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/nebulasio/go-nebulas/consensus/dev"
 	"github.com/nebulasio/go-nebulas/consensus/pod"
 
 	"github.com/nebulasio/go-nebulas/nr"
@@ -22,10 +23,12 @@ import (
 	"github.com/nebulasio/go-nebulas/cmd/console"
 
 	"net"
+	"path/filepath"
 
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/core"
 	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/light"
 	"github.com/nebulasio/go-nebulas/metrics"
 	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
 	nebnet "github.com/nebulasio/go-nebulas/net"
@@ -68,6 +71,12 @@ type Neblet struct {
 
 	syncService *nsync.Service
 
+	syncStorage *storage.DiskStorage
+
+	lightServer *light.Server
+
+	lightClient *light.Client
+
 	rpcServer rpc.GRPCServer
 
 	lock sync.RWMutex
@@ -139,6 +148,11 @@ func (n *Neblet) Setup() {
 		}).Fatal("Failed to setup net service.")
 	}
 
+	if n.config.Chain.LightClient {
+		n.setupLightClient()
+		return
+	}
+
 	// nvm
 	n.nvm = nvm.NewNebulasVM()
 	if err = n.nvm.CheckV8Run(); err != nil {
@@ -163,7 +177,16 @@ func (n *Neblet) Setup() {
 
 	// core
 	n.eventEmitter = core.NewEventEmitter(40960)
-	n.consensus = pod.NewPoD()
+	switch n.config.Chain.Consensus {
+	case "", "pod":
+		n.consensus = pod.NewPoD()
+	case "dev":
+		n.consensus = dev.NewDev()
+	default:
+		logging.CLog().WithFields(logrus.Fields{
+			"consensus": n.config.Chain.Consensus,
+		}).Fatal("Unsupported consensus.")
+	}
 	n.blockChain, err = core.NewBlockChain(n)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -182,17 +205,64 @@ func (n *Neblet) Setup() {
 			"err": err,
 		}).Fatal("Failed to setup blockchain.")
 	}
+	if reorg := n.config.Chain.Reorg; reorg != nil && len(reorg.AlertWebhook) > 0 {
+		n.blockChain.RegisterReorgAlertHook(newReorgWebhook(reorg.AlertWebhook))
+	}
 
 	// sync
 	n.syncService = nsync.NewService(n.blockChain, n.netService)
+	syncConfig, err := nsync.NewConfig(n.config.Sync)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Invalid sync config.")
+	}
+	n.syncService.SetConfig(syncConfig)
+	n.syncService.SetStateSync(n.config.Chain.StateSync)
+	n.syncStorage, err = storage.NewDiskStorage(filepath.Join(n.config.Chain.Datadir, "sync"))
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"dir": n.config.Chain.Datadir,
+			"err": err,
+		}).Fatal("Failed to open sync storage.")
+	}
+	n.syncService.SetStore(n.syncStorage)
 	n.blockChain.SetSyncService(n.syncService)
 
+	// light server
+	n.lightServer = light.NewServer(n.blockChain, n.netService)
+
 	// rpc
 	n.rpcServer = rpc.NewServer(n)
 
 	logging.CLog().Info("Setuped Neblet.")
 }
 
+// setupLightClient setup the header chain following the full nodes, skipping
+// the transaction pool, the block execution, the consensus and the sync.
+func (n *Neblet) setupLightClient() {
+	n.eventEmitter = core.NewEventEmitter(40960)
+
+	params, err := pod.NewParams(n.genesis)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to load consensus params.")
+	}
+	chain, err := light.NewHeaderChain(n.genesis.Meta.ChainId, params, n.storage, n.config.Chain.Checkpoints)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to setup header chain.")
+	}
+	n.lightClient = light.NewClient(chain, n.netService)
+
+	// rpc
+	n.rpcServer = rpc.NewServer(n)
+
+	logging.CLog().Info("Setuped Neblet in light client mode.")
+}
+
 // StartPprof start pprof http listen
 func (n *Neblet) StartPprof(listen string) error {
 	if len(listen) > 0 {
@@ -252,11 +322,22 @@ func (n *Neblet) Start() {
 		}).Fatal("Failed to start api gateway.")
 	}
 
+	if n.lightClient != nil {
+		n.eventEmitter.Start()
+		n.lightClient.Start()
+
+		metricsNebstartGauge.Update(1)
+
+		logging.CLog().Info("Started Neblet in light client mode.")
+		return
+	}
+
 	n.blockChain.Start()
 	n.blockChain.BlockPool().Start()
 	n.blockChain.TransactionPool().Start()
 	n.eventEmitter.Start()
 	n.syncService.Start()
+	n.lightServer.Start()
 
 	// start consensus
 	chainConf := n.config.Chain
@@ -314,6 +395,21 @@ func (n *Neblet) Stop() {
 		n.syncService = nil
 	}
 
+	if n.syncStorage != nil {
+		n.syncStorage.Close()
+		n.syncStorage = nil
+	}
+
+	if n.lightServer != nil {
+		n.lightServer.Stop()
+		n.lightServer = nil
+	}
+
+	if n.lightClient != nil {
+		n.lightClient.Stop()
+		n.lightClient = nil
+	}
+
 	if n.eventEmitter != nil {
 		n.eventEmitter.Stop()
 		n.eventEmitter = nil
@@ -402,6 +498,14 @@ func (n *Neblet) SyncService() *nsync.Service {
 	return n.syncService
 }
 
+// LightClient return the light client, nil if not in light client mode
+func (n *Neblet) LightClient() core.LightClient {
+	if n.lightClient == nil {
+		return nil
+	}
+	return n.lightClient
+}
+
 // IsActiveSyncing return if the neb is syncing blocks
 func (n *Neblet) IsActiveSyncing() bool {
 	if n.syncService == nil {
@@ -11,8 +11,13 @@ It has these top-level messages:
 	Config
 	NetworkConfig
 	ChainConfig
+	DevConfig
+	ReorgConfig
+	RemoteSignerConfig
+	FailoverConfig
 	RPCConfig
 	AppConfig
+	SyncConfig
 	PprofConfig
 	MiscConfig
 	StatsConfig
@@ -58,7 +63,7 @@ func (x StatsConfig_ReportingModule) String() string {
 	return proto.EnumName(StatsConfig_ReportingModule_name, int32(x))
 }
 func (StatsConfig_ReportingModule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorConfig, []int{7, 0}
+	return fileDescriptorConfig, []int{12, 0}
 }
 
 // Neblet global configurations.
@@ -69,6 +74,8 @@ type Config struct {
 	Chain *ChainConfig `protobuf:"bytes,2,opt,name=chain" json:"chain"`
 	// RPC config.
 	Rpc *RPCConfig `protobuf:"bytes,3,opt,name=rpc" json:"rpc"`
+	// Sync config.
+	Sync *SyncConfig `protobuf:"bytes,4,opt,name=sync" json:"sync"`
 	// Stats config.
 	Stats *StatsConfig `protobuf:"bytes,100,opt,name=stats" json:"stats"`
 	// Misc config.
@@ -105,6 +112,13 @@ func (m *Config) GetRpc() *RPCConfig {
 	return nil
 }
 
+func (m *Config) GetSync() *SyncConfig {
+	if m != nil {
+		return m.Sync
+	}
+	return nil
+}
+
 func (m *Config) GetStats() *StatsConfig {
 	if m != nil {
 		return m.Stats
@@ -144,6 +158,20 @@ type NetworkConfig struct {
 	NetworkId            uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id"`
 	StreamLimits         int32  `protobuf:"varint,5,opt,name=stream_limits,json=streamLimits,proto3" json:"stream_limits"`
 	ReservedStreamLimits int32  `protobuf:"varint,6,opt,name=reserved_stream_limits,json=reservedStreamLimits,proto3" json:"reserved_stream_limits"`
+	// Peer selection strategy of each message type or policy, e.g. "block": "latency". The messages
+	// go to all the peers if not set. The policies are block (new blocks), tx (new transactions)
+	// and sync (sync requests). Supported strategies: all, sqrt, random, latency, score, diversity.
+	PeerFilters map[string]string `protobuf:"bytes,7,rep,name=peer_filters,json=peerFilters" json:"peer_filters" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// NAT port mapping: none, any, upnp, pmp, or local:<external ip> for testing. Default is any.
+	Nat string `protobuf:"bytes,8,opt,name=nat,proto3" json:"nat"`
+	// External addresses announced to other peers, in the format of ip:port. Overrides the nat mapping.
+	ExternalAddrs []string `protobuf:"bytes,9,rep,name=external_addrs,json=externalAddrs" json:"external_addrs"`
+	// Directory of the message capture files. Capture is disabled if empty.
+	CaptureDir string `protobuf:"bytes,10,opt,name=capture_dir,json=captureDir,proto3" json:"capture_dir"`
+	// Max size of a capture file in MB before rotation. Default is 64.
+	CaptureFileSize uint32 `protobuf:"varint,11,opt,name=capture_file_size,json=captureFileSize,proto3" json:"capture_file_size"`
+	// Max count of capture files kept. Default is 8.
+	CaptureMaxFiles uint32 `protobuf:"varint,12,opt,name=capture_max_files,json=captureMaxFiles,proto3" json:"capture_max_files"`
 }
 
 func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
@@ -193,6 +221,48 @@ func (m *NetworkConfig) GetReservedStreamLimits() int32 {
 	return 0
 }
 
+func (m *NetworkConfig) GetPeerFilters() map[string]string {
+	if m != nil {
+		return m.PeerFilters
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetNat() string {
+	if m != nil {
+		return m.Nat
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetExternalAddrs() []string {
+	if m != nil {
+		return m.ExternalAddrs
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetCaptureDir() string {
+	if m != nil {
+		return m.CaptureDir
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetCaptureFileSize() uint32 {
+	if m != nil {
+		return m.CaptureFileSize
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetCaptureMaxFiles() uint32 {
+	if m != nil {
+		return m.CaptureMaxFiles
+	}
+	return 0
+}
+
 type ChainConfig struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id"`
@@ -225,6 +295,26 @@ type ChainConfig struct {
 	Dynasty            string   `protobuf:"bytes,32,opt,name=dynasty,proto3" json:"dynasty"`
 	// access control config path
 	Access string `protobuf:"bytes,33,opt,name=access,proto3" json:"access"`
+	// Relay new blocks as compact blocks, which carry the header and tx hashes only.
+	// The peers not advertising compact block support in the handshake still get the full blocks.
+	CompactBlockRelay bool `protobuf:"varint,34,opt,name=compact_block_relay,json=compactBlockRelay,proto3" json:"compact_block_relay"`
+	// Download the state at a recent LIB from peers instead of executing all the history blocks, on a new node.
+	// The LIB is verified from the last checkpoint, so the state is at most 24 dynasties after it.
+	StateSync bool `protobuf:"varint,35,opt,name=state_sync,json=stateSync,proto3" json:"state_sync"`
+	// Trusted checkpoints, block height to block hash in hex. Chains not containing them are rejected.
+	Checkpoints map[uint64]string `protobuf:"bytes,36,rep,name=checkpoints" json:"checkpoints" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Follow the verified block headers only and fetch the account states with merkle proofs on demand.
+	LightClient bool `protobuf:"varint,37,opt,name=light_client,json=lightClient,proto3" json:"light_client"`
+	// Active/standby failover of the miners sharing a key.
+	Failover *FailoverConfig `protobuf:"bytes,38,opt,name=failover" json:"failover"`
+	// Protocol and credentials of the remote sign server.
+	RemoteSigner *RemoteSignerConfig `protobuf:"bytes,39,opt,name=remote_signer,json=remoteSigner" json:"remote_signer"`
+	// Alerts of the chain reorgs.
+	Reorg *ReorgConfig `protobuf:"bytes,40,opt,name=reorg" json:"reorg"`
+	// Consensus engine of the chain, "pod" by default, or "dev" for the local dev chains.
+	Consensus string `protobuf:"bytes,41,opt,name=consensus,proto3" json:"consensus"`
+	// Block sealing of the dev engine.
+	Dev *DevConfig `protobuf:"bytes,42,opt,name=dev" json:"dev"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -351,6 +441,219 @@ func (m *ChainConfig) GetAccess() string {
 	return ""
 }
 
+func (m *ChainConfig) GetCompactBlockRelay() bool {
+	if m != nil {
+		return m.CompactBlockRelay
+	}
+	return false
+}
+
+func (m *ChainConfig) GetStateSync() bool {
+	if m != nil {
+		return m.StateSync
+	}
+	return false
+}
+
+func (m *ChainConfig) GetCheckpoints() map[uint64]string {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetLightClient() bool {
+	if m != nil {
+		return m.LightClient
+	}
+	return false
+}
+
+func (m *ChainConfig) GetFailover() *FailoverConfig {
+	if m != nil {
+		return m.Failover
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetRemoteSigner() *RemoteSignerConfig {
+	if m != nil {
+		return m.RemoteSigner
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetReorg() *ReorgConfig {
+	if m != nil {
+		return m.Reorg
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetConsensus() string {
+	if m != nil {
+		return m.Consensus
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetDev() *DevConfig {
+	if m != nil {
+		return m.Dev
+	}
+	return nil
+}
+
+type DevConfig struct {
+	// Seal a block every interval, unit is s. 0 to seal a block once transactions arrive.
+	SealInterval uint32 `protobuf:"varint,1,opt,name=seal_interval,json=sealInterval,proto3" json:"seal_interval"`
+}
+
+func (m *DevConfig) Reset()                    { *m = DevConfig{} }
+func (m *DevConfig) String() string            { return proto.CompactTextString(m) }
+func (*DevConfig) ProtoMessage()               {}
+func (*DevConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
+
+func (m *DevConfig) GetSealInterval() uint32 {
+	if m != nil {
+		return m.SealInterval
+	}
+	return 0
+}
+
+type ReorgConfig struct {
+	// Alert the reorgs reverting more blocks than the depth, 0 to disable.
+	AlertDepth uint32 `protobuf:"varint,1,opt,name=alert_depth,json=alertDepth,proto3" json:"alert_depth"`
+	// URL the alerted reorgs are posted to in json.
+	AlertWebhook string `protobuf:"bytes,2,opt,name=alert_webhook,json=alertWebhook,proto3" json:"alert_webhook"`
+}
+
+func (m *ReorgConfig) Reset()                    { *m = ReorgConfig{} }
+func (m *ReorgConfig) String() string            { return proto.CompactTextString(m) }
+func (*ReorgConfig) ProtoMessage()               {}
+func (*ReorgConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
+
+func (m *ReorgConfig) GetAlertDepth() uint32 {
+	if m != nil {
+		return m.AlertDepth
+	}
+	return 0
+}
+
+func (m *ReorgConfig) GetAlertWebhook() string {
+	if m != nil {
+		return m.AlertWebhook
+	}
+	return ""
+}
+
+type RemoteSignerConfig struct {
+	// The protocol of the remote sign server, "signer" for the signer service with mutual TLS,
+	// or "admin" for the admin api. The default is admin.
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol"`
+	// Client certificate and key of the miner, PEM encoded.
+	TlsCert string `protobuf:"bytes,2,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert"`
+	TlsKey  string `protobuf:"bytes,3,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key"`
+	// CA certificate to verify the signer.
+	TlsCa string `protobuf:"bytes,4,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca"`
+	// Name of the signer in its certificate, the host of remote_sign_server by default.
+	ServerName string `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name"`
+	// Timeout of a signing, unit is s, the default is 3.
+	Timeout uint32 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout"`
+}
+
+func (m *RemoteSignerConfig) Reset()                    { *m = RemoteSignerConfig{} }
+func (m *RemoteSignerConfig) String() string            { return proto.CompactTextString(m) }
+func (*RemoteSignerConfig) ProtoMessage()               {}
+func (*RemoteSignerConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+
+func (m *RemoteSignerConfig) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *RemoteSignerConfig) GetTlsCert() string {
+	if m != nil {
+		return m.TlsCert
+	}
+	return ""
+}
+
+func (m *RemoteSignerConfig) GetTlsKey() string {
+	if m != nil {
+		return m.TlsKey
+	}
+	return ""
+}
+
+func (m *RemoteSignerConfig) GetTlsCa() string {
+	if m != nil {
+		return m.TlsCa
+	}
+	return ""
+}
+
+func (m *RemoteSignerConfig) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *RemoteSignerConfig) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type FailoverConfig struct {
+	// The lease of the active miner, "file" on a lock file shared by the miners,
+	// or "peer" by the heartbeats of the active one. Empty to disable.
+	Lease string `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease"`
+	// Lock file of the file lease.
+	LeaseFile string `protobuf:"bytes,2,opt,name=lease_file,json=leaseFile,proto3" json:"lease_file"`
+	// Lease duration, unit is s, the default is 30.
+	LeaseDuration uint32 `protobuf:"varint,3,opt,name=lease_duration,json=leaseDuration,proto3" json:"lease_duration"`
+	// Name of the node in the lease, the node id by default.
+	NodeName string `protobuf:"bytes,4,opt,name=node_name,json=nodeName,proto3" json:"node_name"`
+}
+
+func (m *FailoverConfig) Reset()                    { *m = FailoverConfig{} }
+func (m *FailoverConfig) String() string            { return proto.CompactTextString(m) }
+func (*FailoverConfig) ProtoMessage()               {}
+func (*FailoverConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+
+func (m *FailoverConfig) GetLease() string {
+	if m != nil {
+		return m.Lease
+	}
+	return ""
+}
+
+func (m *FailoverConfig) GetLeaseFile() string {
+	if m != nil {
+		return m.LeaseFile
+	}
+	return ""
+}
+
+func (m *FailoverConfig) GetLeaseDuration() uint32 {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return 0
+}
+
+func (m *FailoverConfig) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen"`
@@ -367,7 +670,7 @@ type RPCConfig struct {
 func (m *RPCConfig) Reset()                    { *m = RPCConfig{} }
 func (m *RPCConfig) String() string            { return proto.CompactTextString(m) }
 func (*RPCConfig) ProtoMessage()               {}
-func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
+func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
 
 func (m *RPCConfig) GetRpcListen() []string {
 	if m != nil {
@@ -426,7 +729,7 @@ type AppConfig struct {
 func (m *AppConfig) Reset()                    { *m = AppConfig{} }
 func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
 func (*AppConfig) ProtoMessage()               {}
-func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
+func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
 
 func (m *AppConfig) GetLogLevel() string {
 	if m != nil {
@@ -477,6 +780,75 @@ func (m *AppConfig) GetVersion() string {
 	return ""
 }
 
+type SyncConfig struct {
+	// Blocks in a chunk, the default is 32.
+	ChunkSize uint32 `protobuf:"varint,1,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size"`
+	// Chunks asked in a sync request, the default is 10.
+	MaxChunksPerRequest uint32 `protobuf:"varint,2,opt,name=max_chunks_per_request,json=maxChunksPerRequest,proto3" json:"max_chunks_per_request"`
+	// Timeout of a chunk request, unit is s, the default is 10.
+	ChunkDataTimeout uint32 `protobuf:"varint,3,opt,name=chunk_data_timeout,json=chunkDataTimeout,proto3" json:"chunk_data_timeout"`
+	// Interval to retry the chunk headers request, unit is s, the default is 10.
+	SyncInterval uint32 `protobuf:"varint,4,opt,name=sync_interval,json=syncInterval,proto3" json:"sync_interval"`
+	// Chunk requests in flight to a peer, adapted to its throughput between min and max.
+	MinPeerWindow     uint32 `protobuf:"varint,5,opt,name=min_peer_window,json=minPeerWindow,proto3" json:"min_peer_window"`
+	InitialPeerWindow uint32 `protobuf:"varint,6,opt,name=initial_peer_window,json=initialPeerWindow,proto3" json:"initial_peer_window"`
+	MaxPeerWindow     uint32 `protobuf:"varint,7,opt,name=max_peer_window,json=maxPeerWindow,proto3" json:"max_peer_window"`
+}
+
+func (m *SyncConfig) Reset()                    { *m = SyncConfig{} }
+func (m *SyncConfig) String() string            { return proto.CompactTextString(m) }
+func (*SyncConfig) ProtoMessage()               {}
+func (*SyncConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+
+func (m *SyncConfig) GetChunkSize() uint32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetMaxChunksPerRequest() uint32 {
+	if m != nil {
+		return m.MaxChunksPerRequest
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetChunkDataTimeout() uint32 {
+	if m != nil {
+		return m.ChunkDataTimeout
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetSyncInterval() uint32 {
+	if m != nil {
+		return m.SyncInterval
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetMinPeerWindow() uint32 {
+	if m != nil {
+		return m.MinPeerWindow
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetInitialPeerWindow() uint32 {
+	if m != nil {
+		return m.InitialPeerWindow
+	}
+	return 0
+}
+
+func (m *SyncConfig) GetMaxPeerWindow() uint32 {
+	if m != nil {
+		return m.MaxPeerWindow
+	}
+	return 0
+}
+
 type PprofConfig struct {
 	// pprof listen address, if not configured, the function closes.
 	HttpListen string `protobuf:"bytes,1,opt,name=http_listen,json=httpListen,proto3" json:"http_listen"`
@@ -489,7 +861,7 @@ type PprofConfig struct {
 func (m *PprofConfig) Reset()                    { *m = PprofConfig{} }
 func (m *PprofConfig) String() string            { return proto.CompactTextString(m) }
 func (*PprofConfig) ProtoMessage()               {}
-func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+func (*PprofConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
 
 func (m *PprofConfig) GetHttpListen() string {
 	if m != nil {
@@ -520,7 +892,7 @@ type MiscConfig struct {
 func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
 func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
 func (*MiscConfig) ProtoMessage()               {}
-func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{11} }
 
 func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
 	if m != nil {
@@ -541,7 +913,7 @@ type StatsConfig struct {
 func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
 func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
 func (*StatsConfig) ProtoMessage()               {}
-func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{12} }
 
 func (m *StatsConfig) GetEnableMetrics() bool {
 	if m != nil {
@@ -587,7 +959,7 @@ type InfluxdbConfig struct {
 func (m *InfluxdbConfig) Reset()                    { *m = InfluxdbConfig{} }
 func (m *InfluxdbConfig) String() string            { return proto.CompactTextString(m) }
 func (*InfluxdbConfig) ProtoMessage()               {}
-func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
+func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{13} }
 
 func (m *InfluxdbConfig) GetHost() string {
 	if m != nil {
@@ -646,7 +1018,7 @@ type NbreConfig struct {
 func (m *NbreConfig) Reset()                    { *m = NbreConfig{} }
 func (m *NbreConfig) String() string            { return proto.CompactTextString(m) }
 func (*NbreConfig) ProtoMessage()               {}
-func (*NbreConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
+func (*NbreConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{14} }
 
 func (m *NbreConfig) GetRootDir() string {
 	if m != nil {
@@ -708,8 +1080,13 @@ func init() {
 	proto.RegisterType((*Config)(nil), "nebletpb.Config")
 	proto.RegisterType((*NetworkConfig)(nil), "nebletpb.NetworkConfig")
 	proto.RegisterType((*ChainConfig)(nil), "nebletpb.ChainConfig")
+	proto.RegisterType((*DevConfig)(nil), "nebletpb.DevConfig")
+	proto.RegisterType((*ReorgConfig)(nil), "nebletpb.ReorgConfig")
+	proto.RegisterType((*RemoteSignerConfig)(nil), "nebletpb.RemoteSignerConfig")
+	proto.RegisterType((*FailoverConfig)(nil), "nebletpb.FailoverConfig")
 	proto.RegisterType((*RPCConfig)(nil), "nebletpb.RPCConfig")
 	proto.RegisterType((*AppConfig)(nil), "nebletpb.AppConfig")
+	proto.RegisterType((*SyncConfig)(nil), "nebletpb.SyncConfig")
 	proto.RegisterType((*PprofConfig)(nil), "nebletpb.PprofConfig")
 	proto.RegisterType((*MiscConfig)(nil), "nebletpb.MiscConfig")
 	proto.RegisterType((*StatsConfig)(nil), "nebletpb.StatsConfig")
@@ -721,78 +1098,121 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 1164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0xfe, 0xc9, 0x96, 0x6d, 0x72, 0x24, 0x3b, 0xce, 0xc6, 0x71, 0xd6, 0xf1, 0xaf, 0x89, 0xa3,
-	0x22, 0x80, 0x8a, 0x14, 0x2e, 0x9a, 0xe6, 0xd2, 0x43, 0x0f, 0x81, 0x8a, 0xa2, 0x81, 0xe3, 0xc0,
-	0xa0, 0xdb, 0x33, 0x41, 0x91, 0x63, 0x6a, 0x61, 0x8a, 0x4b, 0xec, 0xae, 0x9c, 0xf8, 0xd6, 0xb7,
-	0x6a, 0x1f, 0xa1, 0x4f, 0xd0, 0xbe, 0x4b, 0x81, 0x02, 0xc5, 0xcc, 0x2e, 0x45, 0x49, 0xc8, 0x8d,
-	0xf3, 0x7d, 0xdf, 0xee, 0xac, 0xe6, 0xaf, 0x60, 0x98, 0xeb, 0xfa, 0x46, 0x95, 0xe7, 0x8d, 0xd1,
-	0x4e, 0x8b, 0xa8, 0xc6, 0x69, 0x85, 0xae, 0x99, 0x8e, 0xfe, 0xd8, 0x82, 0xdd, 0x09, 0x53, 0xe2,
-	0x5b, 0xd8, 0xab, 0xd1, 0x7d, 0xd4, 0xe6, 0x56, 0xf6, 0xce, 0x7a, 0xe3, 0xc1, 0xeb, 0x27, 0xe7,
-	0xad, 0xec, 0xfc, 0x83, 0x27, 0xbc, 0x32, 0x69, 0x75, 0xe2, 0x15, 0xec, 0xe4, 0xb3, 0x4c, 0xd5,
-	0x72, 0x8b, 0x0f, 0x3c, 0xee, 0x0e, 0x4c, 0x08, 0x0e, 0x72, 0xaf, 0x11, 0x2f, 0x61, 0xdb, 0x34,
-	0xb9, 0xdc, 0x66, 0xe9, 0xa3, 0x4e, 0x9a, 0x5c, 0x4d, 0x82, 0x90, 0x78, 0xba, 0xd3, 0xba, 0xcc,
-	0x59, 0x59, 0x6c, 0xde, 0x79, 0x4d, 0x70, 0x7b, 0x27, 0x6b, 0xc4, 0x18, 0xfa, 0x73, 0x65, 0x73,
-	0x89, 0xac, 0x3d, 0xea, 0xb4, 0x97, 0xca, 0xe6, 0x41, 0xca, 0x0a, 0xf2, 0x9e, 0x35, 0x8d, 0xbc,
-	0xd9, 0xf4, 0xfe, 0xb6, 0x69, 0x5a, 0xef, 0x59, 0xd3, 0x88, 0xaf, 0xa0, 0x5f, 0x4f, 0x0d, 0xca,
-	0x3f, 0x7b, 0x9b, 0x37, 0x7e, 0x98, 0x1a, 0x6c, 0x6f, 0x24, 0xc9, 0xe8, 0xaf, 0x1e, 0xec, 0xaf,
-	0xc5, 0x45, 0x08, 0xe8, 0x5b, 0xc4, 0x42, 0xf6, 0xce, 0xb6, 0xc7, 0x71, 0xc2, 0xdf, 0xe2, 0x18,
-	0x76, 0x2b, 0x65, 0x1d, 0x52, 0x8c, 0x08, 0x0d, 0x96, 0x78, 0x0e, 0x83, 0xc6, 0xa8, 0xbb, 0xcc,
-	0x61, 0x7a, 0x8b, 0xf7, 0x1c, 0x95, 0x38, 0x81, 0x00, 0x5d, 0xe0, 0xbd, 0xf8, 0x02, 0x20, 0x84,
-	0x39, 0x55, 0x85, 0xec, 0x9f, 0xf5, 0xc6, 0xfb, 0x49, 0x1c, 0x90, 0x77, 0x85, 0xf8, 0x12, 0xf6,
-	0xad, 0x33, 0x98, 0xcd, 0xd3, 0x4a, 0xcd, 0x95, 0xb3, 0x72, 0xe7, 0xac, 0x37, 0xde, 0x49, 0x86,
-	0x1e, 0x7c, 0xcf, 0x98, 0x78, 0x03, 0xc7, 0x06, 0x2d, 0x9a, 0x3b, 0x2c, 0xd2, 0x75, 0xf5, 0x2e,
-	0xab, 0x8f, 0x5a, 0xf6, 0x7a, 0xe5, 0xd4, 0xe8, 0xf7, 0x3e, 0x0c, 0x56, 0xf2, 0x27, 0x4e, 0x20,
-	0xe2, 0x0c, 0xd2, 0x3b, 0x7a, 0xfc, 0x8e, 0x3d, 0xb6, 0xdf, 0x15, 0x42, 0xc2, 0x5e, 0x89, 0x35,
-	0x5a, 0x65, 0xb9, 0x04, 0xe2, 0xa4, 0x35, 0x89, 0x29, 0x32, 0x97, 0x15, 0xca, 0xc8, 0x81, 0x67,
-	0x82, 0x49, 0x11, 0xb9, 0xc5, 0x7b, 0x22, 0x86, 0x4c, 0x04, 0x8b, 0x7e, 0xb0, 0x75, 0x99, 0x71,
-	0xe9, 0x5c, 0xd5, 0x28, 0x8f, 0xce, 0x7a, 0xe3, 0x28, 0x89, 0x19, 0xb9, 0x54, 0x35, 0x8a, 0xa7,
-	0x10, 0xe5, 0x5a, 0xd5, 0xd3, 0xcc, 0xa2, 0x7c, 0xcc, 0x07, 0x97, 0xb6, 0x38, 0x82, 0x1d, 0x3a,
-	0x64, 0xe4, 0x31, 0x13, 0xde, 0x10, 0xcf, 0x00, 0x9a, 0xcc, 0xda, 0x66, 0x66, 0xe8, 0xcc, 0x93,
-	0x10, 0xe1, 0x25, 0x22, 0xbe, 0x87, 0x13, 0xac, 0xb3, 0x69, 0x85, 0xa9, 0xc1, 0xb9, 0x76, 0x98,
-	0x5a, 0x55, 0xd6, 0x29, 0x07, 0xc4, 0x48, 0xc9, 0xfe, 0x8f, 0xbd, 0x20, 0x61, 0xfe, 0x5a, 0x95,
-	0xf5, 0x35, 0xb3, 0xe2, 0x6b, 0x10, 0x9f, 0x39, 0x73, 0xc2, 0x2e, 0x0e, 0xcd, 0xa6, 0xfa, 0x14,
-	0xe2, 0x32, 0xb3, 0x69, 0x63, 0x54, 0x8e, 0xf2, 0xa9, 0x7f, 0x7b, 0x99, 0xd9, 0x2b, 0xb2, 0x5b,
-	0x92, 0xf3, 0x22, 0x4f, 0x97, 0x24, 0xe7, 0x42, 0xbc, 0x82, 0x87, 0xe4, 0x20, 0x73, 0x0b, 0x83,
-	0x69, 0xae, 0x9a, 0x19, 0x1a, 0x2b, 0xff, 0xcf, 0x85, 0x74, 0xb8, 0x24, 0x26, 0x1e, 0xe7, 0x00,
-	0x2e, 0x1a, 0x34, 0x69, 0xad, 0x0b, 0x94, 0xcf, 0x42, 0x00, 0x09, 0xf9, 0xa0, 0x0b, 0x14, 0xdf,
-	0xc0, 0xa3, 0x45, 0x6d, 0x17, 0x4d, 0xa3, 0x8d, 0xc3, 0x82, 0xaa, 0xee, 0xa3, 0x36, 0x85, 0x7c,
-	0xce, 0x2e, 0xc5, 0x0a, 0x75, 0xe1, 0x19, 0x4e, 0xe1, 0x7d, 0x9d, 0x59, 0x77, 0x2f, 0xcf, 0x42,
-	0x0a, 0xbd, 0x49, 0x29, 0xcc, 0xf2, 0x1c, 0xad, 0x95, 0x2f, 0x7c, 0x0a, 0xbd, 0x35, 0xfa, 0xbb,
-	0x07, 0xf1, 0xb2, 0x9d, 0xe9, 0x3d, 0xa6, 0xc9, 0xd3, 0x50, 0xfe, 0xbe, 0x29, 0x62, 0xd3, 0xe4,
-	0xef, 0x97, 0x1d, 0x30, 0x73, 0xae, 0x49, 0xd7, 0xda, 0x03, 0x08, 0xda, 0x10, 0xcc, 0x75, 0xb1,
-	0xa8, 0x50, 0x6e, 0x77, 0x82, 0x4b, 0x46, 0x28, 0x3a, 0xb9, 0xae, 0x6b, 0xcc, 0x9d, 0xd2, 0x75,
-	0x5b, 0xd9, 0x7d, 0xae, 0xec, 0xc3, 0x8e, 0x08, 0xbd, 0xd0, 0xb9, 0x5b, 0x69, 0x97, 0xe0, 0x8e,
-	0x05, 0xa7, 0x10, 0xb3, 0x20, 0xd7, 0x86, 0xfa, 0x83, 0x9c, 0x45, 0x04, 0x4c, 0xb4, 0xb1, 0xa3,
-	0x7f, 0x7b, 0x10, 0x2f, 0x47, 0x05, 0x49, 0x2b, 0x5d, 0xa6, 0x15, 0xde, 0x61, 0xc5, 0x2d, 0x11,
-	0x27, 0x51, 0xa5, 0xcb, 0xf7, 0x64, 0x53, 0xbb, 0x10, 0x79, 0xa3, 0x2a, 0x6c, 0x9b, 0xa2, 0xd2,
-	0xe5, 0x4f, 0xaa, 0x42, 0xf1, 0x04, 0xe8, 0x33, 0xcd, 0x4a, 0xe4, 0x86, 0xdf, 0x4f, 0x76, 0x2b,
-	0x5d, 0xbe, 0x2d, 0x51, 0x9c, 0xc3, 0xa3, 0x50, 0x8a, 0xb9, 0xc9, 0xec, 0x2c, 0x35, 0x48, 0xa9,
-	0xe0, 0xdf, 0x12, 0x25, 0x0f, 0x3d, 0x35, 0x21, 0x26, 0x61, 0x42, 0x8c, 0xe1, 0x70, 0x55, 0x98,
-	0x2e, 0x4c, 0xc5, 0xbf, 0x28, 0x4e, 0x0e, 0xf2, 0x4e, 0xf6, 0xab, 0xa9, 0x68, 0x9c, 0x36, 0x8d,
-	0xd1, 0x37, 0xdc, 0xf1, 0x6b, 0xe3, 0xf4, 0x8a, 0xe0, 0x76, 0x9c, 0xb2, 0x86, 0x32, 0x7e, 0x87,
-	0xc6, 0x2a, 0x5d, 0xf3, 0xf4, 0x8d, 0x93, 0xd6, 0x1c, 0xd5, 0x30, 0x58, 0xd1, 0x6f, 0xe6, 0xce,
-	0x87, 0x60, 0x35, 0x77, 0xcf, 0x00, 0xf2, 0x66, 0x41, 0x27, 0xba, 0x30, 0xac, 0x20, 0xc4, 0xcf,
-	0x71, 0xde, 0xf2, 0x61, 0xfa, 0x75, 0xc8, 0xe8, 0x02, 0xa0, 0x1b, 0xe1, 0xe2, 0x07, 0x38, 0x2d,
-	0xf0, 0x26, 0x5b, 0x54, 0x8e, 0xca, 0xd6, 0x3a, 0x6d, 0x90, 0xe3, 0x4b, 0x2d, 0x81, 0x26, 0xb8,
-	0x97, 0x41, 0x72, 0x11, 0x14, 0x14, 0xf1, 0x09, 0xf1, 0xa3, 0xdf, 0xb6, 0x60, 0xb0, 0xb2, 0x3c,
-	0xc4, 0x4b, 0x38, 0x08, 0xd1, 0x9e, 0xa3, 0x33, 0x2a, 0xb7, 0x7c, 0x43, 0x94, 0xec, 0x7b, 0xf4,
-	0xd2, 0x83, 0xe2, 0x0a, 0x0e, 0x7d, 0x78, 0x55, 0x5d, 0xb6, 0x45, 0x48, 0x55, 0x7a, 0xf0, 0xfa,
-	0xe5, 0x67, 0x97, 0xd2, 0x79, 0xd2, 0xaa, 0x7d, 0x7d, 0x26, 0x0f, 0xcc, 0x3a, 0x20, 0xde, 0x40,
-	0xa4, 0xea, 0x9b, 0x6a, 0xf1, 0xa9, 0x98, 0xf2, 0x54, 0x1c, 0xbc, 0x96, 0xdd, 0x4d, 0xef, 0x02,
-	0x13, 0x52, 0xb2, 0x54, 0x8a, 0x17, 0x30, 0x0c, 0xef, 0x4c, 0x5d, 0x56, 0x5a, 0x39, 0xe4, 0xda,
-	0x1c, 0x04, 0xec, 0x97, 0xac, 0xb4, 0xa3, 0xe7, 0xf0, 0x60, 0xc3, 0xb9, 0x18, 0x42, 0xd4, 0xde,
-	0x78, 0xf8, 0xbf, 0xd1, 0x27, 0x38, 0x58, 0xbf, 0x9f, 0x96, 0xd5, 0x4c, 0x5b, 0x17, 0x82, 0xc7,
-	0xdf, 0x84, 0x71, 0xdd, 0x6d, 0x71, 0x71, 0xf2, 0xb7, 0x38, 0x80, 0xad, 0x62, 0x1a, 0x32, 0xb4,
-	0x55, 0x4c, 0x49, 0xb3, 0xb0, 0x68, 0xb8, 0x36, 0xe3, 0x84, 0xbf, 0x69, 0x36, 0xd3, 0x5c, 0xe5,
-	0x79, 0xe2, 0xcb, 0x70, 0x69, 0x8f, 0xfe, 0xe9, 0x01, 0x74, 0xbb, 0x93, 0xba, 0xc3, 0x68, 0xed,
-	0x52, 0x9a, 0xff, 0xde, 0xf5, 0x1e, 0xd9, 0x3f, 0x2a, 0xd3, 0x76, 0x07, 0x31, 0xbe, 0x60, 0xa8,
-	0x3b, 0x88, 0x38, 0x81, 0x88, 0x96, 0x07, 0x33, 0xdb, 0xdd, 0x32, 0x21, 0xea, 0x14, 0x62, 0x5a,
-	0xc6, 0x69, 0x93, 0xb9, 0x59, 0x78, 0x52, 0x44, 0xc0, 0x55, 0xe6, 0x66, 0xb4, 0x23, 0xb3, 0x62,
-	0xae, 0xea, 0x34, 0x2b, 0x0a, 0x43, 0xd3, 0xca, 0xbf, 0x6d, 0xc8, 0xe0, 0x5b, 0x8f, 0x51, 0x74,
-	0xfd, 0xda, 0x99, 0xa1, 0x2a, 0x67, 0x8e, 0xfb, 0xa4, 0x9f, 0x0c, 0x18, 0xfb, 0x99, 0x21, 0x1a,
-	0x64, 0xaa, 0x1b, 0x64, 0x7b, 0x7c, 0x49, 0xac, 0x96, 0x83, 0xec, 0x04, 0x22, 0xa2, 0x39, 0x72,
-	0x91, 0xdf, 0x8f, 0xaa, 0xc9, 0xaf, 0xb4, 0x71, 0xd3, 0x5d, 0xfe, 0xbf, 0xf5, 0xdd, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x70, 0xb6, 0x8d, 0x0b, 0x7f, 0x09, 0x00, 0x00,
+	// 1842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x57, 0xcd, 0x6e, 0x1b, 0xc9,
+	0x11, 0x0e, 0xf5, 0x4b, 0x16, 0x49, 0x59, 0x6a, 0xdb, 0x72, 0xcb, 0x76, 0x6c, 0x2d, 0x37, 0x76,
+	0xb4, 0xbb, 0x81, 0x92, 0x78, 0xf7, 0x90, 0x04, 0x48, 0x00, 0x85, 0x8a, 0xb1, 0x86, 0x2c, 0x43,
+	0x18, 0x6d, 0xb0, 0xc7, 0x41, 0x73, 0xa6, 0x44, 0x36, 0x34, 0xec, 0x99, 0x74, 0x37, 0x25, 0xd1,
+	0xa7, 0x5c, 0xf3, 0x00, 0xb9, 0xe6, 0x25, 0x02, 0xe4, 0x19, 0xf2, 0x06, 0xb9, 0xe7, 0x31, 0x02,
+	0x04, 0x08, 0xaa, 0xba, 0x87, 0x43, 0x31, 0x0e, 0x72, 0x9b, 0xfe, 0xea, 0xeb, 0xbf, 0xea, 0xaa,
+	0xaf, 0x6a, 0xa0, 0x97, 0x95, 0xe6, 0x4a, 0x8f, 0x8f, 0x2b, 0x5b, 0xfa, 0x52, 0xb4, 0x0d, 0x8e,
+	0x0a, 0xf4, 0xd5, 0x68, 0xf0, 0xcf, 0x35, 0xd8, 0x1a, 0xb2, 0x49, 0xfc, 0x1c, 0xb6, 0x0d, 0xfa,
+	0xdb, 0xd2, 0x5e, 0xcb, 0xd6, 0x61, 0xeb, 0xa8, 0xfb, 0xe6, 0xc9, 0x71, 0x4d, 0x3b, 0xfe, 0x10,
+	0x0c, 0x81, 0x99, 0xd4, 0x3c, 0xf1, 0x15, 0x6c, 0x66, 0x13, 0xa5, 0x8d, 0x5c, 0xe3, 0x09, 0x8f,
+	0x9b, 0x09, 0x43, 0x82, 0x23, 0x3d, 0x70, 0xc4, 0x2b, 0x58, 0xb7, 0x55, 0x26, 0xd7, 0x99, 0xfa,
+	0xb0, 0xa1, 0x26, 0x17, 0xc3, 0x48, 0x24, 0xbb, 0x38, 0x82, 0x0d, 0x37, 0x37, 0x99, 0xdc, 0x60,
+	0xde, 0xa3, 0x86, 0x77, 0x39, 0x37, 0x59, 0x24, 0x32, 0x83, 0x76, 0x77, 0x5e, 0x79, 0x27, 0xf3,
+	0xd5, 0xdd, 0x2f, 0x09, 0xae, 0x77, 0x67, 0x0e, 0x2d, 0x3b, 0xd5, 0x2e, 0x93, 0xb8, 0xba, 0xec,
+	0xb9, 0x76, 0x8b, 0x65, 0x89, 0x41, 0xe7, 0x54, 0x55, 0x25, 0xaf, 0x56, 0xcf, 0x79, 0x52, 0x55,
+	0xf5, 0x39, 0x55, 0x55, 0x89, 0x2f, 0x60, 0xc3, 0x8c, 0x2c, 0xca, 0xbf, 0xb7, 0x56, 0x57, 0xfc,
+	0x30, 0xb2, 0x58, 0xaf, 0x48, 0x94, 0xc1, 0x9f, 0x37, 0xa0, 0x7f, 0xcf, 0x83, 0x42, 0xc0, 0x86,
+	0x43, 0xcc, 0x65, 0xeb, 0x70, 0xfd, 0xa8, 0x93, 0xf0, 0xb7, 0xd8, 0x87, 0xad, 0x42, 0x3b, 0x8f,
+	0xe4, 0x4d, 0x42, 0xe3, 0x48, 0xbc, 0x84, 0x6e, 0x65, 0xf5, 0x8d, 0xf2, 0x98, 0x5e, 0xe3, 0x9c,
+	0xfd, 0xd7, 0x49, 0x20, 0x42, 0x67, 0x38, 0x17, 0x3f, 0x04, 0x88, 0x0f, 0x92, 0xea, 0x9c, 0xfd,
+	0xd6, 0x4f, 0x3a, 0x11, 0x79, 0x97, 0x8b, 0xcf, 0xa1, 0xef, 0xbc, 0x45, 0x35, 0x4d, 0x0b, 0x3d,
+	0xd5, 0xde, 0xc9, 0xcd, 0xc3, 0xd6, 0xd1, 0x66, 0xd2, 0x0b, 0xe0, 0x7b, 0xc6, 0xc4, 0x37, 0xb0,
+	0x6f, 0xd1, 0xa1, 0xbd, 0xc1, 0x3c, 0xbd, 0xcf, 0xde, 0x62, 0xf6, 0xa3, 0xda, 0x7a, 0xb9, 0x3c,
+	0xeb, 0x0c, 0x7a, 0x15, 0xa2, 0x4d, 0xaf, 0x74, 0xe1, 0xd1, 0x3a, 0xb9, 0x7d, 0xb8, 0x7e, 0xd4,
+	0x7d, 0x73, 0xf4, 0x3f, 0xe2, 0xe6, 0xf8, 0x02, 0xd1, 0xbe, 0x0d, 0xd4, 0xdf, 0x19, 0x6f, 0xe7,
+	0x49, 0xb7, 0x6a, 0x10, 0xb1, 0x0b, 0xeb, 0x46, 0x79, 0xd9, 0xe6, 0xfb, 0xd1, 0xa7, 0x78, 0x05,
+	0x3b, 0x78, 0xe7, 0xd1, 0x1a, 0x55, 0xa4, 0x2a, 0xcf, 0xad, 0x93, 0x1d, 0xf6, 0x4c, 0xbf, 0x46,
+	0x4f, 0x08, 0x24, 0x07, 0x65, 0xaa, 0xf2, 0x33, 0x8b, 0x69, 0xae, 0xad, 0x84, 0xe0, 0xa0, 0x08,
+	0x9d, 0x6a, 0x2b, 0xbe, 0x84, 0xbd, 0x9a, 0x70, 0xa5, 0x0b, 0x4c, 0x9d, 0xfe, 0x88, 0xb2, 0xcb,
+	0x7e, 0x7a, 0x10, 0x0d, 0x6f, 0x75, 0x81, 0x97, 0xfa, 0x23, 0x2e, 0x73, 0xa7, 0xea, 0x8e, 0xf9,
+	0x4e, 0xf6, 0xee, 0x71, 0xcf, 0xd5, 0x1d, 0xd1, 0xdd, 0xd3, 0xdf, 0xc0, 0xee, 0xea, 0x95, 0xe8,
+	0x16, 0xf4, 0x4a, 0xad, 0x70, 0x8b, 0x6b, 0x9c, 0x8b, 0x47, 0xb0, 0x79, 0xa3, 0x8a, 0x19, 0x72,
+	0x92, 0x74, 0x92, 0x30, 0xf8, 0xd5, 0xda, 0x2f, 0x5a, 0x83, 0xbf, 0xb4, 0xa1, 0xbb, 0x94, 0x28,
+	0xe2, 0x00, 0xda, 0x9c, 0x2a, 0xf4, 0x8c, 0x2d, 0xde, 0x72, 0x9b, 0xc7, 0xef, 0x72, 0x21, 0x61,
+	0x7b, 0x8c, 0x06, 0x9d, 0x76, 0x71, 0x99, 0x7a, 0x48, 0x96, 0x5c, 0x79, 0x45, 0x37, 0xef, 0x06,
+	0x4b, 0x1c, 0x52, 0x40, 0x5d, 0xe3, 0x9c, 0x0c, 0x3d, 0x36, 0xc4, 0x11, 0xc5, 0x8b, 0xf3, 0xca,
+	0xfa, 0x74, 0xaa, 0x0d, 0xca, 0x47, 0x87, 0xad, 0xa3, 0x76, 0xd2, 0x61, 0xe4, 0x5c, 0x1b, 0x14,
+	0x4f, 0xa1, 0x9d, 0x95, 0xda, 0x8c, 0x94, 0x43, 0xf9, 0x98, 0x27, 0x2e, 0xc6, 0x74, 0x17, 0x9a,
+	0x64, 0xe5, 0x7e, 0xb8, 0x0b, 0x0f, 0xc4, 0x0b, 0x80, 0x4a, 0x39, 0x57, 0x4d, 0x2c, 0xcd, 0x79,
+	0x12, 0x03, 0x74, 0x81, 0x88, 0x5f, 0xc2, 0x01, 0x1a, 0x35, 0x2a, 0x30, 0xb5, 0x38, 0x2d, 0x3d,
+	0x3d, 0xc0, 0xd8, 0xa4, 0x1c, 0x4f, 0x56, 0x4a, 0xde, 0x7f, 0x3f, 0x10, 0x12, 0xb6, 0x5f, 0xea,
+	0xb1, 0xb9, 0x64, 0xab, 0xf8, 0x09, 0x88, 0x4f, 0xcc, 0x39, 0xe0, 0x2d, 0x76, 0xed, 0x2a, 0xfb,
+	0x19, 0x74, 0xc6, 0xca, 0xa5, 0x95, 0xd5, 0x19, 0xca, 0xa7, 0xe1, 0xec, 0x63, 0xe5, 0x2e, 0x68,
+	0x5c, 0x1b, 0x39, 0xac, 0xe5, 0xb3, 0x85, 0x91, 0x43, 0x59, 0x7c, 0x05, 0x7b, 0xb4, 0x81, 0xe2,
+	0x87, 0xcf, 0x74, 0x35, 0xa1, 0x70, 0x7e, 0xce, 0xd1, 0xb6, 0xbb, 0x30, 0x0c, 0x03, 0xce, 0x0e,
+	0x9c, 0x55, 0x68, 0x53, 0x53, 0xe6, 0x28, 0x5f, 0x44, 0x07, 0x12, 0xf2, 0xa1, 0xcc, 0x51, 0xfc,
+	0x14, 0x1e, 0xce, 0x8c, 0x9b, 0x55, 0x55, 0x69, 0x3d, 0xe6, 0x94, 0xb4, 0xb7, 0xa5, 0xcd, 0xe5,
+	0x4b, 0xde, 0x52, 0x2c, 0x99, 0xce, 0x82, 0x85, 0x9f, 0x70, 0x6e, 0x94, 0xf3, 0x73, 0x79, 0x18,
+	0x9f, 0x30, 0x0c, 0xe9, 0x09, 0x55, 0x96, 0xa1, 0x73, 0xf2, 0xb3, 0xf0, 0x84, 0x61, 0x24, 0x8e,
+	0xe1, 0x61, 0x56, 0x4e, 0x2b, 0x95, 0xf9, 0x74, 0x54, 0x94, 0xd9, 0x75, 0x6a, 0xb1, 0x50, 0x73,
+	0x39, 0xe0, 0xa3, 0xec, 0x45, 0xd3, 0x6f, 0xc9, 0x92, 0x90, 0x21, 0x3e, 0x39, 0x79, 0x91, 0xa4,
+	0xf5, 0xf3, 0xc5, 0x93, 0x7b, 0x24, 0x55, 0x15, 0xdf, 0x42, 0x37, 0x9b, 0x60, 0x76, 0x5d, 0x95,
+	0xda, 0x78, 0x27, 0x7f, 0xc4, 0x69, 0xfc, 0xfa, 0x93, 0x6a, 0x7e, 0x3c, 0x6c, 0x88, 0x31, 0x89,
+	0x97, 0xa6, 0x8a, 0xcf, 0xa0, 0x57, 0xe8, 0xf1, 0xc4, 0xa7, 0x59, 0xa1, 0xd1, 0x78, 0xf9, 0x8a,
+	0xb7, 0xea, 0x32, 0x36, 0x64, 0x48, 0x7c, 0x03, 0xed, 0x2b, 0xa5, 0x8b, 0x92, 0x1e, 0xf2, 0x35,
+	0x6b, 0xa7, 0x6c, 0x76, 0x7a, 0x1b, 0x2d, 0x51, 0x3f, 0x17, 0x4c, 0x71, 0x02, 0xfd, 0xa5, 0x40,
+	0x40, 0x2b, 0x7f, 0xcc, 0x53, 0x9f, 0x37, 0x53, 0x9b, 0xd8, 0x59, 0x4c, 0xef, 0xd9, 0x25, 0x8c,
+	0xea, 0x85, 0xc5, 0xd2, 0x8e, 0xe5, 0xd1, 0x6a, 0xbd, 0x48, 0x08, 0xae, 0xeb, 0x05, 0x73, 0xc4,
+	0x73, 0xe8, 0x64, 0xa5, 0x71, 0x68, 0xdc, 0xcc, 0xc9, 0x2f, 0xd8, 0xf9, 0x0d, 0x40, 0x35, 0x22,
+	0xc7, 0x1b, 0xf9, 0xe5, 0x6a, 0x8d, 0x38, 0xc5, 0x9b, 0xba, 0x46, 0xe4, 0x78, 0x43, 0x02, 0xb1,
+	0xea, 0xae, 0x65, 0x81, 0xd8, 0xf8, 0x7f, 0x02, 0xf1, 0x33, 0xe8, 0x2c, 0x56, 0x64, 0x1d, 0x47,
+	0x55, 0xa4, 0xda, 0x78, 0xb4, 0x37, 0xaa, 0x88, 0x12, 0xd1, 0x23, 0xf0, 0x5d, 0xc4, 0x06, 0x97,
+	0xd0, 0x5d, 0xba, 0x0c, 0x49, 0xa3, 0x2a, 0xd0, 0xfa, 0x34, 0xc7, 0xca, 0x4f, 0xe2, 0x0c, 0x60,
+	0xe8, 0x94, 0x10, 0x5a, 0x34, 0x10, 0x6e, 0x71, 0x34, 0x29, 0xcb, 0xeb, 0x78, 0x86, 0x1e, 0x83,
+	0xdf, 0x07, 0x6c, 0xf0, 0xb7, 0x16, 0x88, 0xff, 0xf6, 0x2e, 0x09, 0x05, 0xb7, 0x13, 0x59, 0x59,
+	0x44, 0xbd, 0x5b, 0x8c, 0x49, 0xca, 0x7c, 0xe1, 0xd2, 0x0c, 0xad, 0xaf, 0x05, 0xcb, 0x17, 0x6e,
+	0x88, 0xd6, 0x8b, 0x27, 0x40, 0x9f, 0x4b, 0xb5, 0x6c, 0xcb, 0x17, 0x8e, 0xea, 0xd8, 0x63, 0xd8,
+	0xe2, 0x39, 0x8a, 0x6b, 0x58, 0x27, 0xd9, 0xa4, 0x19, 0x8a, 0xee, 0x10, 0xd2, 0x3e, 0x35, 0x6a,
+	0x8a, 0x5c, 0xbd, 0x3a, 0x09, 0x04, 0xe8, 0x83, 0x9a, 0x22, 0xa5, 0x8f, 0xd7, 0x53, 0x2c, 0x67,
+	0x9e, 0x8b, 0x55, 0x3f, 0xa9, 0x87, 0x83, 0x3f, 0xb5, 0x60, 0xe7, 0x7e, 0x44, 0x91, 0xb3, 0x0b,
+	0x24, 0x99, 0x0a, 0x27, 0x0e, 0x03, 0xca, 0x0f, 0xfe, 0x60, 0xbd, 0x8f, 0x07, 0xee, 0x30, 0x42,
+	0x4a, 0x4f, 0x85, 0x28, 0x98, 0xf3, 0x99, 0x55, 0x5e, 0x97, 0x86, 0x4f, 0xde, 0x4f, 0xfa, 0x8c,
+	0x9e, 0x46, 0x90, 0x14, 0x86, 0x14, 0x21, 0x9c, 0x33, 0xdc, 0xa1, 0x4d, 0x00, 0x9d, 0x72, 0xf0,
+	0x8f, 0x16, 0x74, 0x16, 0xad, 0x0e, 0x6d, 0x68, 0xab, 0x2c, 0x8d, 0x05, 0x3f, 0xb4, 0x01, 0x1d,
+	0x5b, 0x65, 0xef, 0x17, 0x35, 0x7f, 0xe2, 0x7d, 0x95, 0xde, 0x6b, 0x08, 0x80, 0xa0, 0x15, 0xc2,
+	0xb4, 0xcc, 0x67, 0x05, 0xca, 0xf5, 0x86, 0x70, 0xce, 0x08, 0x09, 0x5a, 0x56, 0x1a, 0x83, 0x19,
+	0x9d, 0xac, 0xae, 0xe5, 0x1b, 0x5c, 0xcb, 0x77, 0x1b, 0x43, 0xac, 0xe3, 0xcd, 0x76, 0x4b, 0x0d,
+	0x42, 0xdc, 0x8e, 0x09, 0xcf, 0xa0, 0xc3, 0x84, 0xac, 0xb4, 0xd4, 0x11, 0xd0, 0x66, 0x6d, 0x02,
+	0x86, 0xa5, 0x75, 0x83, 0x7f, 0xb7, 0xa0, 0xb3, 0x68, 0x8e, 0x88, 0x5a, 0x94, 0xe3, 0xb4, 0xc0,
+	0x1b, 0x5c, 0x84, 0x45, 0x51, 0x8e, 0xdf, 0xd3, 0x98, 0xc2, 0x82, 0x8c, 0x4b, 0x5e, 0xde, 0x2e,
+	0xca, 0x31, 0xfb, 0xf8, 0x09, 0xd0, 0x67, 0xaa, 0xc6, 0x18, 0x9d, 0xbb, 0x55, 0x94, 0xe3, 0x93,
+	0x31, 0x92, 0xd6, 0xc5, 0xea, 0x91, 0x59, 0xe5, 0x26, 0xa9, 0x45, 0x52, 0x4f, 0xbe, 0x4b, 0x3b,
+	0xd9, 0x0b, 0xa6, 0x21, 0x59, 0x12, 0x36, 0x88, 0x23, 0xd8, 0x5d, 0x26, 0xa6, 0x33, 0x5b, 0xc4,
+	0xa0, 0xd9, 0xc9, 0x1a, 0xda, 0xef, 0x6d, 0x41, 0x82, 0x50, 0x55, 0xb6, 0xbc, 0x92, 0x5b, 0xab,
+	0x82, 0x70, 0x41, 0x70, 0x2d, 0x08, 0xcc, 0xa1, 0x28, 0xbb, 0x41, 0xeb, 0xe8, 0xf1, 0xf3, 0x70,
+	0xf2, 0x38, 0x1c, 0xfc, 0x75, 0x0d, 0xa0, 0x69, 0x4e, 0xe9, 0x69, 0xb3, 0xc9, 0xcc, 0x5c, 0x87,
+	0x36, 0x23, 0xa4, 0x5c, 0x87, 0x11, 0x6e, 0x30, 0xbe, 0x86, 0x7d, 0x6a, 0x2c, 0x18, 0x70, 0x29,
+	0x55, 0x11, 0x8b, 0x7f, 0x98, 0xa1, 0x0b, 0x79, 0xd2, 0x4f, 0x1e, 0x4e, 0xd5, 0xdd, 0x90, 0x8d,
+	0x17, 0x68, 0x93, 0x60, 0xa2, 0x32, 0x18, 0xd6, 0xa4, 0xda, 0x9e, 0xd6, 0xd1, 0x1e, 0xfc, 0xb4,
+	0xcb, 0x96, 0x53, 0xe5, 0xd5, 0x77, 0x01, 0x67, 0xa5, 0x98, 0x9b, 0xac, 0x51, 0x8a, 0x8d, 0xa8,
+	0x14, 0x73, 0x93, 0xd5, 0x4a, 0x21, 0x5e, 0xc3, 0x83, 0xa9, 0x36, 0x29, 0xf7, 0x6f, 0xb7, 0xda,
+	0xe4, 0xe5, 0x2d, 0x7b, 0xa9, 0x9f, 0xf4, 0xa7, 0xda, 0x50, 0x5b, 0xf3, 0x3d, 0x83, 0xe4, 0x7e,
+	0x6d, 0xb4, 0xd7, 0xaa, 0xb8, 0xc7, 0x0d, 0x99, 0xb6, 0x17, 0x4d, 0x4b, 0x7c, 0x5a, 0x57, 0xdd,
+	0xdd, 0xe3, 0x6e, 0xc7, 0x75, 0xd5, 0x5d, 0xc3, 0x1b, 0x18, 0xe8, 0x2e, 0x79, 0x79, 0x35, 0xe2,
+	0x43, 0xe0, 0x2c, 0x47, 0xfc, 0x0b, 0x80, 0xac, 0x9a, 0xd1, 0x8c, 0x26, 0x78, 0x96, 0x10, 0xb2,
+	0x4f, 0x71, 0x5a, 0xdb, 0x63, 0x97, 0xdc, 0x20, 0x83, 0x33, 0x80, 0xa6, 0xd5, 0x17, 0xbf, 0x86,
+	0x67, 0x39, 0x5e, 0xa9, 0x59, 0xe1, 0x49, 0x88, 0x9c, 0x2f, 0xeb, 0xde, 0x30, 0xd3, 0x15, 0xda,
+	0xb8, 0xbd, 0x8c, 0x94, 0xb3, 0xc8, 0xa0, 0x38, 0x1d, 0x92, 0x7d, 0xf0, 0xc7, 0x35, 0xe8, 0x2e,
+	0xfd, 0x64, 0x70, 0xa7, 0x1a, 0x62, 0x74, 0x8a, 0xde, 0xea, 0xcc, 0xf1, 0x0a, 0xed, 0xa4, 0x1f,
+	0xd0, 0xf3, 0x00, 0x8a, 0x0b, 0xd8, 0x0d, 0x41, 0xa9, 0xcd, 0xb8, 0x4e, 0x5d, 0xca, 0xed, 0x9d,
+	0x37, 0xaf, 0x3e, 0xf9, 0xf3, 0x72, 0x9c, 0xd4, 0xec, 0x90, 0xd5, 0xc9, 0x03, 0x7b, 0x1f, 0xa0,
+	0x62, 0xaa, 0xcd, 0x55, 0x31, 0xbb, 0xcb, 0x47, 0xb2, 0xbb, 0x5a, 0x4c, 0xdf, 0x45, 0x4b, 0x5d,
+	0x4c, 0x6b, 0x26, 0x55, 0xe9, 0x78, 0xce, 0xd4, 0xab, 0x31, 0xf5, 0xb7, 0x94, 0xd1, 0xdd, 0x88,
+	0x7d, 0xa7, 0xc6, 0x6e, 0xf0, 0x12, 0x1e, 0xac, 0x6c, 0x2e, 0x7a, 0xd0, 0xae, 0x57, 0xdc, 0xfd,
+	0xc1, 0xe0, 0x0e, 0x76, 0xee, 0xaf, 0x4f, 0x3f, 0x35, 0x93, 0xd2, 0xf9, 0xe8, 0x3c, 0xfe, 0x26,
+	0x8c, 0xb3, 0x35, 0xc4, 0x36, 0x7f, 0x8b, 0x1d, 0x58, 0xcb, 0x47, 0xf1, 0x85, 0xd6, 0xf2, 0x11,
+	0x71, 0x66, 0x0e, 0x6d, 0x54, 0x4c, 0xfe, 0xe6, 0xda, 0xa2, 0x9c, 0xe3, 0xc6, 0x69, 0x33, 0xd6,
+	0x96, 0x38, 0x1e, 0xfc, 0xab, 0x05, 0xd0, 0xfc, 0x63, 0x91, 0xa6, 0xd8, 0xb2, 0xf4, 0xdc, 0xfb,
+	0x87, 0xad, 0xb7, 0x69, 0x4c, 0x8d, 0x7f, 0xd4, 0x14, 0xb2, 0x84, 0x80, 0x21, 0x4d, 0x21, 0xc3,
+	0x01, 0xb4, 0x39, 0x93, 0xc8, 0xb2, 0xde, 0x74, 0xcd, 0x64, 0x22, 0x11, 0x1f, 0x59, 0x4c, 0x2b,
+	0xe5, 0x27, 0x0b, 0x11, 0x1f, 0x59, 0xbc, 0x50, 0xb1, 0x5c, 0xe6, 0x94, 0x36, 0xf4, 0x3b, 0x42,
+	0x6d, 0xd9, 0x66, 0x2c, 0x97, 0x04, 0x9e, 0x04, 0x8c, 0xbc, 0x1b, 0xfa, 0xeb, 0x09, 0x52, 0xdb,
+	0xc3, 0xa9, 0xb2, 0x91, 0x74, 0x19, 0xfb, 0x96, 0x21, 0xd2, 0x08, 0xdd, 0xc8, 0xff, 0x76, 0xa8,
+	0x37, 0x7a, 0x21, 0xff, 0x07, 0xd0, 0x26, 0x33, 0x7b, 0xae, 0x1d, 0x4a, 0x9a, 0xae, 0xb2, 0x8b,
+	0xd2, 0xfa, 0xd1, 0x16, 0x97, 0xd8, 0xaf, 0xff, 0x33, 0x00, 0x2a, 0x42, 0x0e, 0xe1, 0xd1, 0x0f,
+	0x00, 0x00,
 }
@@ -0,0 +1,59 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// ReorgWebhookTimeout timeout of posting an alerted reorg.
+const ReorgWebhookTimeout = 5 * time.Second
+
+// newReorgWebhook return a hook posting the alerted reorgs to the url in json.
+func newReorgWebhook(url string) core.ReorgAlertHook {
+	client := &http.Client{Timeout: ReorgWebhookTimeout}
+	return func(reorg *core.Reorg) {
+		data, err := json.Marshal(reorg)
+		if err != nil {
+			return
+		}
+		resp, err := client.Post(url, "application/json", bytes.NewReader(data))
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"url": url,
+				"err": err,
+			}).Warn("Failed to post reorg alert.")
+			return
+		}
+		resp.Body.Close()
+		if resp.StatusCode/100 != 2 {
+			logging.VLog().WithFields(logrus.Fields{
+				"url":    url,
+				"status": resp.Status,
+			}).Warn("Reorg alert is rejected by webhook.")
+		}
+	}
+}
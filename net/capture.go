@@ -0,0 +1,329 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Capture parameters
+const (
+	CaptureFilePrefix       = "capture-"
+	CaptureFileSuffix       = ".neb"
+	DefaultCaptureFileSize  = 64
+	DefaultCaptureMaxFiles  = 8
+	MaxCapturedMessageSize  = 64 * 1024 * 1024
+	captureRecordHeaderSize = 4
+)
+
+// CaptureRotateRetryInterval is the interval to retry a failed rotation,
+// the records go on to the current file meanwhile.
+var CaptureRotateRetryInterval = 10 * time.Second
+
+// Capture Errors
+var (
+	ErrInvalidCaptureRecord = errors.New("invalid capture record")
+	ErrNoCaptureFile        = errors.New("no capture file found")
+)
+
+// Recorder writes the messages passing the dispatcher to rotating capture files.
+type Recorder struct {
+	mu       sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+	index    int
+
+	rotateRetryAt time.Time
+}
+
+// NewRecorder return a recorder writing to the given dir,
+// a new file is started once the current one exceeds maxSize bytes
+// and only the latest maxFiles files are kept.
+func NewRecorder(dir string, maxSize int64, maxFiles int) (*Recorder, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	files, err := captureFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{
+		dir:      dir,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+	if len(files) > 0 {
+		r.index = captureFileIndex(files[len(files)-1]) + 1
+	}
+	if err := r.rotate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record write a message to the capture file.
+func (r *Recorder) Record(outbound bool, peer, name string, data []byte) {
+	msg := &netpb.CapturedMessage{
+		Timestamp: time.Now().UnixNano(),
+		Outbound:  outbound,
+		Peer:      peer,
+		Name:      name,
+		Data:      data,
+	}
+	bytes, err := proto.Marshal(msg)
+	if err != nil {
+		return
+	}
+	record := make([]byte, captureRecordHeaderSize+len(bytes))
+	binary.BigEndian.PutUint32(record, uint32(len(bytes)))
+	copy(record[captureRecordHeaderSize:], bytes)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return
+	}
+	if r.size > 0 && r.size+int64(len(record)) > r.maxSize && !time.Now().Before(r.rotateRetryAt) {
+		if err := r.rotate(); err != nil {
+			r.rotateRetryAt = time.Now().Add(CaptureRotateRetryInterval)
+			logging.VLog().WithFields(logrus.Fields{
+				"dir":   r.dir,
+				"file":  r.file.Name(),
+				"retry": CaptureRotateRetryInterval,
+				"err":   err,
+			}).Error("Failed to rotate capture file, keep writing the current one.")
+		}
+	}
+	n, err := r.file.Write(record)
+	r.size += int64(n)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"file": r.file.Name(),
+			"err":  err,
+		}).Error("Failed to write capture file.")
+	}
+}
+
+// Close close the current capture file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// rotate start a new capture file, the current one is kept if the new one can not be opened.
+func (r *Recorder) rotate() error {
+	name := filepath.Join(r.dir, fmt.Sprintf("%s%08d%s", CaptureFilePrefix, r.index, CaptureFileSuffix))
+	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if r.file != nil {
+		r.file.Close()
+	}
+	r.file = file
+	r.size = 0
+	r.index++
+
+	files, err := captureFiles(r.dir)
+	if err != nil {
+		return err
+	}
+	for len(files) > r.maxFiles {
+		os.Remove(files[0])
+		files = files[1:]
+	}
+	return nil
+}
+
+// captureFiles return the capture files in the dir, oldest first.
+func captureFiles(dir string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0)
+	for _, info := range infos {
+		if info.IsDir() || captureFileIndex(info.Name()) < 0 {
+			continue
+		}
+		files = append(files, filepath.Join(dir, info.Name()))
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return captureFileIndex(files[i]) < captureFileIndex(files[j])
+	})
+	return files, nil
+}
+
+func captureFileIndex(path string) int {
+	name := filepath.Base(path)
+	if !strings.HasPrefix(name, CaptureFilePrefix) || !strings.HasSuffix(name, CaptureFileSuffix) {
+		return -1
+	}
+	index, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, CaptureFilePrefix), CaptureFileSuffix))
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// CaptureReader reads the captured messages in order.
+type CaptureReader struct {
+	files []string
+	file  *os.File
+}
+
+// NewCaptureReader return a reader of a capture file, or of all the capture files in a dir.
+func NewCaptureReader(path string) (*CaptureReader, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{path}
+	if info.IsDir() {
+		if files, err = captureFiles(path); err != nil {
+			return nil, err
+		}
+	}
+	if len(files) == 0 {
+		return nil, ErrNoCaptureFile
+	}
+	return &CaptureReader{files: files}, nil
+}
+
+// Next return the next captured message, io.EOF if there is no more.
+func (cr *CaptureReader) Next() (*netpb.CapturedMessage, error) {
+	for {
+		if cr.file == nil {
+			if len(cr.files) == 0 {
+				return nil, io.EOF
+			}
+			file, err := os.Open(cr.files[0])
+			if err != nil {
+				return nil, err
+			}
+			cr.file = file
+			cr.files = cr.files[1:]
+		}
+
+		header := make([]byte, captureRecordHeaderSize)
+		if _, err := io.ReadFull(cr.file, header); err != nil {
+			cr.file.Close()
+			cr.file = nil
+			// the last record of a file may be cut off by a crash.
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				continue
+			}
+			return nil, err
+		}
+		size := binary.BigEndian.Uint32(header)
+		if size > MaxCapturedMessageSize {
+			return nil, ErrInvalidCaptureRecord
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(cr.file, data); err != nil {
+			cr.file.Close()
+			cr.file = nil
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				continue
+			}
+			return nil, err
+		}
+
+		msg := new(netpb.CapturedMessage)
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return nil, ErrInvalidCaptureRecord
+		}
+		return msg, nil
+	}
+}
+
+// Close close the reader.
+func (cr *CaptureReader) Close() {
+	if cr.file != nil {
+		cr.file.Close()
+		cr.file = nil
+	}
+	cr.files = nil
+}
+
+// MessagePutter is where the replayed messages are put, e.g. the net service.
+type MessagePutter interface {
+	PutMessage(Message)
+}
+
+// ReplayCapture put the inbound messages of a capture in order,
+// if pace is true the original intervals between messages are kept.
+// Return the count of replayed messages.
+func ReplayCapture(path string, putter MessagePutter, pace bool) (int, error) {
+	reader, err := NewCaptureReader(path)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+
+	count := 0
+	var last int64
+	for {
+		msg, err := reader.Next()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if msg.Outbound {
+			continue
+		}
+		if pace && last > 0 && msg.Timestamp > last {
+			time.Sleep(time.Duration(msg.Timestamp - last))
+		}
+		last = msg.Timestamp
+
+		putter.PutMessage(NewBaseMessage(msg.Name, msg.Peer, msg.Data))
+		count++
+	}
+}
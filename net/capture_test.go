@@ -0,0 +1,153 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockPutter struct {
+	messages []Message
+}
+
+func (p *mockPutter) PutMessage(msg Message) {
+	p.messages = append(p.messages, msg)
+}
+
+func TestRecorderRotation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// every file holds about 2 records.
+	recorder, err := NewRecorder(dir, 100, 3)
+	assert.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		recorder.Record(i%2 == 1, "peer", fmt.Sprintf("msg%d", i), make([]byte, 20))
+	}
+	assert.Nil(t, recorder.Close())
+
+	files, err := captureFiles(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(files))
+
+	// the oldest records are dropped.
+	reader, err := NewCaptureReader(dir)
+	assert.Nil(t, err)
+	first, err := reader.Next()
+	assert.Nil(t, err)
+	assert.NotEqual(t, "msg0", first.Name)
+	last := first
+	for {
+		msg, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.Nil(t, err)
+		assert.True(t, msg.Timestamp >= last.Timestamp)
+		last = msg
+	}
+	assert.Equal(t, "msg9", last.Name)
+
+	// a new recorder continues the numbering.
+	recorder, err = NewRecorder(dir, 100, 3)
+	assert.Nil(t, err)
+	recorder.Record(false, "peer", "msg10", nil)
+	assert.Nil(t, recorder.Close())
+	files, err = captureFiles(dir)
+	assert.Nil(t, err)
+	reader, err = NewCaptureReader(files[len(files)-1])
+	assert.Nil(t, err)
+	msg, err := reader.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "msg10", msg.Name)
+}
+
+func TestRecorderRotateFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	moved := dir + "-moved"
+	defer os.RemoveAll(moved)
+
+	recorder, err := NewRecorder(dir, 100, 3)
+	assert.Nil(t, err)
+	recorder.Record(false, "peer", "msg0", make([]byte, 20))
+	recorder.Record(false, "peer", "msg1", make([]byte, 20))
+
+	// the rotation fails while the dir is gone, the records go on to the current file.
+	assert.Nil(t, os.Rename(dir, moved))
+	recorder.Record(false, "peer", "msg2", make([]byte, 20))
+	assert.False(t, recorder.rotateRetryAt.IsZero())
+	recorder.Record(false, "peer", "msg3", make([]byte, 20))
+
+	// the rotation is retried after the interval.
+	assert.Nil(t, os.Rename(moved, dir))
+	recorder.rotateRetryAt = time.Time{}
+	recorder.Record(false, "peer", "msg4", make([]byte, 20))
+	assert.Nil(t, recorder.Close())
+
+	files, err := captureFiles(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(files))
+
+	reader, err := NewCaptureReader(dir)
+	assert.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		msg, err := reader.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("msg%d", i), msg.Name)
+	}
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReplayCapture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "capture")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	recorder, err := NewRecorder(dir, DefaultCaptureFileSize*1024*1024, DefaultCaptureMaxFiles)
+	assert.Nil(t, err)
+	dp := NewDispatcher()
+	dp.SetRecorder(recorder)
+	dp.PutMessage(NewBaseMessage("newblock", "peer1", []byte("block")))
+	dp.RecordOutbound("peer1", "dlblock", []byte("parent"))
+	dp.PutMessage(NewBaseMessage("dlreply", "peer1", []byte("reply")))
+	assert.Nil(t, recorder.Close())
+
+	putter := new(mockPutter)
+	count, err := ReplayCapture(dir, putter, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, "newblock", putter.messages[0].MessageType())
+	assert.Equal(t, "peer1", putter.messages[0].MessageFrom())
+	assert.Equal(t, []byte("block"), putter.messages[0].Data())
+	assert.Equal(t, "dlreply", putter.messages[1].MessageType())
+
+	_, err = ReplayCapture(dir+"/missing", putter, false)
+	assert.NotNil(t, err)
+}
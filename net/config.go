@@ -37,6 +37,7 @@ const ( // TODO delete redundant vars
 	DefaultRoutingTableDir        = ""
 	DefaultMaxStreamNum           = 200
 	DefaultReservedStreamNum      = 20
+	DefaultNATMode                = NATModeAny
 )
 
 // Default Configuration in P2P network
@@ -63,6 +64,12 @@ type Config struct {
 	RoutingTableDir      string
 	StreamLimits         int32
 	ReservedStreamLimits int32
+	PeerFilters          map[string]PeerFilterAlgorithm
+	NAT                  string
+	ExternalAddrs        []multiaddr.Multiaddr
+	CaptureDir           string
+	CaptureFileSize      int64
+	CaptureMaxFiles      int
 }
 
 // Neblet interface breaks cycle import dependency.
@@ -123,6 +130,41 @@ func NewP2PConfig(n Neblet) *Config {
 		config.ReservedStreamLimits = networkConf.ReservedStreamLimits
 	}
 
+	// peer selection strategy of each message type or policy, all peers if not set.
+	for key, name := range networkConf.PeerFilters {
+		filter, err := NewPeerFilter(name)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid network.peer_filters config: err is %s, config value is %s:%s.", err, key, name))
+		}
+		config.PeerFilters[key] = filter
+	}
+
+	// nat port mapping.
+	if len(networkConf.Nat) > 0 {
+		if err := verifyNATMode(networkConf.Nat); err != nil {
+			panic(fmt.Sprintf("Invalid network.nat config: err is %s, config value is %s.", err, networkConf.Nat))
+		}
+		config.NAT = networkConf.Nat
+	}
+
+	// external address override.
+	for _, v := range networkConf.ExternalAddrs {
+		addr, err := parseExternalAddr(v)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid network.external_addrs config: err is %s, config value is %s.", err, v))
+		}
+		config.ExternalAddrs = append(config.ExternalAddrs, addr)
+	}
+
+	// message capture.
+	config.CaptureDir = networkConf.CaptureDir
+	if networkConf.CaptureFileSize > 0 {
+		config.CaptureFileSize = int64(networkConf.CaptureFileSize) * 1024 * 1024
+	}
+	if networkConf.CaptureMaxFiles > 0 {
+		config.CaptureMaxFiles = int(networkConf.CaptureMaxFiles)
+	}
+
 	return config
 }
 
@@ -155,5 +197,11 @@ func NewConfigFromDefaults() *Config {
 		DefaultRoutingTableDir,
 		DefaultMaxStreamNum,
 		DefaultReservedStreamNum,
+		make(map[string]PeerFilterAlgorithm),
+		DefaultNATMode,
+		[]multiaddr.Multiaddr{},
+		"",
+		DefaultCaptureFileSize * 1024 * 1024,
+		DefaultCaptureMaxFiles,
 	}
 }
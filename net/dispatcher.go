@@ -41,6 +41,7 @@ type Dispatcher struct {
 	receivedMessageCh  chan Message
 	dispatchedMessages *lru.Cache
 	filters            map[string]bool
+	recorder           *Recorder
 }
 
 // NewDispatcher create Dispatcher instance.
@@ -128,19 +129,44 @@ func (dp *Dispatcher) Stop() {
 	dp.quitCh <- true
 }
 
+// SetRecorder set the recorder capturing the inbound and outbound messages.
+func (dp *Dispatcher) SetRecorder(recorder *Recorder) {
+	dp.recorder = recorder
+}
+
+// RecordOutbound capture an outbound message if the recorder is set.
+func (dp *Dispatcher) RecordOutbound(peer, messageName string, data []byte) {
+	if dp.recorder != nil {
+		dp.recorder.Record(true, peer, messageName, data)
+	}
+}
+
 // PutMessage put new message to chan, then subscribers will be notified to process.
 func (dp *Dispatcher) PutMessage(msg Message) {
+	dp.putMessage(msg)
+}
+
+// putMessage return true if the message is the first one of its content in a filtered
+// message type, i.e. the sender relayed a new block or transaction to us.
+func (dp *Dispatcher) putMessage(msg Message) bool {
+	if dp.recorder != nil {
+		dp.recorder.Record(false, msg.MessageFrom(), msg.MessageType(), msg.Data())
+	}
+
 	// it's a optimize strategy for message dispatch, according to https://github.com/nebulasio/go-nebulas/issues/50
 	hash := msg.Hash()
+	useful := false
 	if dp.filters[msg.MessageType()] {
 		if exist, _ := dp.dispatchedMessages.ContainsOrAdd(hash, hash); exist == true {
 			// duplicated message, ignore.
 			metricsDuplicatedMessage(msg.MessageType())
-			return
+			return false
 		}
+		useful = true
 	}
 
 	dp.receivedMessageCh <- msg
+	return useful
 }
 
 func metricsDuplicatedMessage(messageName string) {
@@ -0,0 +1,477 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	gonat "github.com/libp2p/go-nat"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// NAT modes used in the network config.
+const (
+	NATModeNone  = "none"
+	NATModeAny   = "any"
+	NATModeUPnP  = "upnp"
+	NATModePMP   = "pmp"
+	NATModeLocal = "local" // local:<external ip>, a stand-in gateway for testing
+)
+
+// NAT parameters
+var (
+	NATDiscoveryTimeout              = 10 * time.Second
+	NATMappingLifetime               = 20 * time.Minute
+	NATMappingRefreshPeriod          = 15 * time.Minute
+	NATMappingDescription            = "nebulas"
+	ObservedAddrConfirmation         = 4 // reporters in distinct subnets
+	ObservedAddrOutboundConfirmation = 2 // outbound-dialed reporters in distinct subnets
+	ObservedAddrTTL                  = 30 * time.Minute
+	MaxObservedAddrs                 = 16
+	MaxObservedAddrReporters         = 32
+)
+
+// NAT Errors
+var (
+	ErrInvalidNATMode      = errors.New("invalid nat mode")
+	ErrNATNotFound         = errors.New("no nat gateway found")
+	ErrInvalidExternalAddr = errors.New("invalid external address")
+)
+
+// NAT is a gateway able to map local ports to its external address.
+type NAT interface {
+	// Type return the kind of the port mapping service.
+	Type() string
+
+	// ExternalIP return the external address of the gateway.
+	ExternalIP() (net.IP, error)
+
+	// AddMapping map a local port to an external port, return the external port.
+	AddMapping(protocol string, internalPort int, description string, lifetime time.Duration) (int, error)
+
+	// DeleteMapping remove the mapping of a local port.
+	DeleteMapping(protocol string, internalPort int) error
+}
+
+// NewNAT return the nat of the given mode, nil if nat is disabled.
+func NewNAT(mode string) (NAT, error) {
+	switch {
+	case mode == "" || mode == NATModeNone:
+		return nil, nil
+	case mode == NATModeAny || mode == NATModeUPnP || mode == NATModePMP:
+		return discoverNAT(mode)
+	case strings.HasPrefix(mode, NATModeLocal+":"):
+		ip := net.ParseIP(strings.TrimPrefix(mode, NATModeLocal+":"))
+		if ip == nil {
+			return nil, ErrInvalidNATMode
+		}
+		return NewLocalNAT(ip), nil
+	}
+	return nil, ErrInvalidNATMode
+}
+
+func verifyNATMode(mode string) error {
+	switch {
+	case mode == "" || mode == NATModeNone || mode == NATModeAny || mode == NATModeUPnP || mode == NATModePMP:
+		return nil
+	case strings.HasPrefix(mode, NATModeLocal+":"):
+		if net.ParseIP(strings.TrimPrefix(mode, NATModeLocal+":")) == nil {
+			return ErrInvalidNATMode
+		}
+		return nil
+	}
+	return ErrInvalidNATMode
+}
+
+func discoverNAT(mode string) (NAT, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), NATDiscoveryTimeout)
+	defer cancel()
+
+	if mode == NATModeAny {
+		nat, err := gonat.DiscoverGateway()
+		if err != nil {
+			return nil, ErrNATNotFound
+		}
+		return &gatewayNAT{nat}, nil
+	}
+
+	for nat := range gonat.DiscoverNATs(ctx) {
+		if mode == NATModePMP && nat.Type() == "NAT-PMP" {
+			return &gatewayNAT{nat}, nil
+		}
+		if mode == NATModeUPnP && strings.HasPrefix(nat.Type(), "UPNP") {
+			return &gatewayNAT{nat}, nil
+		}
+	}
+	return nil, ErrNATNotFound
+}
+
+// gatewayNAT is a UPnP or NAT-PMP gateway in the local network.
+type gatewayNAT struct {
+	nat gonat.NAT
+}
+
+func (g *gatewayNAT) Type() string {
+	return g.nat.Type()
+}
+
+func (g *gatewayNAT) ExternalIP() (net.IP, error) {
+	return g.nat.GetExternalAddress()
+}
+
+func (g *gatewayNAT) AddMapping(protocol string, internalPort int, description string, lifetime time.Duration) (int, error) {
+	return g.nat.AddPortMapping(protocol, internalPort, description, lifetime)
+}
+
+func (g *gatewayNAT) DeleteMapping(protocol string, internalPort int) error {
+	return g.nat.DeletePortMapping(protocol, internalPort)
+}
+
+// LocalNAT is an in-process stand-in of a gateway, mapping ports on a fixed external address.
+type LocalNAT struct {
+	mu       sync.Mutex
+	ip       net.IP
+	mappings map[string]int
+}
+
+// NewLocalNAT return a local nat with the given external address.
+func NewLocalNAT(ip net.IP) *LocalNAT {
+	return &LocalNAT{
+		ip:       ip,
+		mappings: make(map[string]int),
+	}
+}
+
+// Type implements NAT interface
+func (n *LocalNAT) Type() string {
+	return NATModeLocal
+}
+
+// ExternalIP implements NAT interface
+func (n *LocalNAT) ExternalIP() (net.IP, error) {
+	return n.ip, nil
+}
+
+// AddMapping implements NAT interface, the external port is the same as the internal one
+func (n *LocalNAT) AddMapping(protocol string, internalPort int, description string, lifetime time.Duration) (int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	key := fmt.Sprintf("%s:%d", protocol, internalPort)
+	n.mappings[key] = internalPort
+	return internalPort, nil
+}
+
+// DeleteMapping implements NAT interface
+func (n *LocalNAT) DeleteMapping(protocol string, internalPort int) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.mappings, fmt.Sprintf("%s:%d", protocol, internalPort))
+	return nil
+}
+
+// Mappings return the count of active mappings
+func (n *LocalNAT) Mappings() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return len(n.mappings)
+}
+
+// natManager keeps the port mappings of the listen addresses alive,
+// and decides the addresses announced to other peers.
+type natManager struct {
+	mu        sync.RWMutex
+	mode      string
+	nat       NAT
+	ports     []int
+	external  []ma.Multiaddr
+	mapped    []ma.Multiaddr
+	observed  *lru.Cache // ip -> map[pid]*addrReporter
+	confirmed ma.Multiaddr
+	quitCh    chan bool
+}
+
+func newNATManager(config *Config) *natManager {
+	ports := make([]int, 0, len(config.Listen))
+	for _, v := range config.Listen {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", v)
+		if err != nil {
+			continue
+		}
+		ports = append(ports, tcpAddr.Port)
+	}
+	observed, _ := lru.New(MaxObservedAddrs)
+	return &natManager{
+		mode:     config.NAT,
+		ports:    ports,
+		external: config.ExternalAddrs,
+		observed: observed,
+		quitCh:   make(chan bool, 1),
+	}
+}
+
+// Start discover the gateway and map the listen ports in background
+func (m *natManager) Start() {
+	if len(m.external) > 0 || m.mode == "" || m.mode == NATModeNone {
+		return
+	}
+	go m.loop()
+}
+
+// Stop delete the port mappings
+func (m *natManager) Stop() {
+	if len(m.external) > 0 || m.mode == "" || m.mode == NATModeNone {
+		return
+	}
+	m.quitCh <- true
+}
+
+func (m *natManager) loop() {
+	nat, err := NewNAT(m.mode)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"mode": m.mode,
+			"err":  err,
+		}).Warn("Failed to discover nat gateway.")
+		return
+	}
+	m.nat = nat
+
+	m.refresh()
+	ticker := time.NewTicker(NATMappingRefreshPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.quitCh:
+			for _, port := range m.ports {
+				m.nat.DeleteMapping("tcp", port)
+			}
+			logging.CLog().Info("Stopped NAT Manager Loop.")
+			return
+		case <-ticker.C:
+			m.refresh()
+		}
+	}
+}
+
+func (m *natManager) refresh() {
+	ip, err := m.nat.ExternalIP()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"nat": m.nat.Type(),
+			"err": err,
+		}).Debug("Failed to get external address of nat.")
+		return
+	}
+
+	mapped := make([]ma.Multiaddr, 0, len(m.ports))
+	for _, port := range m.ports {
+		extPort, err := m.nat.AddMapping("tcp", port, NATMappingDescription, NATMappingLifetime)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"nat":  m.nat.Type(),
+				"port": port,
+				"err":  err,
+			}).Debug("Failed to map port on nat.")
+			continue
+		}
+		addr, err := ipTCPMultiaddr(ip, extPort)
+		if err != nil {
+			continue
+		}
+		mapped = append(mapped, addr)
+	}
+
+	m.mu.Lock()
+	m.mapped = mapped
+	m.mu.Unlock()
+
+	logging.CLog().WithFields(logrus.Fields{
+		"nat":    m.nat.Type(),
+		"mapped": mapped,
+	}).Info("Mapped ports on nat.")
+}
+
+// addrReporter is a peer which reported the address of this node.
+type addrReporter struct {
+	prefix     string
+	outbound   bool
+	reportedAt time.Time
+}
+
+// Observe record the address of this node observed by a peer,
+// the ip is announced once enough peers in distinct subnets agree on it,
+// peers dialed by this node are harder to forge and need less agreement.
+// Every peer reports one address, the reports expire after ObservedAddrTTL,
+// and the announced address changes only when another one has more support.
+func (m *natManager) Observe(pid string, peerAddr ma.Multiaddr, outbound bool, addr ma.Multiaddr) {
+	if addr == nil || len(m.ports) == 0 {
+		return
+	}
+	ip, err := ipOfMultiaddr(addr)
+	if err != nil || ip.IsLoopback() || ip.IsUnspecified() {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	key := ip.String()
+	for _, k := range m.observed.Keys() {
+		if v, ok := m.observed.Peek(k); ok && k != key {
+			delete(v.(map[string]*addrReporter), pid)
+		}
+	}
+	var reporters map[string]*addrReporter
+	if v, ok := m.observed.Get(key); ok {
+		reporters = v.(map[string]*addrReporter)
+	} else {
+		reporters = make(map[string]*addrReporter)
+		m.observed.Add(key, reporters)
+	}
+	if _, ok := reporters[pid]; !ok && len(reporters) >= MaxObservedAddrReporters {
+		evictOldestReporter(reporters)
+	}
+	reporters[pid] = &addrReporter{
+		prefix:     networkPrefix(peerAddr),
+		outbound:   outbound,
+		reportedAt: now,
+	}
+
+	support, confirmed := observedSupport(reporters, now)
+	if !confirmed {
+		return
+	}
+	if m.confirmed != nil {
+		if current, err := ipOfMultiaddr(m.confirmed); err == nil && current.String() != key {
+			if v, ok := m.observed.Peek(current.String()); ok {
+				if currentSupport, currentConfirmed := observedSupport(v.(map[string]*addrReporter), now); currentConfirmed && currentSupport >= support {
+					return
+				}
+			}
+		}
+	}
+
+	addrConfirmed, err := ipTCPMultiaddr(ip, m.ports[0])
+	if err != nil || (m.confirmed != nil && m.confirmed.Equal(addrConfirmed)) {
+		return
+	}
+	m.confirmed = addrConfirmed
+	logging.CLog().WithFields(logrus.Fields{
+		"addr":    addrConfirmed,
+		"support": support,
+	}).Info("Confirmed the external address observed by peers.")
+}
+
+// observedSupport drop the expired reports, return the count of the distinct subnets
+// reporting the address, plus the outbound ones, and whether the address is confirmed.
+func observedSupport(reporters map[string]*addrReporter, now time.Time) (int, bool) {
+	prefixes := make(map[string]bool)
+	outboundPrefixes := make(map[string]bool)
+	for pid, v := range reporters {
+		if now.Sub(v.reportedAt) > ObservedAddrTTL {
+			delete(reporters, pid)
+			continue
+		}
+		prefixes[v.prefix] = true
+		if v.outbound {
+			outboundPrefixes[v.prefix] = true
+		}
+	}
+	confirmed := len(prefixes) >= ObservedAddrConfirmation || len(outboundPrefixes) >= ObservedAddrOutboundConfirmation
+	return len(prefixes) + len(outboundPrefixes), confirmed
+}
+
+func evictOldestReporter(reporters map[string]*addrReporter) {
+	var oldest string
+	for pid, v := range reporters {
+		if oldest == "" || v.reportedAt.Before(reporters[oldest].reportedAt) {
+			oldest = pid
+		}
+	}
+	delete(reporters, oldest)
+}
+
+// Addrs return the addresses announced to other peers, in the order of
+// the configured external addresses, the nat mapped addresses and the observed address.
+func (m *natManager) Addrs() []ma.Multiaddr {
+	if len(m.external) > 0 {
+		return m.external
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.mapped) > 0 {
+		return m.mapped
+	}
+	if m.confirmed != nil {
+		return []ma.Multiaddr{m.confirmed}
+	}
+	return []ma.Multiaddr{}
+}
+
+// parseExternalAddr parse an external address in the format of ip:port.
+func parseExternalAddr(addr string) (ma.Multiaddr, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, ErrInvalidExternalAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, ErrInvalidExternalAddr
+	}
+	tcpAddr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(ip.String(), port))
+	if err != nil {
+		return nil, ErrInvalidExternalAddr
+	}
+	return ipTCPMultiaddr(tcpAddr.IP, tcpAddr.Port)
+}
+
+func ipTCPMultiaddr(ip net.IP, port int) (ma.Multiaddr, error) {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ip4, port))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/tcp/%d", ip, port))
+}
+
+func ipOfMultiaddr(addr ma.Multiaddr) (net.IP, error) {
+	for _, code := range []int{ma.P_IP4, ma.P_IP6} {
+		value, err := addr.ValueForProtocol(code)
+		if err != nil {
+			continue
+		}
+		if ip := net.ParseIP(value); ip != nil {
+			return ip, nil
+		}
+	}
+	return nil, ErrInvalidExternalAddr
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyNATMode(t *testing.T) {
+	for _, mode := range []string{"", NATModeNone, NATModeAny, NATModeUPnP, NATModePMP, "local:1.2.3.4"} {
+		assert.Nil(t, verifyNATMode(mode))
+	}
+	for _, mode := range []string{"upnp2", "local", "local:abc"} {
+		assert.Equal(t, ErrInvalidNATMode, verifyNATMode(mode))
+	}
+
+	nat, err := NewNAT("local:1.2.3.4")
+	assert.Nil(t, err)
+	assert.Equal(t, NATModeLocal, nat.Type())
+	nat, err = NewNAT(NATModeNone)
+	assert.Nil(t, err)
+	assert.Nil(t, nat)
+}
+
+func TestParseExternalAddr(t *testing.T) {
+	addr, err := parseExternalAddr("1.2.3.4:8680")
+	assert.Nil(t, err)
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8680", addr.String())
+
+	addr, err = parseExternalAddr("[2001:db8::1]:8680")
+	assert.Nil(t, err)
+	assert.Equal(t, "/ip6/2001:db8::1/tcp/8680", addr.String())
+
+	for _, v := range []string{"1.2.3.4", "seed.nebulas.io:8680", "1.2.3.4:port"} {
+		_, err = parseExternalAddr(v)
+		assert.Equal(t, ErrInvalidExternalAddr, err)
+	}
+}
+
+func TestNATManagerMapping(t *testing.T) {
+	config := NewConfigFromDefaults()
+	config.Listen = []string{"0.0.0.0:8680", "0.0.0.0:8690"}
+	config.NAT = "local:1.2.3.4"
+	m := newNATManager(config)
+	assert.Equal(t, 0, len(m.Addrs()))
+
+	nat := NewLocalNAT(net.ParseIP("1.2.3.4"))
+	m.nat = nat
+	m.refresh()
+	assert.Equal(t, 2, nat.Mappings())
+	addrs := m.Addrs()
+	assert.Equal(t, 2, len(addrs))
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8680", addrs[0].String())
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8690", addrs[1].String())
+
+	// external address override.
+	override, _ := parseExternalAddr("5.6.7.8:9000")
+	config.ExternalAddrs = []ma.Multiaddr{override}
+	m = newNATManager(config)
+	m.nat = nat
+	m.refresh()
+	assert.Equal(t, []ma.Multiaddr{override}, m.Addrs())
+}
+
+func TestNATManagerObserve(t *testing.T) {
+	config := NewConfigFromDefaults()
+	config.NAT = NATModeNone
+	m := newNATManager(config)
+
+	observed, _ := ma.NewMultiaddr("/ip4/1.2.3.4/tcp/51234")
+	loopback, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/51234")
+
+	peerAddr := func(i int) ma.Multiaddr {
+		addr, _ := ma.NewMultiaddr(fmt.Sprintf("/ip4/10.%d.0.1/tcp/8680", i))
+		return addr
+	}
+
+	for i := 0; i < ObservedAddrConfirmation; i++ {
+		m.Observe(fmt.Sprintf("peer%d", i), peerAddr(i), true, loopback)
+	}
+	assert.Equal(t, 0, len(m.Addrs()))
+
+	// sybil peers in one subnet are not enough.
+	sybil, _ := ma.NewMultiaddr("/ip4/10.0.0.2/tcp/8680")
+	m.Observe("peer0", peerAddr(0), false, observed)
+	m.Observe("peer0", peerAddr(0), false, observed)
+	for i := 1; i < 2*ObservedAddrConfirmation; i++ {
+		m.Observe(fmt.Sprintf("sybil%d", i), sybil, false, observed)
+	}
+	assert.Equal(t, 0, len(m.Addrs()))
+
+	// inbound peers in distinct subnets.
+	for i := 1; i < ObservedAddrConfirmation; i++ {
+		m.Observe(fmt.Sprintf("peer%d", i), peerAddr(i), false, observed)
+	}
+	addrs := m.Addrs()
+	assert.Equal(t, 1, len(addrs))
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8680", addrs[0].String())
+
+	// outbound-dialed peers need less agreement.
+	other, _ := ma.NewMultiaddr("/ip4/5.6.7.8/tcp/51234")
+	m.Observe("peer0", peerAddr(0), true, other)
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8680", m.Addrs()[0].String())
+	m.Observe("peer1", peerAddr(1), true, other)
+	addrs = m.Addrs()
+	assert.Equal(t, "/ip4/5.6.7.8/tcp/8680", addrs[0].String())
+
+	// the confirmed address is kept until another one has more support, the sybils count as one subnet.
+	for i := 2; i < 1+ObservedAddrConfirmation; i++ {
+		m.Observe(fmt.Sprintf("peer%d", i), peerAddr(i), false, observed)
+	}
+	assert.Equal(t, "/ip4/5.6.7.8/tcp/8680", m.Addrs()[0].String())
+	m.Observe("peer6", peerAddr(6), true, observed)
+	assert.Equal(t, "/ip4/1.2.3.4/tcp/8680", m.Addrs()[0].String())
+
+	// the reports expire.
+	v, _ := m.observed.Peek("1.2.3.4")
+	for _, reporter := range v.(map[string]*addrReporter) {
+		reporter.reportedAt = time.Now().Add(-2 * ObservedAddrTTL)
+	}
+	m.Observe("peer0", peerAddr(0), true, other)
+	assert.Equal(t, 0, len(v.(map[string]*addrReporter)))
+	assert.Equal(t, "/ip4/5.6.7.8/tcp/8680", m.Addrs()[0].String())
+
+	// the addresses and their reporters are bounded.
+	for i := 0; i < 2*MaxObservedAddrReporters; i++ {
+		m.Observe(fmt.Sprintf("flood%d", i), peerAddr(i), false, observed)
+	}
+	v, _ = m.observed.Peek("1.2.3.4")
+	assert.Equal(t, MaxObservedAddrReporters, len(v.(map[string]*addrReporter)))
+	for i := 0; i < 2*MaxObservedAddrs; i++ {
+		addr, _ := ma.NewMultiaddr(fmt.Sprintf("/ip4/9.9.%d.1/tcp/8680", i))
+		m.Observe(fmt.Sprintf("flood%d", i), peerAddr(i), false, addr)
+	}
+	assert.Equal(t, MaxObservedAddrs, m.observed.Len())
+}
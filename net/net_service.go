@@ -46,6 +46,19 @@ func NewNebService(n Neblet) (*NebService, error) {
 	}
 	node.SetNebService(ns)
 
+	// message capture.
+	if config := node.Config(); len(config.CaptureDir) > 0 {
+		recorder, err := NewRecorder(config.CaptureDir, config.CaptureFileSize, config.CaptureMaxFiles)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"dir": config.CaptureDir,
+				"err": err,
+			}).Error("Failed to create message recorder.")
+			return nil, err
+		}
+		ns.dispatcher.SetRecorder(recorder)
+	}
+
 	return ns, nil
 }
 
@@ -80,6 +93,14 @@ func (ns *NebService) Stop() {
 
 	ns.node.Stop()
 	ns.dispatcher.Stop()
+	if ns.dispatcher.recorder != nil {
+		ns.dispatcher.recorder.Close()
+	}
+}
+
+// StartDispatcher start the dispatcher only, used to replay captured messages offline.
+func (ns *NebService) StartDispatcher() {
+	ns.dispatcher.Start()
 }
 
 // Register register the subscribers.
@@ -107,6 +128,21 @@ func (ns *NebService) Relay(name string, msg Serializable, priority int) {
 	ns.node.RelayMessage(name, msg, priority)
 }
 
+// BroadcastByCapability broadcast message to the peers advertising the capability, and the fallback to the others.
+func (ns *NebService) BroadcastByCapability(capability string, name string, msg Serializable, fallbackName string, fallback Serializable, priority int) {
+	ns.node.BroadcastMessageByCapability(capability, name, msg, fallbackName, fallback, priority)
+}
+
+// RegisterPeerFilterPolicy name the message types whose peer filter is configured by the policy.
+func (ns *NebService) RegisterPeerFilterPolicy(policy string, messageNames ...string) {
+	ns.node.streamManager.RegisterPeerFilterPolicy(policy, messageNames...)
+}
+
+// RegisterCapability advertise the capability to the peers.
+func (ns *NebService) RegisterCapability(capability string) {
+	ns.node.streamManager.RegisterCapability(capability)
+}
+
 // BroadcastNetworkID broadcast networkID when changed.
 func (ns *NebService) BroadcastNetworkID(msg []byte) {
 	// TODO: @robin networkID.
@@ -62,6 +62,7 @@ type Node struct {
 	host          host.Host
 	streamManager *StreamManager
 	routeTable    *RouteTable
+	natManager    *natManager
 }
 
 // NewNode return new Node according to the config.
@@ -80,6 +81,7 @@ func NewNode(config *Config) (*Node, error) {
 		config:        config,
 		context:       context.Background(),
 		streamManager: NewStreamManager(config),
+		natManager:    newNATManager(config),
 		synchronizing: false,
 	}
 
@@ -104,6 +106,7 @@ func (node *Node) Start() error {
 	}
 
 	node.routeTable.Start()
+	node.natManager.Start()
 
 	logging.CLog().WithFields(logrus.Fields{
 		"id":                node.ID(),
@@ -115,26 +118,31 @@ func (node *Node) Start() error {
 
 // Stop stop a node.
 func (node *Node) Stop() {
+	// the node is not started when a capture is replayed offline.
+	if node.host == nil {
+		return
+	}
+
 	logging.CLog().WithFields(logrus.Fields{
 		"id":                node.ID(),
 		"listening address": node.host.Addrs(),
 	}).Info("Stopping NebService Node...")
 
+	node.natManager.Stop()
 	node.routeTable.Stop()
 	node.stopHost()
 	node.streamManager.Stop()
 }
 
 func (node *Node) startHost() error {
-	// add nat manager
-	//options := &basichost.HostOpts{}
-	//options.NATManager = basichost.NewNATManager
-	//host, err := basichost.NewHost(node.context, node.swarm, options)
+	// the port mapping is done by the nat manager, announce its addresses.
 	opts := []libp2p.Option{
 		libp2p.ListenAddrs(node.multiaddrs...),
 		libp2p.Identity(node.networkKey),
 		libp2p.Peerstore(node.routeTable.peerStore),
-		libp2p.NATPortMap(),
+		libp2p.AddrsFactory(func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+			return append(addrs, node.natManager.Addrs()...)
+		}),
 	}
 	host, err := libp2p.New(node.context, opts...)
 	if err != nil {
@@ -197,6 +205,11 @@ func (node *Node) RouteTable() *RouteTable {
 	return node.routeTable
 }
 
+// ExternalAddrs return the addresses announced to other peers.
+func (node *Node) ExternalAddrs() []multiaddr.Multiaddr {
+	return node.natManager.Addrs()
+}
+
 func initP2PNetworkKey(config *Config, node *Node) {
 	// init p2p network key.
 	networkKey, err := LoadNetworkKeyFromFileOrCreateNew(config.PrivateKeyPath)
@@ -313,3 +326,14 @@ func (node *Node) RelayMessage(messageName string, data Serializable, priority i
 
 	node.streamManager.RelayMessage(messageName, data, priority)
 }
+
+// BroadcastMessageByCapability broadcast message to the peers advertising the capability, and the fallback to the others.
+func (node *Node) BroadcastMessageByCapability(capability string, messageName string, data Serializable,
+	fallbackName string, fallback Serializable, priority int) {
+	// node can not broadcast or relay message if it is in synchronizing.
+	if node.synchronizing {
+		return
+	}
+
+	node.streamManager.BroadcastMessageByCapability(capability, messageName, data, fallbackName, fallback, priority)
+}
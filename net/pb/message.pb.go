@@ -12,6 +12,7 @@ It has these top-level messages:
 	OK
 	Peers
 	PeerInfo
+	CapturedMessage
 */
 package netpb
 
@@ -37,6 +38,8 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type Hello struct {
 	NodeId        string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
 	ClientVersion string `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	// The optional messages the node understands, e.g. compact blocks.
+	Capabilities []string `protobuf:"bytes,3,rep,name=capabilities" json:"capabilities,omitempty"`
 }
 
 func (m *Hello) Reset()                    { *m = Hello{} }
@@ -58,9 +61,17 @@ func (m *Hello) GetClientVersion() string {
 	return ""
 }
 
+func (m *Hello) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type OK struct {
-	NodeId        string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ClientVersion string `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	NodeId        string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ClientVersion string   `protobuf:"bytes,2,opt,name=client_version,json=clientVersion,proto3" json:"client_version,omitempty"`
+	Capabilities  []string `protobuf:"bytes,3,rep,name=capabilities" json:"capabilities,omitempty"`
 }
 
 func (m *OK) Reset()                    { *m = OK{} }
@@ -82,8 +93,17 @@ func (m *OK) GetClientVersion() string {
 	return ""
 }
 
+func (m *OK) GetCapabilities() []string {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
 type Peers struct {
 	Peers []*PeerInfo `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+	// The address of the requester observed by the responder.
+	ObservedAddr string `protobuf:"bytes,2,opt,name=observed_addr,json=observedAddr,proto3" json:"observed_addr,omitempty"`
 }
 
 func (m *Peers) Reset()                    { *m = Peers{} }
@@ -98,6 +118,13 @@ func (m *Peers) GetPeers() []*PeerInfo {
 	return nil
 }
 
+func (m *Peers) GetObservedAddr() string {
+	if m != nil {
+		return m.ObservedAddr
+	}
+	return ""
+}
+
 type PeerInfo struct {
 	Id    string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Addrs []string `protobuf:"bytes,2,rep,name=addrs" json:"addrs,omitempty"`
@@ -122,27 +149,84 @@ func (m *PeerInfo) GetAddrs() []string {
 	return nil
 }
 
+type CapturedMessage struct {
+	// Unix time in nanoseconds.
+	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Outbound  bool   `protobuf:"varint,2,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	Peer      string `protobuf:"bytes,3,opt,name=peer,proto3" json:"peer,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Data      []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CapturedMessage) Reset()                    { *m = CapturedMessage{} }
+func (m *CapturedMessage) String() string            { return proto.CompactTextString(m) }
+func (*CapturedMessage) ProtoMessage()               {}
+func (*CapturedMessage) Descriptor() ([]byte, []int) { return fileDescriptorMessage, []int{4} }
+
+func (m *CapturedMessage) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *CapturedMessage) GetOutbound() bool {
+	if m != nil {
+		return m.Outbound
+	}
+	return false
+}
+
+func (m *CapturedMessage) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *CapturedMessage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CapturedMessage) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Hello)(nil), "netpb.Hello")
 	proto.RegisterType((*OK)(nil), "netpb.OK")
 	proto.RegisterType((*Peers)(nil), "netpb.Peers")
 	proto.RegisterType((*PeerInfo)(nil), "netpb.PeerInfo")
+	proto.RegisterType((*CapturedMessage)(nil), "netpb.CapturedMessage")
 }
 
 func init() { proto.RegisterFile("message.proto", fileDescriptorMessage) }
 
 var fileDescriptorMessage = []byte{
-	// 188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0x4d, 0x2d, 0x2e,
-	0x4e, 0x4c, 0x4f, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcd, 0x4b, 0x2d, 0x29, 0x48,
-	0x52, 0x72, 0xe7, 0x62, 0xf5, 0x48, 0xcd, 0xc9, 0xc9, 0x17, 0x12, 0xe7, 0x62, 0xcf, 0xcb, 0x4f,
-	0x49, 0x8d, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x62, 0x03, 0x71, 0x3d, 0x53,
-	0x84, 0x54, 0xb9, 0xf8, 0x92, 0x73, 0x32, 0x53, 0xf3, 0x4a, 0xe2, 0xcb, 0x52, 0x8b, 0x8a, 0x33,
-	0xf3, 0xf3, 0x24, 0x98, 0xc0, 0xf2, 0xbc, 0x10, 0xd1, 0x30, 0x88, 0xa0, 0x92, 0x0b, 0x17, 0x93,
-	0xbf, 0x37, 0xc5, 0xa6, 0xe8, 0x71, 0xb1, 0x06, 0xa4, 0xa6, 0x16, 0x15, 0x0b, 0xa9, 0x72, 0xb1,
-	0x16, 0x80, 0x18, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xfc, 0x7a, 0x60, 0xe7, 0xea, 0x81,
-	0x24, 0x3d, 0xf3, 0xd2, 0xf2, 0x83, 0x20, 0xb2, 0x4a, 0x06, 0x5c, 0x1c, 0x30, 0x21, 0x21, 0x3e,
-	0x2e, 0x26, 0xb8, 0xb5, 0x4c, 0x99, 0x29, 0x42, 0x22, 0x5c, 0xac, 0x89, 0x29, 0x29, 0x45, 0xc5,
-	0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0x10, 0x4e, 0x12, 0x1b, 0xd8, 0xfb, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x69, 0x57, 0x77, 0xcd, 0x0f, 0x01, 0x00, 0x00,
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x91, 0x5f, 0x4b, 0xf3, 0x30,
+	0x14, 0xc6, 0x69, 0xbb, 0xee, 0xdd, 0xce, 0xbb, 0x3f, 0x10, 0x04, 0x83, 0x78, 0x51, 0x2a, 0x83,
+	0x5e, 0x0d, 0xd1, 0x4f, 0x20, 0xde, 0x38, 0x44, 0x94, 0x0a, 0xde, 0x8e, 0x74, 0xe7, 0xa8, 0xc1,
+	0x36, 0x29, 0x49, 0xba, 0xaf, 0xe0, 0xd7, 0x96, 0x24, 0x6e, 0xe2, 0x17, 0xf0, 0xee, 0x39, 0xbf,
+	0x87, 0x93, 0xe7, 0x49, 0x02, 0xf3, 0x8e, 0xac, 0x15, 0x6f, 0xb4, 0xee, 0x8d, 0x76, 0x9a, 0xe5,
+	0x8a, 0x5c, 0xdf, 0x94, 0x1f, 0x90, 0xdf, 0x51, 0xdb, 0x6a, 0x76, 0x0a, 0xff, 0x94, 0x46, 0xda,
+	0x4a, 0xe4, 0x49, 0x91, 0x54, 0xd3, 0x7a, 0xec, 0xc7, 0x0d, 0xb2, 0x15, 0x2c, 0x76, 0xad, 0x24,
+	0xe5, 0xb6, 0x7b, 0x32, 0x56, 0x6a, 0xc5, 0xd3, 0xe0, 0xcf, 0x23, 0x7d, 0x89, 0x90, 0x95, 0x30,
+	0xdb, 0x89, 0x5e, 0x34, 0xb2, 0x95, 0x4e, 0x92, 0xe5, 0x59, 0x91, 0x55, 0xd3, 0xfa, 0x17, 0x2b,
+	0xdf, 0x21, 0x7d, 0xbc, 0xff, 0x93, 0xa4, 0x67, 0xc8, 0x9f, 0x88, 0x8c, 0x65, 0x2b, 0xc8, 0x7b,
+	0x2f, 0x78, 0x52, 0x64, 0xd5, 0xff, 0xab, 0xe5, 0x3a, 0x5c, 0x7b, 0xed, 0xcd, 0x8d, 0x7a, 0xd5,
+	0x75, 0x74, 0xd9, 0x05, 0xcc, 0x75, 0x63, 0xc9, 0xec, 0x09, 0xb7, 0x02, 0xd1, 0x7c, 0x27, 0xcf,
+	0x0e, 0xf0, 0x06, 0xd1, 0x94, 0x97, 0x30, 0x39, 0xec, 0xb1, 0x05, 0xa4, 0xc7, 0xfe, 0xa9, 0x44,
+	0x76, 0x02, 0xb9, 0xdf, 0xb3, 0x3c, 0x0d, 0x6d, 0xe2, 0x50, 0x7e, 0x26, 0xb0, 0xbc, 0x15, 0xbd,
+	0x1b, 0x0c, 0xe1, 0x43, 0x7c, 0x7e, 0x76, 0x0e, 0x53, 0x27, 0x3b, 0xb2, 0x4e, 0x74, 0x7d, 0x38,
+	0x20, 0xab, 0x7f, 0x00, 0x3b, 0x83, 0x89, 0x1e, 0x5c, 0xa3, 0x07, 0x85, 0xa1, 0xc3, 0xa4, 0x3e,
+	0xce, 0x8c, 0xc1, 0xc8, 0xb7, 0xe5, 0x59, 0x48, 0x0d, 0xda, 0x33, 0x25, 0x3a, 0xe2, 0xa3, 0xc8,
+	0xbc, 0xf6, 0x0c, 0x85, 0x13, 0x3c, 0x2f, 0x92, 0x6a, 0x56, 0x07, 0xdd, 0x8c, 0xc3, 0xaf, 0x5f,
+	0x7f, 0x0d, 0x00, 0x9c, 0xd4, 0x37, 0xd8, 0x06, 0x02, 0x00, 0x00,
 }
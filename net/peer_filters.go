@@ -21,8 +21,55 @@ package net
 import (
 	"math"
 	"math/rand"
+	"net"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// Peer filter names used in the network config.
+const (
+	PeerFilterAll       = "all"
+	PeerFilterSqrt      = "sqrt"
+	PeerFilterRandom    = "random"
+	PeerFilterLatency   = "latency"
+	PeerFilterScore     = "score"
+	PeerFilterDiversity = "diversity"
 )
 
+// DefaultPeerLatency is the latency assumed for a peer not measured yet.
+var DefaultPeerLatency = 500 * time.Millisecond
+
+// NewPeerFilter return the peer filter algorithm of the given name.
+func NewPeerFilter(name string) (PeerFilterAlgorithm, error) {
+	switch name {
+	case PeerFilterAll:
+		return new(AllPeersFilter), nil
+	case PeerFilterSqrt:
+		return new(ChainSyncPeersFilter), nil
+	case PeerFilterRandom:
+		return new(RandomPeerFilter), nil
+	case PeerFilterLatency:
+		return new(LatencyPeerFilter), nil
+	case PeerFilterScore:
+		return new(ScorePeerFilter), nil
+	case PeerFilterDiversity:
+		return new(DiversityPeerFilter), nil
+	}
+	return nil, ErrUnknownPeerFilter
+}
+
+// AllPeersFilter keeps all the peers
+type AllPeersFilter struct {
+}
+
+// Filter implemets PeerFilterAlgorithm interface
+func (filter *AllPeersFilter) Filter(peers PeersSlice) PeersSlice {
+	return peers
+}
+
 // ChainSyncPeersFilter will filter some peers randomly
 type ChainSyncPeersFilter struct {
 }
@@ -49,3 +96,120 @@ func (filter *RandomPeerFilter) Filter(peers PeersSlice) PeersSlice {
 	selection := rand.Intn(len(peers))
 	return peers[selection : selection+1]
 }
+
+// LatencyPeerFilter will filter sqrt of the peers randomly,
+// the peers with lower latency are more likely to be selected
+type LatencyPeerFilter struct {
+}
+
+// Filter implemets PeerFilterAlgorithm interface
+func (filter *LatencyPeerFilter) Filter(peers PeersSlice) PeersSlice {
+	return weightedSelection(peers, func(stream *Stream) float64 {
+		latency := stream.Latency()
+		if latency <= 0 {
+			latency = DefaultPeerLatency
+		}
+		return 1 / latency.Seconds()
+	})
+}
+
+// ScorePeerFilter will filter sqrt of the peers randomly, the peers which relayed
+// more new blocks and transactions to us first are more likely to be selected
+type ScorePeerFilter struct {
+}
+
+// Filter implemets PeerFilterAlgorithm interface
+func (filter *ScorePeerFilter) Filter(peers PeersSlice) PeersSlice {
+	return weightedSelection(peers, func(stream *Stream) float64 {
+		return float64(1 + atomic.LoadInt64(&stream.usefulTotal))
+	})
+}
+
+// DiversityPeerFilter will filter sqrt of the peers,
+// spreading the selection over as many network prefixes as possible
+type DiversityPeerFilter struct {
+}
+
+// Filter implemets PeerFilterAlgorithm interface
+func (filter *DiversityPeerFilter) Filter(peers PeersSlice) PeersSlice {
+	if len(peers) == 0 {
+		return peers
+	}
+
+	groups := make(map[string]PeersSlice)
+	keys := make([]string, 0)
+	for _, v := range peers {
+		var key string
+		if stream, ok := v.(*Stream); ok {
+			key = networkPrefix(stream.addr)
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], v)
+	}
+	sort.Strings(keys)
+	rand.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+	for _, key := range keys {
+		group := groups[key]
+		rand.Shuffle(len(group), func(i, j int) { group[i], group[j] = group[j], group[i] })
+	}
+
+	selection := int(math.Sqrt(float64(len(peers))))
+	selected := make(PeersSlice, 0, selection)
+	for round := 0; len(selected) < selection; round++ {
+		for _, key := range keys {
+			if round < len(groups[key]) && len(selected) < selection {
+				selected = append(selected, groups[key][round])
+			}
+		}
+	}
+	return selected
+}
+
+// weightedSelection select sqrt of the peers randomly without replacement,
+// the chance of each peer is proportional to its weight
+func weightedSelection(peers PeersSlice, weight func(*Stream) float64) PeersSlice {
+	if len(peers) == 0 {
+		return peers
+	}
+
+	type weightedPeer struct {
+		peer interface{}
+		key  float64
+	}
+	candidates := make([]*weightedPeer, len(peers))
+	for i, v := range peers {
+		// Efraimidis-Spirakis: key = u^(1/w), keep the largest keys.
+		w := 1.0
+		if stream, ok := v.(*Stream); ok {
+			w = weight(stream)
+		}
+		candidates[i] = &weightedPeer{
+			peer: v,
+			key:  math.Pow(rand.Float64(), 1/w),
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].key > candidates[j].key })
+
+	selection := int(math.Sqrt(float64(len(peers))))
+	selected := make(PeersSlice, selection)
+	for i := 0; i < selection; i++ {
+		selected[i] = candidates[i].peer
+	}
+	return selected
+}
+
+// networkPrefix return the /16 prefix of an ipv4 address or the /32 prefix of an ipv6 address
+func networkPrefix(addr ma.Multiaddr) string {
+	if addr == nil {
+		return ""
+	}
+	if ip, err := ipOfMultiaddr(addr); err == nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4.Mask(net.CIDRMask(16, 32)).String()
+		}
+		return ip.Mask(net.CIDRMask(32, 128)).String()
+	}
+	return addr.String()
+}
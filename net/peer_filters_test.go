@@ -0,0 +1,159 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockPeers(num int, addr func(int) string) PeersSlice {
+	peers := make(PeersSlice, num)
+	for i := 0; i < num; i++ {
+		a, _ := ma.NewMultiaddr(addr(i))
+		peers[i] = &Stream{
+			addr:     a,
+			msgCount: make(map[string]int),
+		}
+	}
+	return peers
+}
+
+func TestNewPeerFilter(t *testing.T) {
+	for _, name := range []string{PeerFilterAll, PeerFilterSqrt, PeerFilterRandom, PeerFilterLatency, PeerFilterScore, PeerFilterDiversity} {
+		filter, err := NewPeerFilter(name)
+		assert.Nil(t, err)
+		assert.NotNil(t, filter)
+	}
+	_, err := NewPeerFilter("unknown")
+	assert.Equal(t, ErrUnknownPeerFilter, err)
+}
+
+func TestWeightedPeerFilters(t *testing.T) {
+	peers := mockPeers(16, func(i int) string { return fmt.Sprintf("/ip4/10.0.0.%d/tcp/8680", i) })
+	fast := peers[0].(*Stream)
+	fast.latency = int64(time.Millisecond)
+	busy := peers[1].(*Stream)
+	busy.usefulTotal = 100000
+
+	fastSelected, busySelected := 0, 0
+	for i := 0; i < 100; i++ {
+		selected := new(LatencyPeerFilter).Filter(peers)
+		assert.Equal(t, 4, len(selected))
+		for _, v := range selected {
+			if v == fast {
+				fastSelected++
+			}
+		}
+
+		selected = new(ScorePeerFilter).Filter(peers)
+		assert.Equal(t, 4, len(selected))
+		for _, v := range selected {
+			if v == busy {
+				busySelected++
+			}
+		}
+	}
+	assert.True(t, fastSelected > 90)
+	assert.True(t, busySelected > 90)
+
+	assert.Equal(t, 0, len(new(LatencyPeerFilter).Filter(PeersSlice{})))
+	assert.Equal(t, 0, len(new(ScorePeerFilter).Filter(PeersSlice{})))
+}
+
+func TestDiversityPeerFilter(t *testing.T) {
+	// 12 peers in one /16, 4 peers in 4 other /16s.
+	peers := mockPeers(16, func(i int) string {
+		if i < 12 {
+			return fmt.Sprintf("/ip4/10.0.0.%d/tcp/8680", i)
+		}
+		return fmt.Sprintf("/ip4/10.%d.0.1/tcp/8680", i)
+	})
+
+	for i := 0; i < 10; i++ {
+		selected := new(DiversityPeerFilter).Filter(peers)
+		assert.Equal(t, 4, len(selected))
+		prefixes := make(map[string]bool)
+		for _, v := range selected {
+			prefixes[networkPrefix(v.(*Stream).addr)] = true
+		}
+		assert.Equal(t, 4, len(prefixes))
+	}
+
+	assert.Equal(t, 0, len(new(DiversityPeerFilter).Filter(PeersSlice{})))
+}
+
+func TestPeerFilterPolicy(t *testing.T) {
+	// all peers by default.
+	config := NewConfigFromDefaults()
+	assert.Equal(t, 0, len(config.PeerFilters))
+
+	config.PeerFilters["block"] = new(RandomPeerFilter)
+	config.PeerFilters["sync"] = new(ChainSyncPeersFilter)
+	config.PeerFilters["newcblock"] = new(AllPeersFilter)
+	sm := NewStreamManager(config)
+	node := &Node{config: config}
+	for i := 0; i < 16; i++ {
+		stream := newStreamInstance(peer.ID(fmt.Sprintf("peer%d", i)), nil, nil, node)
+		stream.status = streamStatusHandshakeSucceed
+		if i%2 == 0 {
+			stream.setCapabilities([]string{"cblock"})
+		}
+		sm.allStreams.Store(stream.pid.Pretty(), stream)
+	}
+	sent := func() int {
+		count := 0
+		sm.allStreams.Range(func(key, value interface{}) bool {
+			stream := value.(*Stream)
+			for len(stream.highPriorityMessageChan) > 0 {
+				<-stream.highPriorityMessageChan
+				count++
+			}
+			return true
+		})
+		return count
+	}
+
+	// the policies are registered by the message owners, the message types override them.
+	assert.Nil(t, sm.peerFilter("newblock"))
+	sm.RegisterPeerFilterPolicy("block", "newblock", "newcblock")
+	sm.RegisterPeerFilterPolicy("sync", "sync")
+	assert.IsType(t, new(RandomPeerFilter), sm.peerFilter("newblock"))
+	assert.IsType(t, new(AllPeersFilter), sm.peerFilter("newcblock"))
+	assert.Nil(t, sm.peerFilter("newtx"))
+
+	sm.sendMessageToFilteredPeers("newtx", []byte("tx"), MessagePriorityHigh)
+	assert.Equal(t, 16, sent())
+	sm.sendMessageToFilteredPeers("newblock", []byte("block"), MessagePriorityHigh)
+	assert.Equal(t, 1, sent())
+
+	// the configured filter narrows the one of the caller.
+	assert.Equal(t, 16, len(sm.SendMessageToPeers("getheaders", nil, MessagePriorityHigh, new(AllPeersFilter))))
+	assert.Equal(t, 2, len(sm.SendMessageToPeers("sync", nil, MessagePriorityHigh, new(ChainSyncPeersFilter))))
+	sent()
+
+	// the peers without the capability get the fallback.
+	sm.BroadcastMessageByCapability("cblock", "newcblock", &simPeerInfo{id: "compact"}, "newblock", &simPeerInfo{id: "full"}, MessagePriorityHigh)
+	assert.Equal(t, 8+1, sent())
+}
@@ -0,0 +1,432 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// SimNetwork is an in-process network connecting SimServices,
+// with configurable latency, loss and partitions. It is used to run
+// multiple nodes in ordinary go tests without opening real ports.
+type SimNetwork struct {
+	mu         sync.RWMutex
+	rand       *rand.Rand
+	services   map[string]*SimService
+	links      map[string]*simLink
+	closed     bool
+	closedPeer map[string]bool
+	partitions map[string]int
+	latency    time.Duration
+	jitter     time.Duration
+	loss       float64
+}
+
+// simLink delivers the messages from a service to another in order.
+type simLink struct {
+	msgCh         chan *simMessage
+	quitCh        chan bool
+	lastDeliverAt time.Time
+}
+
+type simMessage struct {
+	to        *SimService
+	msg       Message
+	deliverAt time.Time
+}
+
+// NewSimNetwork return a simulated network, the seed makes loss and jitter reproducible.
+func NewSimNetwork(seed int64) *SimNetwork {
+	return &SimNetwork{
+		rand:       rand.New(rand.NewSource(seed)),
+		services:   make(map[string]*SimService),
+		links:      make(map[string]*simLink),
+		closedPeer: make(map[string]bool),
+		partitions: make(map[string]int),
+	}
+}
+
+// SetLatency set the one-way latency of every message, plus a random jitter in [0, jitter).
+func (sn *SimNetwork) SetLatency(latency, jitter time.Duration) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.latency = latency
+	sn.jitter = jitter
+}
+
+// SetLoss set the rate of messages dropped silently, in [0, 1].
+func (sn *SimNetwork) SetLoss(rate float64) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.loss = rate
+}
+
+// Partition split the network, the services in different groups cannot reach each other.
+// The services not in any group form a group of their own.
+func (sn *SimNetwork) Partition(groups ...[]string) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.partitions = make(map[string]int)
+	for idx, group := range groups {
+		for _, id := range group {
+			sn.partitions[id] = idx + 1
+		}
+	}
+}
+
+// Heal remove the partitions and reconnect the closed peers.
+func (sn *SimNetwork) Heal() {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.partitions = make(map[string]int)
+	sn.closedPeer = make(map[string]bool)
+}
+
+// NewService add a service with the given peer id to the network.
+func (sn *SimNetwork) NewService(id string) *SimService {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	s := &SimService{
+		id:           id,
+		network:      sn,
+		dispatcher:   NewDispatcher(),
+		capabilities: make(map[string]bool),
+	}
+	sn.services[id] = s
+	return s
+}
+
+// Close stop delivering messages, the queued messages are dropped.
+func (sn *SimNetwork) Close() {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.closed = true
+	for key, link := range sn.links {
+		close(link.quitCh)
+		delete(sn.links, key)
+	}
+}
+
+func linkKey(from, to string) string {
+	return from + "->" + to
+}
+
+func pairKey(a, b string) string {
+	if a > b {
+		a, b = b, a
+	}
+	return a + "<>" + b
+}
+
+// reachable must be called with the lock held.
+func (sn *SimNetwork) reachable(from, to string) bool {
+	if from == to {
+		return false
+	}
+	if _, ok := sn.services[to]; !ok {
+		return false
+	}
+	return !sn.closedPeer[pairKey(from, to)] && sn.partitions[from] == sn.partitions[to]
+}
+
+// peers return the ids of the services reachable from the given one, in random order.
+func (sn *SimNetwork) peers(from string) []string {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	ids := make([]string, 0, len(sn.services))
+	for id := range sn.services {
+		if sn.reachable(from, id) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	sn.rand.Shuffle(len(ids), func(i, j int) { ids[i], ids[j] = ids[j], ids[i] })
+	return ids
+}
+
+func (sn *SimNetwork) hasCapability(id, capability string) bool {
+	sn.mu.RLock()
+	s := sn.services[id]
+	sn.mu.RUnlock()
+
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.capabilities[capability]
+}
+
+func (sn *SimNetwork) closePeer(a, b string) {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	sn.closedPeer[pairKey(a, b)] = true
+}
+
+func (sn *SimNetwork) send(from, to, name string, data []byte) error {
+	sn.mu.Lock()
+	defer sn.mu.Unlock()
+
+	if sn.closed || !sn.reachable(from, to) {
+		return ErrPeerIsNotConnected
+	}
+	if sn.loss > 0 && sn.rand.Float64() < sn.loss {
+		return nil
+	}
+
+	delay := sn.latency
+	if sn.jitter > 0 {
+		delay += time.Duration(sn.rand.Int63n(int64(sn.jitter)))
+	}
+
+	key := linkKey(from, to)
+	link, ok := sn.links[key]
+	if !ok {
+		link = &simLink{
+			msgCh:  make(chan *simMessage, 65536),
+			quitCh: make(chan bool),
+		}
+		sn.links[key] = link
+		go link.loop()
+	}
+
+	// keep the messages of a link in order, as a stream does.
+	deliverAt := time.Now().Add(delay)
+	if deliverAt.Before(link.lastDeliverAt) {
+		deliverAt = link.lastDeliverAt
+	}
+	link.lastDeliverAt = deliverAt
+
+	copied := make([]byte, len(data))
+	copy(copied, data)
+	select {
+	case link.msgCh <- &simMessage{
+		to:        sn.services[to],
+		msg:       NewBaseMessage(name, from, copied),
+		deliverAt: deliverAt,
+	}:
+	default:
+		// the link is congested, drop the message.
+	}
+	return nil
+}
+
+func (link *simLink) loop() {
+	for {
+		select {
+		case <-link.quitCh:
+			return
+		case msg := <-link.msgCh:
+			if wait := time.Until(msg.deliverAt); wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-link.quitCh:
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+			select {
+			case <-link.quitCh:
+				return
+			default:
+				msg.to.receive(msg.msg)
+			}
+		}
+	}
+}
+
+// SimService implements Service on a SimNetwork.
+type SimService struct {
+	mu           sync.RWMutex
+	id           string
+	network      *SimNetwork
+	dispatcher   *Dispatcher
+	started      bool
+	capabilities map[string]bool
+}
+
+// ID return the peer id of the service.
+func (s *SimService) ID() string {
+	return s.id
+}
+
+// Start implements Service interface
+func (s *SimService) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.started {
+		s.dispatcher.Start()
+		s.started = true
+	}
+	return nil
+}
+
+// Stop implements Service interface
+func (s *SimService) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		s.dispatcher.Stop()
+		s.started = false
+	}
+}
+
+// Node implements Service interface, there is no p2p node in the simulated network.
+func (s *SimService) Node() *Node {
+	return nil
+}
+
+// Register implements Service interface
+func (s *SimService) Register(subscribers ...*Subscriber) {
+	s.dispatcher.Register(subscribers...)
+}
+
+// Deregister implements Service interface
+func (s *SimService) Deregister(subscribers ...*Subscriber) {
+	s.dispatcher.Deregister(subscribers...)
+}
+
+// PutMessage put a message to the dispatcher as if it was received from the network.
+func (s *SimService) PutMessage(msg Message) {
+	s.dispatcher.PutMessage(msg)
+}
+
+func (s *SimService) receive(msg Message) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.started {
+		s.dispatcher.PutMessage(msg)
+	}
+}
+
+// Broadcast implements Service interface
+func (s *SimService) Broadcast(name string, msg Serializable, priority int) {
+	s.sendToAll(name, msg)
+}
+
+// Relay implements Service interface
+func (s *SimService) Relay(name string, msg Serializable, priority int) {
+	s.sendToAll(name, msg)
+}
+
+// BroadcastByCapability implements Service interface
+func (s *SimService) BroadcastByCapability(capability string, name string, msg Serializable, fallbackName string, fallback Serializable, priority int) {
+	var data, fallbackData []byte
+	var err error
+	if msg != nil {
+		if data, err = marshalSerializable(msg); err != nil {
+			return
+		}
+	}
+	if fallback != nil {
+		if fallbackData, err = marshalSerializable(fallback); err != nil {
+			return
+		}
+	}
+	for _, id := range s.network.peers(s.id) {
+		if s.network.hasCapability(id, capability) {
+			if data != nil {
+				s.network.send(s.id, id, name, data)
+			}
+		} else if fallbackData != nil {
+			s.network.send(s.id, id, fallbackName, fallbackData)
+		}
+	}
+}
+
+// RegisterPeerFilterPolicy implements Service interface, the peer filters are not configured in the simulated network
+func (s *SimService) RegisterPeerFilterPolicy(policy string, messageNames ...string) {}
+
+// RegisterCapability implements Service interface
+func (s *SimService) RegisterCapability(capability string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.capabilities[capability] = true
+}
+
+func (s *SimService) sendToAll(name string, msg Serializable) {
+	data, err := marshalSerializable(msg)
+	if err != nil {
+		return
+	}
+	for _, id := range s.network.peers(s.id) {
+		s.network.send(s.id, id, name, data)
+	}
+}
+
+func marshalSerializable(msg Serializable) ([]byte, error) {
+	pb, err := msg.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(pb)
+}
+
+// SendMsg implements Service interface
+func (s *SimService) SendMsg(name string, data []byte, target string, priority int) error {
+	return s.network.send(s.id, target, name, data)
+}
+
+// SendMessageToPeers implements Service interface, the filter is applied on the peer ids
+func (s *SimService) SendMessageToPeers(messageName string, data []byte, priority int, filter PeerFilterAlgorithm) []string {
+	peers := make(PeersSlice, 0)
+	for _, id := range s.network.peers(s.id) {
+		peers = append(peers, id)
+	}
+
+	selected := make([]string, 0)
+	for _, v := range filter.Filter(peers) {
+		id := v.(string)
+		if err := s.network.send(s.id, id, messageName, data); err == nil {
+			selected = append(selected, id)
+		}
+	}
+	return selected
+}
+
+// SendMessageToPeer implements Service interface
+func (s *SimService) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
+	return s.network.send(s.id, peerID, messageName, data)
+}
+
+// ClosePeer implements Service interface, the peers stay disconnected until the network heals
+func (s *SimService) ClosePeer(peerID string, reason error) {
+	s.network.closePeer(s.id, peerID)
+}
+
+// BroadcastNetworkID implements Service interface
+func (s *SimService) BroadcastNetworkID([]byte) {}
@@ -0,0 +1,172 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package net
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	netpb "github.com/nebulasio/go-nebulas/net/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSimServices(t *testing.T, sn *SimNetwork, num int) ([]*SimService, []chan Message) {
+	services := make([]*SimService, num)
+	chs := make([]chan Message, num)
+	for i := 0; i < num; i++ {
+		services[i] = sn.NewService(fmt.Sprintf("node%d", i))
+		chs[i] = make(chan Message, 128)
+		services[i].Register(NewSubscriber(services[i], chs[i], false, "ping", MessageWeightZero))
+		assert.Nil(t, services[i].Start())
+	}
+	return services, chs
+}
+
+func receiveSimMessage(ch chan Message, timeout time.Duration) Message {
+	select {
+	case msg := <-ch:
+		return msg
+	case <-time.After(timeout):
+		return nil
+	}
+}
+
+func TestSimNetworkLatency(t *testing.T) {
+	sn := NewSimNetwork(1)
+	defer sn.Close()
+	sn.SetLatency(50*time.Millisecond, 0)
+	services, chs := newSimServices(t, sn, 2)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, services[0].SendMsg("ping", []byte{byte(i)}, "node1", MessagePriorityNormal))
+	}
+	for i := 0; i < 10; i++ {
+		msg := receiveSimMessage(chs[1], time.Second)
+		assert.NotNil(t, msg)
+		assert.Equal(t, "node0", msg.MessageFrom())
+		assert.Equal(t, []byte{byte(i)}, msg.Data())
+	}
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+
+	assert.Equal(t, ErrPeerIsNotConnected, services[0].SendMsg("ping", nil, "unknown", MessagePriorityNormal))
+	assert.Equal(t, ErrPeerIsNotConnected, services[0].SendMsg("ping", nil, "node0", MessagePriorityNormal))
+}
+
+func TestSimNetworkLoss(t *testing.T) {
+	sn := NewSimNetwork(1)
+	defer sn.Close()
+	sn.SetLoss(0.5)
+	services, chs := newSimServices(t, sn, 2)
+
+	for i := 0; i < 200; i++ {
+		assert.Nil(t, services[0].SendMsg("ping", nil, "node1", MessagePriorityNormal))
+	}
+	received := 0
+	for receiveSimMessage(chs[1], 100*time.Millisecond) != nil {
+		received++
+	}
+	assert.True(t, received > 50 && received < 150)
+}
+
+func TestSimNetworkPartition(t *testing.T) {
+	sn := NewSimNetwork(1)
+	defer sn.Close()
+	services, chs := newSimServices(t, sn, 4)
+
+	sn.Partition([]string{"node0", "node1"}, []string{"node2", "node3"})
+	selected := services[0].SendMessageToPeers("ping", nil, MessagePriorityNormal, new(AllPeersFilter))
+	assert.Equal(t, []string{"node1"}, selected)
+	assert.NotNil(t, receiveSimMessage(chs[1], time.Second))
+	assert.Equal(t, ErrPeerIsNotConnected, services[0].SendMsg("ping", nil, "node2", MessagePriorityNormal))
+	assert.Nil(t, receiveSimMessage(chs[2], 50*time.Millisecond))
+
+	// closed peers stay disconnected until the network heals.
+	sn.Heal()
+	services[0].ClosePeer("node3", nil)
+	assert.Equal(t, 2, len(services[0].SendMessageToPeers("ping", nil, MessagePriorityNormal, new(AllPeersFilter))))
+	assert.NotNil(t, receiveSimMessage(chs[1], time.Second))
+	assert.NotNil(t, receiveSimMessage(chs[2], time.Second))
+	assert.Equal(t, ErrPeerIsNotConnected, services[3].SendMsg("ping", nil, "node0", MessagePriorityNormal))
+	sn.Heal()
+	assert.Nil(t, services[3].SendMsg("ping", nil, "node0", MessagePriorityNormal))
+	assert.NotNil(t, receiveSimMessage(chs[0], time.Second))
+
+	// stopped services do not receive messages.
+	services[1].Stop()
+	assert.Nil(t, services[0].SendMsg("ping", nil, "node1", MessagePriorityNormal))
+	assert.Nil(t, receiveSimMessage(chs[1], 50*time.Millisecond))
+}
+
+func TestSimNetworkClose(t *testing.T) {
+	sn := NewSimNetwork(1)
+	sn.SetLatency(100*time.Millisecond, 0)
+	services, chs := newSimServices(t, sn, 2)
+
+	// the queued messages are dropped.
+	assert.Nil(t, services[0].SendMsg("ping", nil, "node1", MessagePriorityNormal))
+	sn.Close()
+	assert.Nil(t, receiveSimMessage(chs[1], 200*time.Millisecond))
+
+	// no message is sent after close.
+	assert.Equal(t, ErrPeerIsNotConnected, services[0].SendMsg("ping", nil, "node1", MessagePriorityNormal))
+	assert.Equal(t, 0, len(sn.links))
+	assert.Nil(t, receiveSimMessage(chs[1], 200*time.Millisecond))
+}
+
+type simPeerInfo struct {
+	id string
+}
+
+func (p *simPeerInfo) ToProto() (proto.Message, error) {
+	return &netpb.PeerInfo{Id: p.id}, nil
+}
+
+func (p *simPeerInfo) FromProto(msg proto.Message) error {
+	p.id = msg.(*netpb.PeerInfo).Id
+	return nil
+}
+
+func TestSimNetworkCapability(t *testing.T) {
+	sn := NewSimNetwork(1)
+	defer sn.Close()
+	services, chs := newSimServices(t, sn, 3)
+	for i, s := range services {
+		s.Register(NewSubscriber(s, chs[i], false, "pong", MessageWeightZero))
+	}
+	services[1].RegisterCapability("pong")
+
+	// the peers advertising the capability get the message, the others the fallback.
+	services[0].BroadcastByCapability("pong", "pong", &simPeerInfo{id: "new"}, "ping", &simPeerInfo{id: "old"}, MessagePriorityNormal)
+	msg := receiveSimMessage(chs[1], time.Second)
+	assert.NotNil(t, msg)
+	assert.Equal(t, "pong", msg.MessageType())
+	msg = receiveSimMessage(chs[2], time.Second)
+	assert.NotNil(t, msg)
+	assert.Equal(t, "ping", msg.MessageType())
+
+	// the nil message is not sent.
+	services[0].BroadcastByCapability("pong", "", nil, "ping", &simPeerInfo{id: "old"}, MessagePriorityNormal)
+	assert.Nil(t, receiveSimMessage(chs[1], 100*time.Millisecond))
+	msg = receiveSimMessage(chs[2], time.Second)
+	assert.NotNil(t, msg)
+	assert.Equal(t, "ping", msg.MessageType())
+}
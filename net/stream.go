@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p-core/helpers"
@@ -80,7 +81,13 @@ type Stream struct {
 	latestReadAt              int64
 	latestWriteAt             int64
 	msgCount                  map[string]int
+	msgTotal                  int64
+	usefulTotal               int64
+	pingAt                    int64
+	latency                   int64
+	outbound                  bool
 	reservedFlag              []byte
+	capabilities              map[string]bool
 }
 
 // NewStream return a new Stream
@@ -111,6 +118,7 @@ func newStreamInstance(pid peer.ID, addr ma.Multiaddr, stream network.Stream, no
 		latestWriteAt:             0,
 		msgCount:                  make(map[string]int),
 		reservedFlag:              DefaultReserved,
+		capabilities:              make(map[string]bool),
 	}
 }
 
@@ -135,6 +143,7 @@ func (s *Stream) Connect() error {
 	}
 	s.stream = stream
 	s.addr = stream.Conn().RemoteMultiaddr()
+	s.outbound = true
 
 	return nil
 }
@@ -188,6 +197,11 @@ func (s *Stream) SendMessage(messageName string, data []byte, priority int) erro
 	// metrics.
 	metricsPacketsOutByMessageName(messageName, message.Length())
 
+	// capture.
+	if s.node.netService != nil {
+		s.node.netService.dispatcher.RecordOutbound(s.pid.Pretty(), messageName, data)
+	}
+
 	// send to pool.
 	message.FlagSendMessageAt()
 
@@ -457,6 +471,7 @@ func (s *Stream) writeLoop() {
 func (s *Stream) handleMessage(message *NebMessage) error {
 	messageName := message.MessageName()
 	s.msgCount[messageName]++
+	atomic.AddInt64(&s.msgTotal, 1)
 
 	switch messageName {
 	case HELLO:
@@ -486,7 +501,9 @@ func (s *Stream) handleMessage(message *NebMessage) error {
 			}).Info("Handle message data occurs error.")
 			return err
 		}
-		s.node.netService.PutMessage(NewBaseMessage(message.MessageName(), s.pid.Pretty(), data))
+		if s.node.netService.dispatcher.putMessage(NewBaseMessage(message.MessageName(), s.pid.Pretty(), data)) {
+			atomic.AddInt64(&s.usefulTotal, 1)
+		}
 		// record recv message.
 		RecordRecvMessage(s, message.DataCheckSum())
 	}
@@ -542,7 +559,9 @@ func (s *Stream) Hello() error {
 	msg := &netpb.Hello{
 		NodeId:        s.NodeId(),
 		ClientVersion: ClientVersion,
+		Capabilities:  s.node.streamManager.Capabilities(),
 	}
+	atomic.StoreInt64(&s.pingAt, time.Now().UnixNano())
 	return s.WriteProtoMessage(HELLO, msg, ReservedCompressionClientFlag)
 }
 
@@ -567,6 +586,7 @@ func (s *Stream) onHello(message *NebMessage) error {
 	if (message.Reserved()[2] & ReservedCompressionClientFlag) > 0 {
 		s.reservedFlag = CurrentReserved
 	}
+	s.setCapabilities(msg.Capabilities)
 
 	// add to route table.
 	s.node.routeTable.AddPeerStream(s)
@@ -583,6 +603,7 @@ func (s *Stream) Ok() error {
 	resp := &netpb.OK{
 		NodeId:        s.NodeId(),
 		ClientVersion: ClientVersion,
+		Capabilities:  s.node.streamManager.Capabilities(),
 	}
 
 	return s.WriteProtoMessage(OK, resp, ReservedCompressionClientFlag)
@@ -608,6 +629,9 @@ func (s *Stream) onOk(message *NebMessage) error {
 	if (message.Reserved()[2] & ReservedCompressionClientFlag) > 0 {
 		s.reservedFlag = CurrentReserved
 	}
+	s.setCapabilities(msg.Capabilities)
+
+	s.updateLatency()
 
 	// add to route table.
 	s.node.routeTable.AddPeerStream(s)
@@ -620,6 +644,7 @@ func (s *Stream) onOk(message *NebMessage) error {
 
 // SyncRoute send sync route request
 func (s *Stream) SyncRoute() error {
+	atomic.StoreInt64(&s.pingAt, time.Now().UnixNano())
 	return s.SendMessage(SYNCROUTE, []byte{}, MessagePriorityHigh)
 }
 
@@ -632,10 +657,20 @@ func (s *Stream) RouteTable() error {
 	// get random peers from routeTable
 	peers := s.node.routeTable.GetRandomPeers(s.pid)
 
+	// announce the external addresses of this node, leaving room in the response.
+	externalAddrs := s.node.ExternalAddrs()
+	maxCount := s.node.routeTable.maxPeersCountForSyncResp
+	if len(externalAddrs) > 0 && maxCount > 0 && len(peers) >= maxCount {
+		peers = peers[:maxCount-1]
+	}
+
 	// prepare the protobuf message.
 	msg := &netpb.Peers{
 		Peers: make([]*netpb.PeerInfo, len(peers)),
 	}
+	if s.addr != nil {
+		msg.ObservedAddr = s.addr.String()
+	}
 
 	for i, v := range peers {
 		pi := &netpb.PeerInfo{
@@ -648,6 +683,17 @@ func (s *Stream) RouteTable() error {
 		msg.Peers[i] = pi
 	}
 
+	if len(externalAddrs) > 0 {
+		pi := &netpb.PeerInfo{
+			Id:    s.node.ID(),
+			Addrs: make([]string, len(externalAddrs)),
+		}
+		for i, addr := range externalAddrs {
+			pi.Addrs[i] = addr.String()
+		}
+		msg.Peers = append(msg.Peers, pi)
+	}
+
 	logging.VLog().WithFields(logrus.Fields{
 		"stream":          s.String(),
 		"routetableCount": len(peers),
@@ -670,11 +716,43 @@ func (s *Stream) onRouteTable(message *NebMessage) error {
 		return ErrShouldCloseConnectionAndExitLoop
 	}
 
+	s.updateLatency()
+
+	if len(peers.ObservedAddr) > 0 {
+		if addr, err := ma.NewMultiaddr(peers.ObservedAddr); err == nil {
+			s.node.natManager.Observe(s.pid.Pretty(), s.addr, s.outbound, addr)
+		}
+	}
+
 	s.node.routeTable.AddPeers(s.node.ID(), peers)
 
 	return nil
 }
 
+func (s *Stream) setCapabilities(capabilities []string) {
+	for _, v := range capabilities {
+		s.capabilities[v] = true
+	}
+}
+
+// HasCapability return whether the peer advertised the capability in the handshake.
+func (s *Stream) HasCapability(capability string) bool {
+	return s.capabilities[capability]
+}
+
+// Latency return the round trip time measured by the latest hello or sync route request
+func (s *Stream) Latency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&s.latency))
+}
+
+func (s *Stream) updateLatency() {
+	pingAt := atomic.SwapInt64(&s.pingAt, 0)
+	if pingAt == 0 {
+		return
+	}
+	atomic.StoreInt64(&s.latency, time.Now().UnixNano()-pingAt)
+}
+
 func (s *Stream) finishHandshake() {
 	logging.VLog().WithFields(logrus.Fields{
 		"stream": s.String(),
@@ -57,6 +57,9 @@ type StreamManager struct {
 	activePeersCount  int32
 	maxStreamNum      int32
 	reservedStreamNum int32
+	peerFilters       map[string]PeerFilterAlgorithm
+	peerFilterPolicy  *sync.Map
+	capabilities      []string
 }
 
 // NewStreamManager return a new stream manager
@@ -67,6 +70,8 @@ func NewStreamManager(config *Config) *StreamManager {
 		activePeersCount:  0,
 		maxStreamNum:      config.StreamLimits,
 		reservedStreamNum: config.ReservedStreamLimits,
+		peerFilters:       config.PeerFilters,
+		peerFilterPolicy:  new(sync.Map),
 	}
 }
 
@@ -213,15 +218,7 @@ func (sm *StreamManager) BroadcastMessage(messageName string, messageContent Ser
 		return
 	}
 
-	dataCheckSum := crc32.ChecksumIEEE(data)
-
-	sm.allStreams.Range(func(key, value interface{}) bool {
-		stream := value.(*Stream)
-		if stream.IsHandshakeSucceed() && !HasRecvMessage(stream, dataCheckSum) {
-			stream.SendMessage(messageName, data, priority)
-		}
-		return true
-	})
+	sm.sendMessageToFilteredPeers(messageName, data, priority)
 }
 
 // RelayMessage relay the message
@@ -232,18 +229,127 @@ func (sm *StreamManager) RelayMessage(messageName string, messageContent Seriali
 		return
 	}
 
-	dataCheckSum := crc32.ChecksumIEEE(data)
+	sm.sendMessageToFilteredPeers(messageName, data, priority)
+}
 
+// BroadcastMessageByCapability send the message to the peers advertising the capability,
+// and the fallback message to the others, a nil message is not sent
+func (sm *StreamManager) BroadcastMessageByCapability(capability string, messageName string, messageContent Serializable,
+	fallbackName string, fallbackContent Serializable, priority int) {
+	var data, fallbackData []byte
+	checkSums := []uint32{}
+	if messageContent != nil {
+		pb, _ := messageContent.ToProto()
+		bytes, err := proto.Marshal(pb)
+		if err != nil {
+			return
+		}
+		data = bytes
+		checkSums = append(checkSums, crc32.ChecksumIEEE(data))
+	}
+	if fallbackContent != nil {
+		pb, _ := fallbackContent.ToProto()
+		bytes, err := proto.Marshal(pb)
+		if err != nil {
+			return
+		}
+		fallbackData = bytes
+		checkSums = append(checkSums, crc32.ChecksumIEEE(fallbackData))
+	}
+
+	capable, fallbackPeers := make(PeersSlice, 0), make(PeersSlice, 0)
+	for _, v := range sm.peersNotSent(checkSums...) {
+		if v.(*Stream).HasCapability(capability) {
+			capable = append(capable, v)
+		} else {
+			fallbackPeers = append(fallbackPeers, v)
+		}
+	}
+	if data != nil {
+		sm.sendMessageToPeers(messageName, data, priority, capable)
+	}
+	if fallbackData != nil {
+		sm.sendMessageToPeers(fallbackName, fallbackData, priority, fallbackPeers)
+	}
+}
+
+// sendMessageToFilteredPeers send the message to the peers which have not sent it to us,
+// filtered by the peer filter configured for the message type
+func (sm *StreamManager) sendMessageToFilteredPeers(messageName string, data []byte, priority int) {
+	sm.sendMessageToPeers(messageName, data, priority, sm.peersNotSent(crc32.ChecksumIEEE(data)))
+}
+
+// peersNotSent return the handshaked peers which have sent us none of the messages
+func (sm *StreamManager) peersNotSent(dataCheckSums ...uint32) PeersSlice {
+	peers := make(PeersSlice, 0)
 	sm.allStreams.Range(func(key, value interface{}) bool {
 		stream := value.(*Stream)
-		if stream.IsHandshakeSucceed() && !HasRecvMessage(stream, dataCheckSum) {
-			stream.SendMessage(messageName, data, priority)
+		if !stream.IsHandshakeSucceed() {
+			return true
 		}
+		for _, dataCheckSum := range dataCheckSums {
+			if HasRecvMessage(stream, dataCheckSum) {
+				return true
+			}
+		}
+		peers = append(peers, value)
 		return true
 	})
+	return peers
+}
+
+// sendMessageToPeers send the message to the peers filtered by the peer filter configured for the message type
+func (sm *StreamManager) sendMessageToPeers(messageName string, data []byte, priority int, peers PeersSlice) {
+	if filter := sm.peerFilter(messageName); filter != nil {
+		peers = filter.Filter(peers)
+	}
+
+	for _, v := range peers {
+		v.(*Stream).SendMessage(messageName, data, priority)
+	}
+}
+
+// RegisterPeerFilterPolicy name the message types whose peer filter is configured by the policy
+func (sm *StreamManager) RegisterPeerFilterPolicy(policy string, messageNames ...string) {
+	for _, v := range messageNames {
+		sm.peerFilterPolicy.Store(v, policy)
+	}
 }
 
-// SendMessageToPeers send the message to the peers filtered by the filter algorithm
+// peerFilter return the peer filter configured for the message type or its policy, nil to keep all the peers
+func (sm *StreamManager) peerFilter(messageName string) PeerFilterAlgorithm {
+	if filter, ok := sm.peerFilters[messageName]; ok {
+		return filter
+	}
+	if policy, ok := sm.peerFilterPolicy.Load(messageName); ok {
+		return sm.peerFilters[policy.(string)]
+	}
+	return nil
+}
+
+// RegisterCapability advertise the capability to the peers in the handshakes
+func (sm *StreamManager) RegisterCapability(capability string) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for _, v := range sm.capabilities {
+		if v == capability {
+			return
+		}
+	}
+	sm.capabilities = append(sm.capabilities, capability)
+}
+
+// Capabilities return the capabilities advertised to the peers
+func (sm *StreamManager) Capabilities() []string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return append([]string{}, sm.capabilities...)
+}
+
+// SendMessageToPeers send the message to the peers filtered by the filter algorithm,
+// the peer filter configured for the message type narrows the selection further
 func (sm *StreamManager) SendMessageToPeers(messageName string, data []byte, priority int, filter PeerFilterAlgorithm) []string {
 	allPeers := make(PeersSlice, 0)
 
@@ -256,6 +362,9 @@ func (sm *StreamManager) SendMessageToPeers(messageName string, data []byte, pri
 	})
 
 	selectedPeers := filter.Filter(allPeers)
+	if configured := sm.peerFilter(messageName); configured != nil {
+		selectedPeers = configured.Filter(selectedPeers)
+	}
 	selectedPeersPrettyID := make([]string, 0)
 
 	for _, v := range selectedPeers {
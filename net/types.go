@@ -36,10 +36,28 @@ const (
 
 // Sync Message Type
 const (
-	ChunkHeadersRequest  = "sync"      // ChainSync
-	ChunkHeadersResponse = "chunks"    // ChainChunks
-	ChunkDataRequest     = "getchunk"  // ChainGetChunk
-	ChunkDataResponse    = "chunkdata" // ChainChunkData
+	ChunkHeadersRequest  = "sync"       // ChainSync
+	ChunkHeadersResponse = "chunks"     // ChainChunks
+	ChunkDataRequest     = "getchunk"   // ChainGetChunk
+	ChunkDataResponse    = "chunkdata"  // ChainChunkData
+	BlockHeadersRequest  = "getheaders" // ChainGetHeaders
+	BlockHeadersResponse = "headers"    // ChainHeaders
+	BlockBodiesRequest   = "getbodies"  // ChainGetBodies
+	BlockBodiesResponse  = "bodies"     // ChainBodies
+	StatePivotRequest    = "getpivot"   // ChainGetPivot
+	StatePivotResponse   = "pivot"      // ChainPivot
+	TrieNodesRequest     = "getnodes"   // ChainGetNodes
+	TrieNodesResponse    = "nodes"      // ChainNodes
+)
+
+// Light Client Message Type
+const (
+	LightHeadersRequest  = "lgetheaders" // LightGetHeaders
+	LightHeadersResponse = "lheaders"    // LightHeaders
+	LightProofRequest    = "lgetproof"   // LightGetProof
+	LightProofResponse   = "lproof"      // LightProof
+	LightDynastyRequest  = "lgetdynasty" // LightGetDynasty
+	LightDynastyResponse = "ldynasty"    // LightDynasty
 )
 
 // Sync Errors
@@ -47,6 +65,11 @@ var (
 	ErrPeersIsNotEnough = errors.New("peers is not enough")
 )
 
+// Peer Filter Errors
+var (
+	ErrUnknownPeerFilter = errors.New("unknown peer filter")
+)
+
 // MessageType a string for message type.
 type MessageType string
 
@@ -86,6 +109,14 @@ type Service interface {
 	Relay(string, Serializable, int)
 	SendMsg(string, []byte, string, int) error
 
+	// RegisterCapability advertise an optional message support to the peers in the handshakes,
+	// BroadcastByCapability send the message to the peers advertising the capability and the fallback to the others,
+	// a nil message is not sent.
+	RegisterCapability(capability string)
+	BroadcastByCapability(capability string, name string, msg Serializable, fallbackName string, fallback Serializable, priority int)
+
+	// RegisterPeerFilterPolicy name the message types fanned out by a policy of the network.peer_filters config.
+	RegisterPeerFilterPolicy(policy string, messageNames ...string)
 	SendMessageToPeers(messageName string, data []byte, priority int, filter PeerFilterAlgorithm) []string
 	SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error
 
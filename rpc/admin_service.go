@@ -19,6 +19,7 @@
 package rpc
 
 import (
+	"errors"
 	"sync"
 	"time"
 
@@ -278,6 +279,41 @@ func (s *AdminService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest
 	return resp, nil
 }
 
+// MineBlocks is the RPC API handler.
+func (s *AdminService) MineBlocks(ctx context.Context, req *rpcpb.MineBlocksRequest) (*rpcpb.MineBlocksResponse, error) {
+	neb := s.server.Neblet()
+
+	sealer, ok := neb.Consensus().(core.InstantSealer)
+	if !ok {
+		return nil, errors.New("consensus doesn't seal blocks on demand")
+	}
+	blocks, err := sealer.MineBlocks(int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.MineBlocksResponse{Height: neb.BlockChain().TailBlock().Height()}
+	for _, block := range blocks {
+		resp.Hashes = append(resp.Hashes, block.Hash().String())
+	}
+	return resp, nil
+}
+
+// AdvanceTime is the RPC API handler.
+func (s *AdminService) AdvanceTime(ctx context.Context, req *rpcpb.AdvanceTimeRequest) (*rpcpb.AdvanceTimeResponse, error) {
+	neb := s.server.Neblet()
+
+	sealer, ok := neb.Consensus().(core.InstantSealer)
+	if !ok {
+		return nil, errors.New("consensus doesn't seal blocks on demand")
+	}
+	timestamp, err := sealer.AdvanceTime(req.Seconds)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.AdvanceTimeResponse{Timestamp: timestamp}, nil
+}
+
 func (s *AdminService) autoGenNonceForZeroNonceTransaction(tx *core.Transaction) error {
 	neb := s.server.Neblet()
 	pool := neb.BlockChain().TransactionPool()
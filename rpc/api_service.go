@@ -43,6 +43,9 @@ import (
 //the max number of block can be dumped once
 const maxDumpBlockCount = 10
 
+//the max number of serials can be measured once
+const maxPerformanceSerials = 16
+
 // APIService implements the RPC API service interface.
 type APIService struct {
 	server GRPCServer
@@ -68,6 +71,25 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 	return resp, nil
 }
 
+// GetSyncProgress is the RPC API handler.
+func (s *APIService) GetSyncProgress(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.SyncProgressResponse, error) {
+
+	neb := s.server.Neblet()
+
+	progress := neb.BlockChain().SyncProgress()
+	return &rpcpb.SyncProgressResponse{
+		Syncing:        progress.Syncing,
+		StartingHeight: progress.StartingHeight,
+		CurrentHeight:  progress.CurrentHeight,
+		HighestHeight:  progress.HighestHeight,
+		PendingChunks:  uint32(progress.PendingChunks),
+		InflightChunks: uint32(progress.InflightChunks),
+		DownloadRate:   progress.DownloadRate,
+		ExecutionRate:  progress.ExecutionRate,
+		Eta:            progress.ETA,
+	}, nil
+}
+
 // GetAccountState is the RPC API handler.
 func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateResponse, error) {
 
@@ -666,6 +688,224 @@ func (s *APIService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightReq
 	return &rpcpb.GetDynastyResponse{Miners: result}, nil
 }
 
+// GetMinerPerformance is the RPC API handler.
+func (s *APIService) GetMinerPerformance(ctx context.Context, req *rpcpb.MinerPerformanceRequest) (*rpcpb.MinerPerformanceResponse, error) {
+	neb := s.server.Neblet()
+
+	reporter, ok := neb.Consensus().(core.PerformanceReporter)
+	if !ok {
+		return nil, errors.New("consensus doesn't report the miners performance")
+	}
+
+	serial := req.Serial
+	if serial == 0 {
+		serial = neb.Consensus().Serial(neb.BlockChain().TailBlock().Timestamp())
+	}
+	count := int64(req.Count)
+	if count == 0 {
+		count = 1
+	}
+	if count > maxPerformanceSerials {
+		return nil, errors.New("too many serials to measure")
+	}
+
+	result := []*rpcpb.DynastyPerformance{}
+	for i := serial; i > serial-count && i >= 0; i-- {
+		perf, err := reporter.DynastyPerformance(i)
+		if err != nil {
+			return nil, err
+		}
+		dynasty := &rpcpb.DynastyPerformance{
+			Serial:            perf.Serial,
+			StartHeight:       perf.StartHeight,
+			EndHeight:         perf.EndHeight,
+			WitnessesRecorded: perf.WitnessesRecorded,
+		}
+		for _, v := range perf.Miners {
+			dynasty.Miners = append(dynasty.Miners, &rpcpb.MinerPerformance{
+				Miner:           v.Miner,
+				Expected:        uint32(v.Expected),
+				Produced:        uint32(v.Produced),
+				Missed:          uint32(v.Missed),
+				HeartbeatSerial: v.HeartbeatSerial,
+				Score:           v.Score,
+				Witnesses:       uint32(v.Witnesses),
+				Reports:         uint32(v.Reports),
+			})
+		}
+		result = append(result, dynasty)
+	}
+	return &rpcpb.MinerPerformanceResponse{Dynasties: result}, nil
+}
+
+// GetReorgHistory is the RPC API handler.
+func (s *APIService) GetReorgHistory(ctx context.Context, req *rpcpb.ReorgHistoryRequest) (*rpcpb.ReorgHistoryResponse, error) {
+	neb := s.server.Neblet()
+
+	reorgs, err := neb.BlockChain().ReorgHistory(int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*rpcpb.Reorg{}
+	for _, v := range reorgs {
+		result = append(result, &rpcpb.Reorg{
+			OldTail:     toRPCReorgBlock(v.OldTail),
+			NewTail:     toRPCReorgBlock(v.NewTail),
+			Ancestor:    toRPCReorgBlock(v.Ancestor),
+			Depth:       v.Depth,
+			Applied:     v.Applied,
+			RevertedTxs: v.RevertedTxs,
+			AppliedTxs:  v.AppliedTxs,
+			Timestamp:   v.Timestamp,
+		})
+	}
+	return &rpcpb.ReorgHistoryResponse{Reorgs: result}, nil
+}
+
+// GetEvidence is the RPC API handler.
+func (s *APIService) GetEvidence(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.EvidenceResponse, error) {
+	neb := s.server.Neblet()
+
+	reporter, ok := neb.Consensus().(core.EvidenceReporter)
+	if !ok {
+		return nil, errors.New("consensus doesn't keep the evidence")
+	}
+	return &rpcpb.EvidenceResponse{
+		Pending:   toRPCEvidence(reporter.PendingEvidence()),
+		Committed: toRPCEvidence(reporter.CommittedEvidence()),
+	}, nil
+}
+
+// GetFinalityCertificate is the RPC API handler.
+func (s *APIService) GetFinalityCertificate(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.FinalityCertificateResponse, error) {
+	neb := s.server.Neblet()
+
+	certifier, ok := neb.Consensus().(core.FinalityCertifier)
+	if !ok {
+		return nil, errors.New("consensus doesn't certify the finality")
+	}
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := certifier.FinalityCertificate(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.FinalityCertificateResponse{
+		Hash:            cert.Hash,
+		Height:          cert.Height,
+		CertifiedHash:   cert.CertifiedHash,
+		CertifiedHeight: cert.CertifiedHeight,
+	}
+	for _, v := range cert.Witnesses {
+		resp.Witnesses = append(resp.Witnesses, &rpcpb.AggregatedWitness{
+			Blocks:  v.Blocks,
+			Serial:  v.Serial,
+			Bitmap:  v.Bitmap,
+			Signers: v.Signers,
+			Signs:   v.Signs,
+		})
+	}
+	return resp, nil
+}
+
+// GetConsensusState is the RPC API handler.
+func (s *APIService) GetConsensusState(ctx context.Context, req *rpcpb.ConsensusStateRequest) (*rpcpb.ConsensusStateResponse, error) {
+	neb := s.server.Neblet()
+
+	historian, ok := neb.Consensus().(core.ConsensusHistorian)
+	if !ok {
+		return nil, errors.New("consensus doesn't keep the state history")
+	}
+
+	height := req.Height
+	if height == 0 {
+		height = neb.BlockChain().TailBlock().Height()
+		if req.Serial > 0 {
+			var err error
+			if height, err = historian.SerialStartHeight(req.Serial); err != nil {
+				return nil, err
+			}
+		}
+	}
+	snapshot, err := historian.ConsensusSnapshot(height)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.ConsensusStateResponse{
+		Height:       snapshot.Height,
+		Hash:         snapshot.Hash,
+		Timestamp:    snapshot.Timestamp,
+		Serial:       snapshot.Serial,
+		DynastyRoot:  snapshot.DynastyRoot,
+		Dynasty:      snapshot.Dynasty,
+		Candidates:   toRPCPodNodes(snapshot.Candidates),
+		Participants: toRPCPodNodes(snapshot.Participants),
+	}, nil
+}
+
+// GetProposerSchedule is the RPC API handler.
+func (s *APIService) GetProposerSchedule(ctx context.Context, req *rpcpb.ProposerScheduleRequest) (*rpcpb.ProposerScheduleResponse, error) {
+	neb := s.server.Neblet()
+
+	historian, ok := neb.Consensus().(core.ConsensusHistorian)
+	if !ok {
+		return nil, errors.New("consensus doesn't schedule the proposers")
+	}
+	schedule, err := historian.ProposerSchedule(int(req.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.ProposerScheduleResponse{}
+	for _, v := range schedule {
+		resp.Slots = append(resp.Slots, &rpcpb.ProposerSlot{
+			Timestamp: v.Timestamp,
+			Serial:    v.Serial,
+			Proposer:  v.Proposer,
+		})
+	}
+	return resp, nil
+}
+
+func toRPCPodNodes(nodes []*core.NodeInfo) []*rpcpb.PodNode {
+	result := []*rpcpb.PodNode{}
+	for _, v := range nodes {
+		result = append(result, &rpcpb.PodNode{
+			Id:              v.Id,
+			HeartbeatSerial: v.HeartbeatSerial,
+			Miner:           v.Miner,
+			Score:           v.Score,
+		})
+	}
+	return result
+}
+
+func toRPCEvidence(evidence []*core.Evidence) []*rpcpb.Evidence {
+	result := []*rpcpb.Evidence{}
+	for _, v := range evidence {
+		result = append(result, &rpcpb.Evidence{
+			Miner:     v.Miner,
+			Timestamp: v.Timestamp,
+			Evil:      v.Evil,
+			Blocks:    v.Blocks,
+			Height:    v.Height,
+			Reporter:  v.Reporter,
+		})
+	}
+	return result
+}
+
+func toRPCReorgBlock(block *core.ReorgBlock) *rpcpb.ReorgBlock {
+	return &rpcpb.ReorgBlock{
+		Height: block.Height,
+		Hash:   block.Hash,
+	}
+}
+
 //verify signature.
 func (s *APIService) VerifySignature(ctx context.Context, req *rpcpb.VerifySignatureRequest) (*rpcpb.VerifySignatureResponse, error) {
 
@@ -0,0 +1,208 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	rpcpb "github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/net/context"
+)
+
+// LightNeblet is a neblet running in the light client mode.
+type LightNeblet interface {
+	LightClient() core.LightClient
+}
+
+// LightAPIService implements the subset of the RPC API service a light client serves,
+// the headers are verified when followed and the account states with merkle proofs.
+type LightAPIService struct {
+	rpcpb.UnimplementedApiServiceServer
+	server GRPCServer
+}
+
+func (s *LightAPIService) client() (core.LightClient, error) {
+	neb, ok := s.server.Neblet().(LightNeblet)
+	if !ok || neb.LightClient() == nil {
+		return nil, errors.New("light client is not running")
+	}
+	return neb.LightClient(), nil
+}
+
+// GetNebState is the RPC API handler.
+func (s *LightAPIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetNebStateResponse, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	tail := client.Tail()
+	if tail == nil {
+		return nil, errors.New("block not found")
+	}
+
+	return &rpcpb.GetNebStateResponse{
+		ChainId:         client.ChainID(),
+		Tail:            tail.Hash().String(),
+		Height:          tail.Height(),
+		Synchronized:    true,
+		ProtocolVersion: net.NebProtocolID,
+		Version:         s.server.Neblet().Config().App.Version,
+	}, nil
+}
+
+// GetAccountState is the RPC API handler.
+func (s *LightAPIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateResponse, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		metricsAccountStateFailed.Mark(1)
+		return nil, err
+	}
+
+	acc, header, err := client.GetAccount(addr.Bytes(), req.Height)
+	if err != nil {
+		metricsAccountStateFailed.Mark(1)
+		return nil, err
+	}
+	balance := util.NewUint128()
+	if len(acc.Balance) > 0 {
+		if balance, err = util.NewUint128FromFixedSizeByteSlice(acc.Balance); err != nil {
+			return nil, err
+		}
+	}
+
+	metricsAccountStateSuccess.Mark(1)
+	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: acc.Nonce, Type: uint32(addr.Type()), Height: header.Height()}, nil
+}
+
+// SendRawTransaction checks the nonce with the verified account state and broadcasts the transaction.
+func (s *LightAPIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(req.GetData(), pbTx); err != nil {
+		metricsSendTxFailed.Mark(1)
+		return nil, err
+	}
+	tx := new(core.Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		metricsSendTxFailed.Mark(1)
+		return nil, err
+	}
+	if err := tx.VerifyIntegrity(client.ChainID()); err != nil {
+		metricsSendTxFailed.Mark(1)
+		return nil, err
+	}
+
+	acc, _, err := client.GetAccount(tx.From().Bytes(), 0)
+	if err != nil {
+		metricsSendTxFailed.Mark(1)
+		return nil, err
+	}
+	if tx.Nonce() <= acc.Nonce {
+		metricsSendTxFailed.Mark(1)
+		return nil, errors.New("transaction's nonce is invalid, should bigger than the from's nonce")
+	}
+
+	var contract string
+	if tx.Type() == core.TxPayloadDeployType {
+		if !tx.From().Equals(tx.To()) {
+			metricsSendTxFailed.Mark(1)
+			return nil, core.ErrContractTransactionAddressNotEqual
+		}
+		addr, err := core.NewContractAddressFromData(tx.From().Bytes(), byteutils.FromUint64(tx.Nonce()))
+		if err != nil {
+			metricsSendTxFailed.Mark(1)
+			return nil, err
+		}
+		contract = addr.String()
+	}
+
+	s.server.Neblet().NetService().Broadcast(core.MessageTypeNewTx, tx, net.MessagePriorityNormal)
+
+	metricsSendTxSuccess.Mark(1)
+	return &rpcpb.SendTransactionResponse{Txhash: tx.Hash().String(), ContractAddress: contract}, nil
+}
+
+// GetBlockByHash return the verified header of the hash, without the transactions.
+func (s *LightAPIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	bhash, err := byteutils.FromHex(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	// only the headers on the chain are served.
+	header := client.GetHeaderByHash(bhash)
+	if header != nil {
+		header = client.GetHeaderByHeight(header.Height())
+	}
+	if header == nil || !header.Hash().Equals(bhash) {
+		return nil, errors.New("block not found")
+	}
+	return toHeaderResponse(header), nil
+}
+
+// GetBlockByHeight return the verified header at the height, without the transactions.
+func (s *LightAPIService) GetBlockByHeight(ctx context.Context, req *rpcpb.GetBlockByHeightRequest) (*rpcpb.BlockResponse, error) {
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	header := client.GetHeaderByHeight(req.Height)
+	if header == nil {
+		return nil, errors.New("block not found")
+	}
+	return toHeaderResponse(header), nil
+}
+
+func toHeaderResponse(header *core.CompactBlock) *rpcpb.BlockResponse {
+	resp := &rpcpb.BlockResponse{
+		Hash:          header.Hash().String(),
+		ParentHash:    header.ParentHash().String(),
+		Height:        header.Height(),
+		Timestamp:     header.Timestamp(),
+		ChainId:       header.ChainID(),
+		StateRoot:     header.StateRoot().String(),
+		TxsRoot:       header.TxsRoot().String(),
+		EventsRoot:    header.EventsRoot().String(),
+		ConsensusRoot: header.ConsensusRoot(),
+	}
+	if header.Coinbase() != nil {
+		resp.Coinbase = header.Coinbase().String()
+	}
+	if header.ConsensusRoot() != nil {
+		resp.Miner = byteutils.Hash(header.ConsensusRoot().Proposer).Base58()
+	}
+	return resp
+}
@@ -387,6 +387,118 @@ func (m *GetNebStateResponse) GetVersion() string {
 	return ""
 }
 
+// Response message of GetSyncProgress rpc.
+type SyncProgressResponse struct {
+	// Whether an active sync task is running
+	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// The tail height when the sync task started
+	StartingHeight uint64 `protobuf:"varint,2,opt,name=starting_height,json=startingHeight,proto3" json:"starting_height,omitempty"`
+	// Current neb tail block height
+	CurrentHeight uint64 `protobuf:"varint,3,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// The highest block height known from peers
+	HighestHeight uint64 `protobuf:"varint,4,opt,name=highest_height,json=highestHeight,proto3" json:"highest_height,omitempty"`
+	// Count of chunks waiting to be requested
+	PendingChunks uint32 `protobuf:"varint,5,opt,name=pending_chunks,json=pendingChunks,proto3" json:"pending_chunks,omitempty"`
+	// Count of chunks being downloaded
+	InflightChunks uint32 `protobuf:"varint,6,opt,name=inflight_chunks,json=inflightChunks,proto3" json:"inflight_chunks,omitempty"`
+	// Downloaded blocks per second
+	DownloadRate float64 `protobuf:"fixed64,7,opt,name=download_rate,json=downloadRate,proto3" json:"download_rate,omitempty"`
+	// Executed blocks per second
+	ExecutionRate float64 `protobuf:"fixed64,8,opt,name=execution_rate,json=executionRate,proto3" json:"execution_rate,omitempty"`
+	// Estimated seconds to finish the sync, 0 if unknown
+	Eta                  int64    `protobuf:"varint,9,opt,name=eta,proto3" json:"eta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncProgressResponse) Reset()         { *m = SyncProgressResponse{} }
+func (m *SyncProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncProgressResponse) ProtoMessage()    {}
+func (*SyncProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+}
+func (m *SyncProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncProgressResponse.Unmarshal(m, b)
+}
+func (m *SyncProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncProgressResponse.Merge(m, src)
+}
+func (m *SyncProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncProgressResponse.Size(m)
+}
+func (m *SyncProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncProgressResponse proto.InternalMessageInfo
+
+func (m *SyncProgressResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncProgressResponse) GetStartingHeight() uint64 {
+	if m != nil {
+		return m.StartingHeight
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetHighestHeight() uint64 {
+	if m != nil {
+		return m.HighestHeight
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetPendingChunks() uint32 {
+	if m != nil {
+		return m.PendingChunks
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetInflightChunks() uint32 {
+	if m != nil {
+		return m.InflightChunks
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetDownloadRate() float64 {
+	if m != nil {
+		return m.DownloadRate
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetExecutionRate() float64 {
+	if m != nil {
+		return m.ExecutionRate
+	}
+	return 0
+}
+
+func (m *SyncProgressResponse) GetEta() int64 {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
 // Response message of Accounts rpc.
 type AccountsResponse struct {
 	// Account list
@@ -400,7 +512,7 @@ func (m *AccountsResponse) Reset()         { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()    {}
 func (*AccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+	return fileDescriptor_77a6da22d6a3feb1, []int{7}
 }
 func (m *AccountsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountsResponse.Unmarshal(m, b)
@@ -442,7 +554,7 @@ func (m *GetAccountStateRequest) Reset()         { *m = GetAccountStateRequest{}
 func (m *GetAccountStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountStateRequest) ProtoMessage()    {}
 func (*GetAccountStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{7}
+	return fileDescriptor_77a6da22d6a3feb1, []int{8}
 }
 func (m *GetAccountStateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountStateRequest.Unmarshal(m, b)
@@ -497,7 +609,7 @@ func (m *GetAccountStateResponse) Reset()         { *m = GetAccountStateResponse
 func (m *GetAccountStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountStateResponse) ProtoMessage()    {}
 func (*GetAccountStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{8}
+	return fileDescriptor_77a6da22d6a3feb1, []int{9}
 }
 func (m *GetAccountStateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAccountStateResponse.Unmarshal(m, b)
@@ -569,7 +681,7 @@ func (m *CallResponse) Reset()         { *m = CallResponse{} }
 func (m *CallResponse) String() string { return proto.CompactTextString(m) }
 func (*CallResponse) ProtoMessage()    {}
 func (*CallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{9}
+	return fileDescriptor_77a6da22d6a3feb1, []int{10}
 }
 func (m *CallResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CallResponse.Unmarshal(m, b)
@@ -589,103 +701,1189 @@ func (m *CallResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CallResponse proto.InternalMessageInfo
 
-func (m *CallResponse) GetResult() string {
+func (m *CallResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *CallResponse) GetExecuteErr() string {
+	if m != nil {
+		return m.ExecuteErr
+	}
+	return ""
+}
+
+func (m *CallResponse) GetEstimateGas() string {
+	if m != nil {
+		return m.EstimateGas
+	}
+	return ""
+}
+
+// ByBlockHeightRequest message
+type ByBlockHeightRequest struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ByBlockHeightRequest) Reset()         { *m = ByBlockHeightRequest{} }
+func (m *ByBlockHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*ByBlockHeightRequest) ProtoMessage()    {}
+func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{11}
+}
+func (m *ByBlockHeightRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ByBlockHeightRequest.Unmarshal(m, b)
+}
+func (m *ByBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ByBlockHeightRequest.Marshal(b, m, deterministic)
+}
+func (m *ByBlockHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ByBlockHeightRequest.Merge(m, src)
+}
+func (m *ByBlockHeightRequest) XXX_Size() int {
+	return xxx_messageInfo_ByBlockHeightRequest.Size(m)
+}
+func (m *ByBlockHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ByBlockHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ByBlockHeightRequest proto.InternalMessageInfo
+
+func (m *ByBlockHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetDynastyRequest rpc
+type GetDynastyResponse struct {
+	Miners               []string `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDynastyResponse) Reset()         { *m = GetDynastyResponse{} }
+func (m *GetDynastyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDynastyResponse) ProtoMessage()    {}
+func (*GetDynastyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{12}
+}
+func (m *GetDynastyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDynastyResponse.Unmarshal(m, b)
+}
+func (m *GetDynastyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDynastyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDynastyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDynastyResponse.Merge(m, src)
+}
+func (m *GetDynastyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDynastyResponse.Size(m)
+}
+func (m *GetDynastyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDynastyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDynastyResponse proto.InternalMessageInfo
+
+func (m *GetDynastyResponse) GetMiners() []string {
+	if m != nil {
+		return m.Miners
+	}
+	return nil
+}
+
+// Request message of GetMinerPerformance rpc
+type MinerPerformanceRequest struct {
+	// the last serial, 0 for the current one.
+	Serial int64 `protobuf:"varint,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	// number of serials until the last one, the default is 1.
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MinerPerformanceRequest) Reset()         { *m = MinerPerformanceRequest{} }
+func (m *MinerPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*MinerPerformanceRequest) ProtoMessage()    {}
+func (*MinerPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{13}
+}
+func (m *MinerPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinerPerformanceRequest.Unmarshal(m, b)
+}
+func (m *MinerPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinerPerformanceRequest.Marshal(b, m, deterministic)
+}
+func (m *MinerPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerPerformanceRequest.Merge(m, src)
+}
+func (m *MinerPerformanceRequest) XXX_Size() int {
+	return xxx_messageInfo_MinerPerformanceRequest.Size(m)
+}
+func (m *MinerPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerPerformanceRequest proto.InternalMessageInfo
+
+func (m *MinerPerformanceRequest) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *MinerPerformanceRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Response message of GetMinerPerformance rpc
+type MinerPerformanceResponse struct {
+	Dynasties            []*DynastyPerformance `protobuf:"bytes,1,rep,name=dynasties,proto3" json:"dynasties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MinerPerformanceResponse) Reset()         { *m = MinerPerformanceResponse{} }
+func (m *MinerPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MinerPerformanceResponse) ProtoMessage()    {}
+func (*MinerPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{14}
+}
+func (m *MinerPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinerPerformanceResponse.Unmarshal(m, b)
+}
+func (m *MinerPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinerPerformanceResponse.Marshal(b, m, deterministic)
+}
+func (m *MinerPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerPerformanceResponse.Merge(m, src)
+}
+func (m *MinerPerformanceResponse) XXX_Size() int {
+	return xxx_messageInfo_MinerPerformanceResponse.Size(m)
+}
+func (m *MinerPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinerPerformanceResponse proto.InternalMessageInfo
+
+func (m *MinerPerformanceResponse) GetDynasties() []*DynastyPerformance {
+	if m != nil {
+		return m.Dynasties
+	}
+	return nil
+}
+
+// Response message of GetEvidence rpc
+type EvidenceResponse struct {
+	Pending              []*Evidence `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	Committed            []*Evidence `protobuf:"bytes,2,rep,name=committed,proto3" json:"committed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *EvidenceResponse) Reset()         { *m = EvidenceResponse{} }
+func (m *EvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*EvidenceResponse) ProtoMessage()    {}
+func (*EvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+}
+func (m *EvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvidenceResponse.Unmarshal(m, b)
+}
+func (m *EvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvidenceResponse.Marshal(b, m, deterministic)
+}
+func (m *EvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceResponse.Merge(m, src)
+}
+func (m *EvidenceResponse) XXX_Size() int {
+	return xxx_messageInfo_EvidenceResponse.Size(m)
+}
+func (m *EvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceResponse proto.InternalMessageInfo
+
+func (m *EvidenceResponse) GetPending() []*Evidence {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *EvidenceResponse) GetCommitted() []*Evidence {
+	if m != nil {
+		return m.Committed
+	}
+	return nil
+}
+
+type Evidence struct {
+	Miner     string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Evil      string `protobuf:"bytes,3,opt,name=evil,proto3" json:"evil,omitempty"`
+	// hashes of the conflicting blocks, empty if only the report is seen on chain.
+	Blocks []string `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// the block including the report and its sender, 0 if pending.
+	Height               uint64   `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Reporter             string   `protobuf:"bytes,6,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Evidence) Reset()         { *m = Evidence{} }
+func (m *Evidence) String() string { return proto.CompactTextString(m) }
+func (*Evidence) ProtoMessage()    {}
+func (*Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+}
+func (m *Evidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Evidence.Unmarshal(m, b)
+}
+func (m *Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Evidence.Marshal(b, m, deterministic)
+}
+func (m *Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Evidence.Merge(m, src)
+}
+func (m *Evidence) XXX_Size() int {
+	return xxx_messageInfo_Evidence.Size(m)
+}
+func (m *Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Evidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Evidence proto.InternalMessageInfo
+
+func (m *Evidence) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *Evidence) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Evidence) GetEvil() string {
+	if m != nil {
+		return m.Evil
+	}
+	return ""
+}
+
+func (m *Evidence) GetBlocks() []string {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *Evidence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Evidence) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+// Response message of GetFinalityCertificate rpc
+type FinalityCertificateResponse struct {
+	Hash   string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the descendant certified, the block is finalized as its ancestor.
+	CertifiedHash        string               `protobuf:"bytes,3,opt,name=certified_hash,json=certifiedHash,proto3" json:"certified_hash,omitempty"`
+	CertifiedHeight      uint64               `protobuf:"varint,4,opt,name=certified_height,json=certifiedHeight,proto3" json:"certified_height,omitempty"`
+	Witnesses            []*AggregatedWitness `protobuf:"bytes,5,rep,name=witnesses,proto3" json:"witnesses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FinalityCertificateResponse) Reset()         { *m = FinalityCertificateResponse{} }
+func (m *FinalityCertificateResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityCertificateResponse) ProtoMessage()    {}
+func (*FinalityCertificateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+}
+func (m *FinalityCertificateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityCertificateResponse.Unmarshal(m, b)
+}
+func (m *FinalityCertificateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityCertificateResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalityCertificateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityCertificateResponse.Merge(m, src)
+}
+func (m *FinalityCertificateResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalityCertificateResponse.Size(m)
+}
+func (m *FinalityCertificateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityCertificateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityCertificateResponse proto.InternalMessageInfo
+
+func (m *FinalityCertificateResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *FinalityCertificateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FinalityCertificateResponse) GetCertifiedHash() string {
+	if m != nil {
+		return m.CertifiedHash
+	}
+	return ""
+}
+
+func (m *FinalityCertificateResponse) GetCertifiedHeight() uint64 {
+	if m != nil {
+		return m.CertifiedHeight
+	}
+	return 0
+}
+
+func (m *FinalityCertificateResponse) GetWitnesses() []*AggregatedWitness {
+	if m != nil {
+		return m.Witnesses
+	}
+	return nil
+}
+
+type AggregatedWitness struct {
+	Blocks []string `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Serial int64    `protobuf:"varint,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	// the signers marked in the bitmap of the dynasty members, and their signs.
+	Bitmap               string   `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+	Signers              []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	Signs                []string `protobuf:"bytes,5,rep,name=signs,proto3" json:"signs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregatedWitness) Reset()         { *m = AggregatedWitness{} }
+func (m *AggregatedWitness) String() string { return proto.CompactTextString(m) }
+func (*AggregatedWitness) ProtoMessage()    {}
+func (*AggregatedWitness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+}
+func (m *AggregatedWitness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatedWitness.Unmarshal(m, b)
+}
+func (m *AggregatedWitness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatedWitness.Marshal(b, m, deterministic)
+}
+func (m *AggregatedWitness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatedWitness.Merge(m, src)
+}
+func (m *AggregatedWitness) XXX_Size() int {
+	return xxx_messageInfo_AggregatedWitness.Size(m)
+}
+func (m *AggregatedWitness) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatedWitness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatedWitness proto.InternalMessageInfo
+
+func (m *AggregatedWitness) GetBlocks() []string {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+func (m *AggregatedWitness) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *AggregatedWitness) GetBitmap() string {
+	if m != nil {
+		return m.Bitmap
+	}
+	return ""
+}
+
+func (m *AggregatedWitness) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *AggregatedWitness) GetSigns() []string {
+	if m != nil {
+		return m.Signs
+	}
+	return nil
+}
+
+// Request message of GetConsensusState rpc
+type ConsensusStateRequest struct {
+	// height of the block, the tail if 0.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the first block of the dynasty serial instead, if height is 0.
+	Serial               int64    `protobuf:"varint,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConsensusStateRequest) Reset()         { *m = ConsensusStateRequest{} }
+func (m *ConsensusStateRequest) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateRequest) ProtoMessage()    {}
+func (*ConsensusStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+}
+func (m *ConsensusStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusStateRequest.Unmarshal(m, b)
+}
+func (m *ConsensusStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusStateRequest.Marshal(b, m, deterministic)
+}
+func (m *ConsensusStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateRequest.Merge(m, src)
+}
+func (m *ConsensusStateRequest) XXX_Size() int {
+	return xxx_messageInfo_ConsensusStateRequest.Size(m)
+}
+func (m *ConsensusStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateRequest proto.InternalMessageInfo
+
+func (m *ConsensusStateRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsensusStateRequest) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+// Response message of GetConsensusState rpc
+type ConsensusStateResponse struct {
+	Height      uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash        string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Timestamp   int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Serial      int64    `protobuf:"varint,4,opt,name=serial,proto3" json:"serial,omitempty"`
+	DynastyRoot string   `protobuf:"bytes,5,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	Dynasty     []string `protobuf:"bytes,6,rep,name=dynasty,proto3" json:"dynasty,omitempty"`
+	// the nodes in the pod contract at the block.
+	Candidates           []*PodNode `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Participants         []*PodNode `protobuf:"bytes,8,rep,name=participants,proto3" json:"participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ConsensusStateResponse) Reset()         { *m = ConsensusStateResponse{} }
+func (m *ConsensusStateResponse) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateResponse) ProtoMessage()    {}
+func (*ConsensusStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+}
+func (m *ConsensusStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConsensusStateResponse.Unmarshal(m, b)
+}
+func (m *ConsensusStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConsensusStateResponse.Marshal(b, m, deterministic)
+}
+func (m *ConsensusStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateResponse.Merge(m, src)
+}
+func (m *ConsensusStateResponse) XXX_Size() int {
+	return xxx_messageInfo_ConsensusStateResponse.Size(m)
+}
+func (m *ConsensusStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateResponse proto.InternalMessageInfo
+
+func (m *ConsensusStateResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ConsensusStateResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ConsensusStateResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ConsensusStateResponse) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *ConsensusStateResponse) GetDynastyRoot() string {
+	if m != nil {
+		return m.DynastyRoot
+	}
+	return ""
+}
+
+func (m *ConsensusStateResponse) GetDynasty() []string {
+	if m != nil {
+		return m.Dynasty
+	}
+	return nil
+}
+
+func (m *ConsensusStateResponse) GetCandidates() []*PodNode {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *ConsensusStateResponse) GetParticipants() []*PodNode {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+type PodNode struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	HeartbeatSerial      int64    `protobuf:"varint,2,opt,name=heartbeat_serial,json=heartbeatSerial,proto3" json:"heartbeat_serial,omitempty"`
+	Miner                string   `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
+	Score                string   `protobuf:"bytes,4,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodNode) Reset()         { *m = PodNode{} }
+func (m *PodNode) String() string { return proto.CompactTextString(m) }
+func (*PodNode) ProtoMessage()    {}
+func (*PodNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+}
+func (m *PodNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodNode.Unmarshal(m, b)
+}
+func (m *PodNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodNode.Marshal(b, m, deterministic)
+}
+func (m *PodNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodNode.Merge(m, src)
+}
+func (m *PodNode) XXX_Size() int {
+	return xxx_messageInfo_PodNode.Size(m)
+}
+func (m *PodNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodNode proto.InternalMessageInfo
+
+func (m *PodNode) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PodNode) GetHeartbeatSerial() int64 {
+	if m != nil {
+		return m.HeartbeatSerial
+	}
+	return 0
+}
+
+func (m *PodNode) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *PodNode) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
+}
+
+// Request message of GetProposerSchedule rpc
+type ProposerScheduleRequest struct {
+	// number of the slots, a round of the dynasty by default.
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposerScheduleRequest) Reset()         { *m = ProposerScheduleRequest{} }
+func (m *ProposerScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposerScheduleRequest) ProtoMessage()    {}
+func (*ProposerScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+}
+func (m *ProposerScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposerScheduleRequest.Unmarshal(m, b)
+}
+func (m *ProposerScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposerScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *ProposerScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerScheduleRequest.Merge(m, src)
+}
+func (m *ProposerScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_ProposerScheduleRequest.Size(m)
+}
+func (m *ProposerScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerScheduleRequest proto.InternalMessageInfo
+
+func (m *ProposerScheduleRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Response message of GetProposerSchedule rpc
+type ProposerScheduleResponse struct {
+	Slots                []*ProposerSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ProposerScheduleResponse) Reset()         { *m = ProposerScheduleResponse{} }
+func (m *ProposerScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerScheduleResponse) ProtoMessage()    {}
+func (*ProposerScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{23}
+}
+func (m *ProposerScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposerScheduleResponse.Unmarshal(m, b)
+}
+func (m *ProposerScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposerScheduleResponse.Marshal(b, m, deterministic)
+}
+func (m *ProposerScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerScheduleResponse.Merge(m, src)
+}
+func (m *ProposerScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposerScheduleResponse.Size(m)
+}
+func (m *ProposerScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerScheduleResponse proto.InternalMessageInfo
+
+func (m *ProposerScheduleResponse) GetSlots() []*ProposerSlot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type ProposerSlot struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Serial               int64    `protobuf:"varint,2,opt,name=serial,proto3" json:"serial,omitempty"`
+	Proposer             string   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposerSlot) Reset()         { *m = ProposerSlot{} }
+func (m *ProposerSlot) String() string { return proto.CompactTextString(m) }
+func (*ProposerSlot) ProtoMessage()    {}
+func (*ProposerSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{24}
+}
+func (m *ProposerSlot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposerSlot.Unmarshal(m, b)
+}
+func (m *ProposerSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposerSlot.Marshal(b, m, deterministic)
+}
+func (m *ProposerSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSlot.Merge(m, src)
+}
+func (m *ProposerSlot) XXX_Size() int {
+	return xxx_messageInfo_ProposerSlot.Size(m)
+}
+func (m *ProposerSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSlot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSlot proto.InternalMessageInfo
+
+func (m *ProposerSlot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ProposerSlot) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *ProposerSlot) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// Request message of GetReorgHistory rpc
+type ReorgHistoryRequest struct {
+	// max number of reorgs returned, 0 for all kept.
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgHistoryRequest) Reset()         { *m = ReorgHistoryRequest{} }
+func (m *ReorgHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ReorgHistoryRequest) ProtoMessage()    {}
+func (*ReorgHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{25}
+}
+func (m *ReorgHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgHistoryRequest.Unmarshal(m, b)
+}
+func (m *ReorgHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ReorgHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgHistoryRequest.Merge(m, src)
+}
+func (m *ReorgHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ReorgHistoryRequest.Size(m)
+}
+func (m *ReorgHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgHistoryRequest proto.InternalMessageInfo
+
+func (m *ReorgHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Response message of GetReorgHistory rpc
+type ReorgHistoryResponse struct {
+	Reorgs               []*Reorg `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgHistoryResponse) Reset()         { *m = ReorgHistoryResponse{} }
+func (m *ReorgHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ReorgHistoryResponse) ProtoMessage()    {}
+func (*ReorgHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+}
+func (m *ReorgHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgHistoryResponse.Unmarshal(m, b)
+}
+func (m *ReorgHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ReorgHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgHistoryResponse.Merge(m, src)
+}
+func (m *ReorgHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ReorgHistoryResponse.Size(m)
+}
+func (m *ReorgHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgHistoryResponse proto.InternalMessageInfo
+
+func (m *ReorgHistoryResponse) GetReorgs() []*Reorg {
+	if m != nil {
+		return m.Reorgs
+	}
+	return nil
+}
+
+type Reorg struct {
+	OldTail  *ReorgBlock `protobuf:"bytes,1,opt,name=old_tail,json=oldTail,proto3" json:"old_tail,omitempty"`
+	NewTail  *ReorgBlock `protobuf:"bytes,2,opt,name=new_tail,json=newTail,proto3" json:"new_tail,omitempty"`
+	Ancestor *ReorgBlock `protobuf:"bytes,3,opt,name=ancestor,proto3" json:"ancestor,omitempty"`
+	// blocks reverted from the old tail and applied to the new tail.
+	Depth                uint64   `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	Applied              uint64   `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	RevertedTxs          uint64   `protobuf:"varint,6,opt,name=reverted_txs,json=revertedTxs,proto3" json:"reverted_txs,omitempty"`
+	AppliedTxs           uint64   `protobuf:"varint,7,opt,name=applied_txs,json=appliedTxs,proto3" json:"applied_txs,omitempty"`
+	Timestamp            int64    `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Reorg) Reset()         { *m = Reorg{} }
+func (m *Reorg) String() string { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()    {}
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+}
+func (m *Reorg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Reorg.Unmarshal(m, b)
+}
+func (m *Reorg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Reorg.Marshal(b, m, deterministic)
+}
+func (m *Reorg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reorg.Merge(m, src)
+}
+func (m *Reorg) XXX_Size() int {
+	return xxx_messageInfo_Reorg.Size(m)
+}
+func (m *Reorg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reorg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reorg proto.InternalMessageInfo
+
+func (m *Reorg) GetOldTail() *ReorgBlock {
+	if m != nil {
+		return m.OldTail
+	}
+	return nil
+}
+
+func (m *Reorg) GetNewTail() *ReorgBlock {
+	if m != nil {
+		return m.NewTail
+	}
+	return nil
+}
+
+func (m *Reorg) GetAncestor() *ReorgBlock {
+	if m != nil {
+		return m.Ancestor
+	}
+	return nil
+}
+
+func (m *Reorg) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *Reorg) GetApplied() uint64 {
+	if m != nil {
+		return m.Applied
+	}
+	return 0
+}
+
+func (m *Reorg) GetRevertedTxs() uint64 {
+	if m != nil {
+		return m.RevertedTxs
+	}
+	return 0
+}
+
+func (m *Reorg) GetAppliedTxs() uint64 {
+	if m != nil {
+		return m.AppliedTxs
+	}
+	return 0
+}
+
+func (m *Reorg) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type ReorgBlock struct {
+	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash                 string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReorgBlock) Reset()         { *m = ReorgBlock{} }
+func (m *ReorgBlock) String() string { return proto.CompactTextString(m) }
+func (*ReorgBlock) ProtoMessage()    {}
+func (*ReorgBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+}
+func (m *ReorgBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReorgBlock.Unmarshal(m, b)
+}
+func (m *ReorgBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReorgBlock.Marshal(b, m, deterministic)
+}
+func (m *ReorgBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorgBlock.Merge(m, src)
+}
+func (m *ReorgBlock) XXX_Size() int {
+	return xxx_messageInfo_ReorgBlock.Size(m)
+}
+func (m *ReorgBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorgBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorgBlock proto.InternalMessageInfo
+
+func (m *ReorgBlock) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReorgBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type DynastyPerformance struct {
+	Serial      int64               `protobuf:"varint,1,opt,name=serial,proto3" json:"serial,omitempty"`
+	StartHeight uint64              `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64              `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Miners      []*MinerPerformance `protobuf:"bytes,4,rep,name=miners,proto3" json:"miners,omitempty"`
+	// false if the witnesses are not counted, they are only kept for the recent serials.
+	WitnessesRecorded    bool     `protobuf:"varint,5,opt,name=witnesses_recorded,json=witnessesRecorded,proto3" json:"witnesses_recorded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DynastyPerformance) Reset()         { *m = DynastyPerformance{} }
+func (m *DynastyPerformance) String() string { return proto.CompactTextString(m) }
+func (*DynastyPerformance) ProtoMessage()    {}
+func (*DynastyPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+}
+func (m *DynastyPerformance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DynastyPerformance.Unmarshal(m, b)
+}
+func (m *DynastyPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DynastyPerformance.Marshal(b, m, deterministic)
+}
+func (m *DynastyPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynastyPerformance.Merge(m, src)
+}
+func (m *DynastyPerformance) XXX_Size() int {
+	return xxx_messageInfo_DynastyPerformance.Size(m)
+}
+func (m *DynastyPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynastyPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynastyPerformance proto.InternalMessageInfo
+
+func (m *DynastyPerformance) GetSerial() int64 {
+	if m != nil {
+		return m.Serial
+	}
+	return 0
+}
+
+func (m *DynastyPerformance) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *DynastyPerformance) GetEndHeight() uint64 {
 	if m != nil {
-		return m.Result
+		return m.EndHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *CallResponse) GetExecuteErr() string {
+func (m *DynastyPerformance) GetMiners() []*MinerPerformance {
 	if m != nil {
-		return m.ExecuteErr
+		return m.Miners
 	}
-	return ""
+	return nil
 }
 
-func (m *CallResponse) GetEstimateGas() string {
+func (m *DynastyPerformance) GetWitnessesRecorded() bool {
 	if m != nil {
-		return m.EstimateGas
+		return m.WitnessesRecorded
 	}
-	return ""
+	return false
 }
 
-// ByBlockHeightRequest message
-type ByBlockHeightRequest struct {
-	Height               uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type MinerPerformance struct {
+	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	// blocks expected by the slots, produced and missed.
+	Expected uint32 `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Produced uint32 `protobuf:"varint,3,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed   uint32 `protobuf:"varint,4,opt,name=missed,proto3" json:"missed,omitempty"`
+	// the latest heartbeat and score of the node.
+	HeartbeatSerial int64  `protobuf:"varint,5,opt,name=heartbeat_serial,json=heartbeatSerial,proto3" json:"heartbeat_serial,omitempty"`
+	Score           string `protobuf:"bytes,6,opt,name=score,proto3" json:"score,omitempty"`
+	// blocks witnessed and evil reports submitted.
+	Witnesses            uint32   `protobuf:"varint,7,opt,name=witnesses,proto3" json:"witnesses,omitempty"`
+	Reports              uint32   `protobuf:"varint,8,opt,name=reports,proto3" json:"reports,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ByBlockHeightRequest) Reset()         { *m = ByBlockHeightRequest{} }
-func (m *ByBlockHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*ByBlockHeightRequest) ProtoMessage()    {}
-func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{10}
+func (m *MinerPerformance) Reset()         { *m = MinerPerformance{} }
+func (m *MinerPerformance) String() string { return proto.CompactTextString(m) }
+func (*MinerPerformance) ProtoMessage()    {}
+func (*MinerPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{30}
 }
-func (m *ByBlockHeightRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ByBlockHeightRequest.Unmarshal(m, b)
+func (m *MinerPerformance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinerPerformance.Unmarshal(m, b)
 }
-func (m *ByBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ByBlockHeightRequest.Marshal(b, m, deterministic)
+func (m *MinerPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinerPerformance.Marshal(b, m, deterministic)
 }
-func (m *ByBlockHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ByBlockHeightRequest.Merge(m, src)
+func (m *MinerPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinerPerformance.Merge(m, src)
 }
-func (m *ByBlockHeightRequest) XXX_Size() int {
-	return xxx_messageInfo_ByBlockHeightRequest.Size(m)
+func (m *MinerPerformance) XXX_Size() int {
+	return xxx_messageInfo_MinerPerformance.Size(m)
 }
-func (m *ByBlockHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ByBlockHeightRequest.DiscardUnknown(m)
+func (m *MinerPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinerPerformance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ByBlockHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_MinerPerformance proto.InternalMessageInfo
 
-func (m *ByBlockHeightRequest) GetHeight() uint64 {
+func (m *MinerPerformance) GetMiner() string {
 	if m != nil {
-		return m.Height
+		return m.Miner
 	}
-	return 0
+	return ""
 }
 
-// Response message of GetDynastyRequest rpc
-type GetDynastyResponse struct {
-	Miners               []string `protobuf:"bytes,1,rep,name=miners,proto3" json:"miners,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MinerPerformance) GetExpected() uint32 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
 }
 
-func (m *GetDynastyResponse) Reset()         { *m = GetDynastyResponse{} }
-func (m *GetDynastyResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDynastyResponse) ProtoMessage()    {}
-func (*GetDynastyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{11}
-}
-func (m *GetDynastyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDynastyResponse.Unmarshal(m, b)
-}
-func (m *GetDynastyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDynastyResponse.Marshal(b, m, deterministic)
+func (m *MinerPerformance) GetProduced() uint32 {
+	if m != nil {
+		return m.Produced
+	}
+	return 0
 }
-func (m *GetDynastyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDynastyResponse.Merge(m, src)
+
+func (m *MinerPerformance) GetMissed() uint32 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
 }
-func (m *GetDynastyResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDynastyResponse.Size(m)
+
+func (m *MinerPerformance) GetHeartbeatSerial() int64 {
+	if m != nil {
+		return m.HeartbeatSerial
+	}
+	return 0
 }
-func (m *GetDynastyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDynastyResponse.DiscardUnknown(m)
+
+func (m *MinerPerformance) GetScore() string {
+	if m != nil {
+		return m.Score
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetDynastyResponse proto.InternalMessageInfo
+func (m *MinerPerformance) GetWitnesses() uint32 {
+	if m != nil {
+		return m.Witnesses
+	}
+	return 0
+}
 
-func (m *GetDynastyResponse) GetMiners() []string {
+func (m *MinerPerformance) GetReports() uint32 {
 	if m != nil {
-		return m.Miners
+		return m.Reports
 	}
-	return nil
+	return 0
 }
 
 // Request message of SendTransaction rpc.
@@ -719,7 +1917,7 @@ func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()    {}
 func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{12}
+	return fileDescriptor_77a6da22d6a3feb1, []int{31}
 }
 func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionRequest.Unmarshal(m, b)
@@ -827,7 +2025,7 @@ func (m *ContractRequest) Reset()         { *m = ContractRequest{} }
 func (m *ContractRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractRequest) ProtoMessage()    {}
 func (*ContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{13}
+	return fileDescriptor_77a6da22d6a3feb1, []int{32}
 }
 func (m *ContractRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ContractRequest.Unmarshal(m, b)
@@ -888,7 +2086,7 @@ func (m *SendRawTransactionRequest) Reset()         { *m = SendRawTransactionReq
 func (m *SendRawTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRawTransactionRequest) ProtoMessage()    {}
 func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{14}
+	return fileDescriptor_77a6da22d6a3feb1, []int{33}
 }
 func (m *SendRawTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRawTransactionRequest.Unmarshal(m, b)
@@ -930,7 +2128,7 @@ func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse
 func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionResponse) ProtoMessage()    {}
 func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+	return fileDescriptor_77a6da22d6a3feb1, []int{34}
 }
 func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendTransactionResponse.Unmarshal(m, b)
@@ -979,7 +2177,7 @@ func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()    {}
 func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+	return fileDescriptor_77a6da22d6a3feb1, []int{35}
 }
 func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockByHashRequest.Unmarshal(m, b)
@@ -1028,7 +2226,7 @@ func (m *GetBlockByHeightRequest) Reset()         { *m = GetBlockByHeightRequest
 func (m *GetBlockByHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByHeightRequest) ProtoMessage()    {}
 func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+	return fileDescriptor_77a6da22d6a3feb1, []int{36}
 }
 func (m *GetBlockByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBlockByHeightRequest.Unmarshal(m, b)
@@ -1075,7 +2273,7 @@ func (m *GetTransactionByHashRequest) Reset()         { *m = GetTransactionByHas
 func (m *GetTransactionByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionByHashRequest) ProtoMessage()    {}
 func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+	return fileDescriptor_77a6da22d6a3feb1, []int{37}
 }
 func (m *GetTransactionByHashRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionByHashRequest.Unmarshal(m, b)
@@ -1115,7 +2313,7 @@ func (m *GetTransactionByContractRequest) Reset()         { *m = GetTransactionB
 func (m *GetTransactionByContractRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionByContractRequest) ProtoMessage()    {}
 func (*GetTransactionByContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+	return fileDescriptor_77a6da22d6a3feb1, []int{38}
 }
 func (m *GetTransactionByContractRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionByContractRequest.Unmarshal(m, b)
@@ -1185,7 +2383,7 @@ func (m *BlockResponse) Reset()         { *m = BlockResponse{} }
 func (m *BlockResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockResponse) ProtoMessage()    {}
 func (*BlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+	return fileDescriptor_77a6da22d6a3feb1, []int{39}
 }
 func (m *BlockResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockResponse.Unmarshal(m, b)
@@ -1354,7 +2552,7 @@ func (m *TransactionResponse) Reset()         { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()    {}
 func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+	return fileDescriptor_77a6da22d6a3feb1, []int{40}
 }
 func (m *TransactionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionResponse.Unmarshal(m, b)
@@ -1504,7 +2702,7 @@ func (m *NewAccountRequest) Reset()         { *m = NewAccountRequest{} }
 func (m *NewAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAccountRequest) ProtoMessage()    {}
 func (*NewAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+	return fileDescriptor_77a6da22d6a3feb1, []int{41}
 }
 func (m *NewAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAccountRequest.Unmarshal(m, b)
@@ -1542,7 +2740,7 @@ func (m *NewAccountResponse) Reset()         { *m = NewAccountResponse{} }
 func (m *NewAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAccountResponse) ProtoMessage()    {}
 func (*NewAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{23}
+	return fileDescriptor_77a6da22d6a3feb1, []int{42}
 }
 func (m *NewAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAccountResponse.Unmarshal(m, b)
@@ -1582,7 +2780,7 @@ func (m *UnlockAccountRequest) Reset()         { *m = UnlockAccountRequest{} }
 func (m *UnlockAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockAccountRequest) ProtoMessage()    {}
 func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{24}
+	return fileDescriptor_77a6da22d6a3feb1, []int{43}
 }
 func (m *UnlockAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockAccountRequest.Unmarshal(m, b)
@@ -1634,7 +2832,7 @@ func (m *UnlockAccountResponse) Reset()         { *m = UnlockAccountResponse{} }
 func (m *UnlockAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockAccountResponse) ProtoMessage()    {}
 func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{25}
+	return fileDescriptor_77a6da22d6a3feb1, []int{44}
 }
 func (m *UnlockAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockAccountResponse.Unmarshal(m, b)
@@ -1672,7 +2870,7 @@ func (m *LockAccountRequest) Reset()         { *m = LockAccountRequest{} }
 func (m *LockAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*LockAccountRequest) ProtoMessage()    {}
 func (*LockAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{26}
+	return fileDescriptor_77a6da22d6a3feb1, []int{45}
 }
 func (m *LockAccountRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LockAccountRequest.Unmarshal(m, b)
@@ -1710,7 +2908,7 @@ func (m *LockAccountResponse) Reset()         { *m = LockAccountResponse{} }
 func (m *LockAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*LockAccountResponse) ProtoMessage()    {}
 func (*LockAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{27}
+	return fileDescriptor_77a6da22d6a3feb1, []int{46}
 }
 func (m *LockAccountResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LockAccountResponse.Unmarshal(m, b)
@@ -1753,7 +2951,7 @@ func (m *SignHashRequest) Reset()         { *m = SignHashRequest{} }
 func (m *SignHashRequest) String() string { return proto.CompactTextString(m) }
 func (*SignHashRequest) ProtoMessage()    {}
 func (*SignHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{28}
+	return fileDescriptor_77a6da22d6a3feb1, []int{47}
 }
 func (m *SignHashRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignHashRequest.Unmarshal(m, b)
@@ -1805,7 +3003,7 @@ func (m *SignHashResponse) Reset()         { *m = SignHashResponse{} }
 func (m *SignHashResponse) String() string { return proto.CompactTextString(m) }
 func (*SignHashResponse) ProtoMessage()    {}
 func (*SignHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{29}
+	return fileDescriptor_77a6da22d6a3feb1, []int{48}
 }
 func (m *SignHashResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignHashResponse.Unmarshal(m, b)
@@ -1848,7 +3046,7 @@ func (m *GenerateRandomSeedRequest) Reset()         { *m = GenerateRandomSeedReq
 func (m *GenerateRandomSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenerateRandomSeedRequest) ProtoMessage()    {}
 func (*GenerateRandomSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{30}
+	return fileDescriptor_77a6da22d6a3feb1, []int{49}
 }
 func (m *GenerateRandomSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateRandomSeedRequest.Unmarshal(m, b)
@@ -1901,7 +3099,7 @@ func (m *GenerateRandomSeedResponse) Reset()         { *m = GenerateRandomSeedRe
 func (m *GenerateRandomSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenerateRandomSeedResponse) ProtoMessage()    {}
 func (*GenerateRandomSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{31}
+	return fileDescriptor_77a6da22d6a3feb1, []int{50}
 }
 func (m *GenerateRandomSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenerateRandomSeedResponse.Unmarshal(m, b)
@@ -1949,7 +3147,7 @@ func (m *SignTransactionPassphraseRequest) Reset()         { *m = SignTransactio
 func (m *SignTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionPassphraseRequest) ProtoMessage()    {}
 func (*SignTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{32}
+	return fileDescriptor_77a6da22d6a3feb1, []int{51}
 }
 func (m *SignTransactionPassphraseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionPassphraseRequest.Unmarshal(m, b)
@@ -1994,7 +3192,7 @@ func (m *SignTransactionPassphraseResponse) Reset()         { *m = SignTransacti
 func (m *SignTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
 func (*SignTransactionPassphraseResponse) ProtoMessage()    {}
 func (*SignTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{33}
+	return fileDescriptor_77a6da22d6a3feb1, []int{52}
 }
 func (m *SignTransactionPassphraseResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignTransactionPassphraseResponse.Unmarshal(m, b)
@@ -2035,7 +3233,7 @@ func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactio
 func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
 func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
 func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{34}
+	return fileDescriptor_77a6da22d6a3feb1, []int{53}
 }
 func (m *SendTransactionPassphraseRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendTransactionPassphraseRequest.Unmarshal(m, b)
@@ -2080,7 +3278,7 @@ func (m *GasPriceResponse) Reset()         { *m = GasPriceResponse{} }
 func (m *GasPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*GasPriceResponse) ProtoMessage()    {}
 func (*GasPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{35}
+	return fileDescriptor_77a6da22d6a3feb1, []int{54}
 }
 func (m *GasPriceResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GasPriceResponse.Unmarshal(m, b)
@@ -2120,7 +3318,7 @@ func (m *HashRequest) Reset()         { *m = HashRequest{} }
 func (m *HashRequest) String() string { return proto.CompactTextString(m) }
 func (*HashRequest) ProtoMessage()    {}
 func (*HashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{36}
+	return fileDescriptor_77a6da22d6a3feb1, []int{55}
 }
 func (m *HashRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HashRequest.Unmarshal(m, b)
@@ -2159,7 +3357,7 @@ func (m *GasResponse) Reset()         { *m = GasResponse{} }
 func (m *GasResponse) String() string { return proto.CompactTextString(m) }
 func (*GasResponse) ProtoMessage()    {}
 func (*GasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{37}
+	return fileDescriptor_77a6da22d6a3feb1, []int{56}
 }
 func (m *GasResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GasResponse.Unmarshal(m, b)
@@ -2204,7 +3402,7 @@ func (m *EventsResponse) Reset()         { *m = EventsResponse{} }
 func (m *EventsResponse) String() string { return proto.CompactTextString(m) }
 func (*EventsResponse) ProtoMessage()    {}
 func (*EventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{38}
+	return fileDescriptor_77a6da22d6a3feb1, []int{57}
 }
 func (m *EventsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EventsResponse.Unmarshal(m, b)
@@ -2243,7 +3441,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{39}
+	return fileDescriptor_77a6da22d6a3feb1, []int{58}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Event.Unmarshal(m, b)
@@ -2284,73 +3482,240 @@ type PprofRequest struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PprofRequest) Reset()         { *m = PprofRequest{} }
-func (m *PprofRequest) String() string { return proto.CompactTextString(m) }
-func (*PprofRequest) ProtoMessage()    {}
-func (*PprofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{40}
+func (m *PprofRequest) Reset()         { *m = PprofRequest{} }
+func (m *PprofRequest) String() string { return proto.CompactTextString(m) }
+func (*PprofRequest) ProtoMessage()    {}
+func (*PprofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{59}
+}
+func (m *PprofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PprofRequest.Unmarshal(m, b)
+}
+func (m *PprofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PprofRequest.Marshal(b, m, deterministic)
+}
+func (m *PprofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PprofRequest.Merge(m, src)
+}
+func (m *PprofRequest) XXX_Size() int {
+	return xxx_messageInfo_PprofRequest.Size(m)
+}
+func (m *PprofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PprofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PprofRequest proto.InternalMessageInfo
+
+func (m *PprofRequest) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
+type PprofResponse struct {
+	Result               bool     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PprofResponse) Reset()         { *m = PprofResponse{} }
+func (m *PprofResponse) String() string { return proto.CompactTextString(m) }
+func (*PprofResponse) ProtoMessage()    {}
+func (*PprofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{60}
+}
+func (m *PprofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PprofResponse.Unmarshal(m, b)
+}
+func (m *PprofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PprofResponse.Marshal(b, m, deterministic)
+}
+func (m *PprofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PprofResponse.Merge(m, src)
+}
+func (m *PprofResponse) XXX_Size() int {
+	return xxx_messageInfo_PprofResponse.Size(m)
+}
+func (m *PprofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PprofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PprofResponse proto.InternalMessageInfo
+
+func (m *PprofResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+// Request message of MineBlocks rpc
+type MineBlocksRequest struct {
+	// number of the blocks to seal.
+	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MineBlocksRequest) Reset()         { *m = MineBlocksRequest{} }
+func (m *MineBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*MineBlocksRequest) ProtoMessage()    {}
+func (*MineBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{61}
+}
+func (m *MineBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MineBlocksRequest.Unmarshal(m, b)
+}
+func (m *MineBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MineBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *MineBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MineBlocksRequest.Merge(m, src)
+}
+func (m *MineBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_MineBlocksRequest.Size(m)
+}
+func (m *MineBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MineBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MineBlocksRequest proto.InternalMessageInfo
+
+func (m *MineBlocksRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type MineBlocksResponse struct {
+	// hashes of the sealed blocks.
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	// height of the tail.
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MineBlocksResponse) Reset()         { *m = MineBlocksResponse{} }
+func (m *MineBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*MineBlocksResponse) ProtoMessage()    {}
+func (*MineBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{62}
+}
+func (m *MineBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MineBlocksResponse.Unmarshal(m, b)
+}
+func (m *MineBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MineBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *MineBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MineBlocksResponse.Merge(m, src)
+}
+func (m *MineBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_MineBlocksResponse.Size(m)
+}
+func (m *MineBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MineBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MineBlocksResponse proto.InternalMessageInfo
+
+func (m *MineBlocksResponse) GetHashes() []string {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *MineBlocksResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Request message of AdvanceTime rpc
+type AdvanceTimeRequest struct {
+	// seconds to move forward.
+	Seconds              int64    `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdvanceTimeRequest) Reset()         { *m = AdvanceTimeRequest{} }
+func (m *AdvanceTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*AdvanceTimeRequest) ProtoMessage()    {}
+func (*AdvanceTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{63}
 }
-func (m *PprofRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PprofRequest.Unmarshal(m, b)
+func (m *AdvanceTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdvanceTimeRequest.Unmarshal(m, b)
 }
-func (m *PprofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PprofRequest.Marshal(b, m, deterministic)
+func (m *AdvanceTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdvanceTimeRequest.Marshal(b, m, deterministic)
 }
-func (m *PprofRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PprofRequest.Merge(m, src)
+func (m *AdvanceTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdvanceTimeRequest.Merge(m, src)
 }
-func (m *PprofRequest) XXX_Size() int {
-	return xxx_messageInfo_PprofRequest.Size(m)
+func (m *AdvanceTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_AdvanceTimeRequest.Size(m)
 }
-func (m *PprofRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PprofRequest.DiscardUnknown(m)
+func (m *AdvanceTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdvanceTimeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PprofRequest proto.InternalMessageInfo
+var xxx_messageInfo_AdvanceTimeRequest proto.InternalMessageInfo
 
-func (m *PprofRequest) GetListen() string {
+func (m *AdvanceTimeRequest) GetSeconds() int64 {
 	if m != nil {
-		return m.Listen
+		return m.Seconds
 	}
-	return ""
+	return 0
 }
 
-type PprofResponse struct {
-	Result               bool     `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+type AdvanceTimeResponse struct {
+	// time of the following blocks, unit is s.
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PprofResponse) Reset()         { *m = PprofResponse{} }
-func (m *PprofResponse) String() string { return proto.CompactTextString(m) }
-func (*PprofResponse) ProtoMessage()    {}
-func (*PprofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{41}
+func (m *AdvanceTimeResponse) Reset()         { *m = AdvanceTimeResponse{} }
+func (m *AdvanceTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*AdvanceTimeResponse) ProtoMessage()    {}
+func (*AdvanceTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{64}
 }
-func (m *PprofResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PprofResponse.Unmarshal(m, b)
+func (m *AdvanceTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdvanceTimeResponse.Unmarshal(m, b)
 }
-func (m *PprofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PprofResponse.Marshal(b, m, deterministic)
+func (m *AdvanceTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdvanceTimeResponse.Marshal(b, m, deterministic)
 }
-func (m *PprofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PprofResponse.Merge(m, src)
+func (m *AdvanceTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdvanceTimeResponse.Merge(m, src)
 }
-func (m *PprofResponse) XXX_Size() int {
-	return xxx_messageInfo_PprofResponse.Size(m)
+func (m *AdvanceTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_AdvanceTimeResponse.Size(m)
 }
-func (m *PprofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PprofResponse.DiscardUnknown(m)
+func (m *AdvanceTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdvanceTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PprofResponse proto.InternalMessageInfo
+var xxx_messageInfo_AdvanceTimeResponse proto.InternalMessageInfo
 
-func (m *PprofResponse) GetResult() bool {
+func (m *AdvanceTimeResponse) GetTimestamp() int64 {
 	if m != nil {
-		return m.Result
+		return m.Timestamp
 	}
-	return false
+	return 0
 }
 
 type GetConfigResponse struct {
@@ -2365,7 +3730,7 @@ func (m *GetConfigResponse) Reset()         { *m = GetConfigResponse{} }
 func (m *GetConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetConfigResponse) ProtoMessage()    {}
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{42}
+	return fileDescriptor_77a6da22d6a3feb1, []int{65}
 }
 func (m *GetConfigResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetConfigResponse.Unmarshal(m, b)
@@ -2406,7 +3771,7 @@ func (m *VerifySignatureRequest) Reset()         { *m = VerifySignatureRequest{}
 func (m *VerifySignatureRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifySignatureRequest) ProtoMessage()    {}
 func (*VerifySignatureRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{43}
+	return fileDescriptor_77a6da22d6a3feb1, []int{66}
 }
 func (m *VerifySignatureRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifySignatureRequest.Unmarshal(m, b)
@@ -2466,7 +3831,7 @@ func (m *VerifySignatureResponse) Reset()         { *m = VerifySignatureResponse
 func (m *VerifySignatureResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifySignatureResponse) ProtoMessage()    {}
 func (*VerifySignatureResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{44}
+	return fileDescriptor_77a6da22d6a3feb1, []int{67}
 }
 func (m *VerifySignatureResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifySignatureResponse.Unmarshal(m, b)
@@ -2512,7 +3877,7 @@ func (m *GetNRByAddressRequest) Reset()         { *m = GetNRByAddressRequest{} }
 func (m *GetNRByAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNRByAddressRequest) ProtoMessage()    {}
 func (*GetNRByAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{45}
+	return fileDescriptor_77a6da22d6a3feb1, []int{68}
 }
 func (m *GetNRByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNRByAddressRequest.Unmarshal(m, b)
@@ -2555,7 +3920,7 @@ func (m *GetNRHandleRequest) Reset()         { *m = GetNRHandleRequest{} }
 func (m *GetNRHandleRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNRHandleRequest) ProtoMessage()    {}
 func (*GetNRHandleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{46}
+	return fileDescriptor_77a6da22d6a3feb1, []int{69}
 }
 func (m *GetNRHandleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNRHandleRequest.Unmarshal(m, b)
@@ -2607,7 +3972,7 @@ func (m *GetNRHandleResponse) Reset()         { *m = GetNRHandleResponse{} }
 func (m *GetNRHandleResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNRHandleResponse) ProtoMessage()    {}
 func (*GetNRHandleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{47}
+	return fileDescriptor_77a6da22d6a3feb1, []int{70}
 }
 func (m *GetNRHandleResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNRHandleResponse.Unmarshal(m, b)
@@ -2646,7 +4011,7 @@ func (m *GetNRListByHandleRequest) Reset()         { *m = GetNRListByHandleReque
 func (m *GetNRListByHandleRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNRListByHandleRequest) ProtoMessage()    {}
 func (*GetNRListByHandleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{48}
+	return fileDescriptor_77a6da22d6a3feb1, []int{71}
 }
 func (m *GetNRListByHandleRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNRListByHandleRequest.Unmarshal(m, b)
@@ -2691,7 +4056,7 @@ func (m *GetNRListResponse) Reset()         { *m = GetNRListResponse{} }
 func (m *GetNRListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNRListResponse) ProtoMessage()    {}
 func (*GetNRListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{49}
+	return fileDescriptor_77a6da22d6a3feb1, []int{72}
 }
 func (m *GetNRListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetNRListResponse.Unmarshal(m, b)
@@ -2755,7 +4120,7 @@ func (m *NRItem) Reset()         { *m = NRItem{} }
 func (m *NRItem) String() string { return proto.CompactTextString(m) }
 func (*NRItem) ProtoMessage()    {}
 func (*NRItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{50}
+	return fileDescriptor_77a6da22d6a3feb1, []int{73}
 }
 func (m *NRItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NRItem.Unmarshal(m, b)
@@ -2815,7 +4180,7 @@ func (m *GetDIPListRequest) Reset()         { *m = GetDIPListRequest{} }
 func (m *GetDIPListRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDIPListRequest) ProtoMessage()    {}
 func (*GetDIPListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{51}
+	return fileDescriptor_77a6da22d6a3feb1, []int{74}
 }
 func (m *GetDIPListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDIPListRequest.Unmarshal(m, b)
@@ -2860,7 +4225,7 @@ func (m *GetDIPListResponse) Reset()         { *m = GetDIPListResponse{} }
 func (m *GetDIPListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDIPListResponse) ProtoMessage()    {}
 func (*GetDIPListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{52}
+	return fileDescriptor_77a6da22d6a3feb1, []int{75}
 }
 func (m *GetDIPListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDIPListResponse.Unmarshal(m, b)
@@ -2924,7 +4289,7 @@ func (m *DIPItem) Reset()         { *m = DIPItem{} }
 func (m *DIPItem) String() string { return proto.CompactTextString(m) }
 func (*DIPItem) ProtoMessage()    {}
 func (*DIPItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{53}
+	return fileDescriptor_77a6da22d6a3feb1, []int{76}
 }
 func (m *DIPItem) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DIPItem.Unmarshal(m, b)
@@ -2972,12 +4337,31 @@ func init() {
 	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
 	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
 	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*SyncProgressResponse)(nil), "rpcpb.SyncProgressResponse")
 	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
 	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
 	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
 	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
 	proto.RegisterType((*ByBlockHeightRequest)(nil), "rpcpb.ByBlockHeightRequest")
 	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*MinerPerformanceRequest)(nil), "rpcpb.MinerPerformanceRequest")
+	proto.RegisterType((*MinerPerformanceResponse)(nil), "rpcpb.MinerPerformanceResponse")
+	proto.RegisterType((*EvidenceResponse)(nil), "rpcpb.EvidenceResponse")
+	proto.RegisterType((*Evidence)(nil), "rpcpb.Evidence")
+	proto.RegisterType((*FinalityCertificateResponse)(nil), "rpcpb.FinalityCertificateResponse")
+	proto.RegisterType((*AggregatedWitness)(nil), "rpcpb.AggregatedWitness")
+	proto.RegisterType((*ConsensusStateRequest)(nil), "rpcpb.ConsensusStateRequest")
+	proto.RegisterType((*ConsensusStateResponse)(nil), "rpcpb.ConsensusStateResponse")
+	proto.RegisterType((*PodNode)(nil), "rpcpb.PodNode")
+	proto.RegisterType((*ProposerScheduleRequest)(nil), "rpcpb.ProposerScheduleRequest")
+	proto.RegisterType((*ProposerScheduleResponse)(nil), "rpcpb.ProposerScheduleResponse")
+	proto.RegisterType((*ProposerSlot)(nil), "rpcpb.ProposerSlot")
+	proto.RegisterType((*ReorgHistoryRequest)(nil), "rpcpb.ReorgHistoryRequest")
+	proto.RegisterType((*ReorgHistoryResponse)(nil), "rpcpb.ReorgHistoryResponse")
+	proto.RegisterType((*Reorg)(nil), "rpcpb.Reorg")
+	proto.RegisterType((*ReorgBlock)(nil), "rpcpb.ReorgBlock")
+	proto.RegisterType((*DynastyPerformance)(nil), "rpcpb.DynastyPerformance")
+	proto.RegisterType((*MinerPerformance)(nil), "rpcpb.MinerPerformance")
 	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
 	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
 	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
@@ -3008,6 +4392,10 @@ func init() {
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
 	proto.RegisterType((*PprofRequest)(nil), "rpcpb.PprofRequest")
 	proto.RegisterType((*PprofResponse)(nil), "rpcpb.PprofResponse")
+	proto.RegisterType((*MineBlocksRequest)(nil), "rpcpb.MineBlocksRequest")
+	proto.RegisterType((*MineBlocksResponse)(nil), "rpcpb.MineBlocksResponse")
+	proto.RegisterType((*AdvanceTimeRequest)(nil), "rpcpb.AdvanceTimeRequest")
+	proto.RegisterType((*AdvanceTimeResponse)(nil), "rpcpb.AdvanceTimeResponse")
 	proto.RegisterType((*GetConfigResponse)(nil), "rpcpb.GetConfigResponse")
 	proto.RegisterType((*VerifySignatureRequest)(nil), "rpcpb.VerifySignatureRequest")
 	proto.RegisterType((*VerifySignatureResponse)(nil), "rpcpb.VerifySignatureResponse")
@@ -3025,178 +4413,253 @@ func init() {
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
 
 var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 2732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdf, 0x6f, 0x1b, 0xc7,
-	0xf1, 0x07, 0x7f, 0xe8, 0x07, 0x87, 0x94, 0x44, 0xaf, 0x64, 0xe9, 0x44, 0xcb, 0xb2, 0xb4, 0xce,
-	0xd7, 0x51, 0xfc, 0x4d, 0xc4, 0x58, 0x01, 0xd2, 0x22, 0x41, 0x0a, 0xd8, 0x4e, 0xa2, 0xb8, 0x35,
-	0x0c, 0xf5, 0xe4, 0xa4, 0x01, 0xda, 0x94, 0x58, 0x92, 0x4b, 0xf2, 0x9a, 0xe3, 0x1d, 0x7b, 0xbb,
-	0x94, 0x2d, 0xf5, 0xa1, 0x40, 0x9e, 0x5b, 0xf4, 0xa1, 0x2f, 0x45, 0x51, 0xf4, 0x6f, 0xe8, 0xff,
-	0xd2, 0x87, 0xbc, 0xf6, 0xa1, 0x7f, 0x48, 0xb1, 0xb3, 0xbb, 0xf7, 0x8b, 0x47, 0xb2, 0x2e, 0x8a,
-	0xbe, 0xdd, 0xcc, 0xee, 0xce, 0xcc, 0xce, 0xce, 0x7e, 0x76, 0x66, 0x0e, 0x6a, 0xd1, 0xa4, 0x77,
-	0x3a, 0x89, 0x42, 0x19, 0x92, 0x95, 0x68, 0xd2, 0x9b, 0x74, 0x5b, 0x07, 0xc3, 0x30, 0x1c, 0xfa,
-	0xbc, 0xcd, 0x26, 0x5e, 0x9b, 0x05, 0x41, 0x28, 0x99, 0xf4, 0xc2, 0x40, 0xe8, 0x49, 0xad, 0x1f,
-	0x0e, 0x3d, 0x39, 0x9a, 0x76, 0x4f, 0x7b, 0xe1, 0xb8, 0x1d, 0xf0, 0xee, 0xd4, 0x67, 0xc2, 0x0b,
-	0xdb, 0xc3, 0xf0, 0x3d, 0x43, 0xb4, 0x7b, 0x61, 0x20, 0x78, 0x20, 0xa6, 0xa2, 0x3d, 0xe9, 0xb6,
-	0x85, 0x64, 0x92, 0x9b, 0x95, 0x1f, 0x2e, 0x5b, 0x19, 0xf0, 0xae, 0xcf, 0xa5, 0x5a, 0xd6, 0x0b,
-	0x83, 0x81, 0x37, 0xd4, 0xeb, 0xe8, 0x43, 0x68, 0x5e, 0x4e, 0xbb, 0xa2, 0x17, 0x79, 0x5d, 0xee,
-	0xf2, 0x5f, 0x4f, 0xb9, 0x90, 0x64, 0x17, 0x56, 0x65, 0x38, 0xf1, 0x7a, 0xc2, 0x29, 0x1d, 0x55,
-	0x4e, 0x6a, 0xae, 0xa1, 0xe8, 0x27, 0x70, 0x2b, 0x35, 0x57, 0x4c, 0x94, 0x2d, 0x64, 0x07, 0x56,
-	0x70, 0xd8, 0x29, 0x1d, 0x95, 0x4e, 0x6a, 0xae, 0x26, 0x08, 0x81, 0x6a, 0x9f, 0x49, 0xe6, 0x94,
-	0x91, 0x89, 0xdf, 0x94, 0x40, 0xf3, 0x45, 0x18, 0x5c, 0xb0, 0x88, 0x8d, 0x85, 0x51, 0x45, 0xff,
-	0x52, 0x56, 0xcc, 0x3e, 0x7f, 0x16, 0x0c, 0xc2, 0x58, 0xe4, 0x26, 0x94, 0xbd, 0xbe, 0x91, 0x57,
-	0xf6, 0xfa, 0x64, 0x1f, 0xd6, 0x7b, 0x23, 0xe6, 0x05, 0x1d, 0xaf, 0x8f, 0x02, 0x37, 0xdc, 0x35,
-	0xa4, 0x9f, 0xf5, 0x49, 0x0b, 0xd6, 0x7b, 0xa1, 0x17, 0x74, 0x99, 0xe0, 0x4e, 0x05, 0x17, 0xc4,
-	0x34, 0xb9, 0x0b, 0x30, 0xe1, 0x3c, 0xea, 0xf4, 0xc2, 0x69, 0x20, 0x9d, 0x2a, 0x2e, 0xac, 0x29,
-	0xce, 0x53, 0xc5, 0x20, 0x14, 0x1a, 0xe2, 0x3a, 0xe8, 0x8d, 0xa2, 0x30, 0xf0, 0x6e, 0x78, 0xdf,
-	0x59, 0x39, 0x2a, 0x9d, 0xac, 0xbb, 0x19, 0x1e, 0xb9, 0x07, 0xf5, 0xee, 0xb4, 0xf7, 0x2d, 0x97,
-	0x1d, 0xe1, 0xdd, 0x70, 0x67, 0xf5, 0xa8, 0x74, 0xb2, 0xe2, 0x82, 0x66, 0x5d, 0x7a, 0x37, 0x9c,
-	0xbc, 0x03, 0x4d, 0xf4, 0x63, 0x2f, 0xf4, 0x3b, 0x57, 0x3c, 0x12, 0x5e, 0x18, 0x38, 0x80, 0x76,
-	0x6c, 0x59, 0xfe, 0x57, 0x9a, 0x4d, 0xce, 0xa0, 0x1e, 0x85, 0x53, 0xc9, 0x3b, 0x92, 0x75, 0x7d,
-	0xee, 0xd4, 0x8f, 0x2a, 0x27, 0xf5, 0xb3, 0x5b, 0xa7, 0x18, 0x16, 0xa7, 0xae, 0x1a, 0x79, 0xa9,
-	0x06, 0x5c, 0x88, 0xe2, 0x6f, 0xfa, 0x21, 0x40, 0x32, 0x32, 0xe3, 0x17, 0x07, 0xd6, 0x58, 0xbf,
-	0x1f, 0x71, 0x21, 0x9c, 0x32, 0x1e, 0x94, 0x25, 0xe9, 0xf7, 0x25, 0xd8, 0x3e, 0xe7, 0xf2, 0x05,
-	0xef, 0x5e, 0xaa, 0x18, 0x89, 0x3d, 0x9b, 0xf6, 0x64, 0x29, 0xeb, 0x49, 0x02, 0x55, 0xc9, 0x3c,
-	0xdf, 0x9e, 0x98, 0xfa, 0x26, 0x4d, 0xa8, 0xf8, 0x5e, 0xd7, 0x38, 0x56, 0x7d, 0xaa, 0xd0, 0x18,
-	0x71, 0x6f, 0x38, 0xd2, 0xfe, 0xac, 0xba, 0x86, 0x2a, 0xf4, 0xc3, 0x6a, 0xb1, 0x1f, 0xf2, 0x7e,
-	0x5f, 0x2b, 0xf0, 0xbb, 0x03, 0x6b, 0x56, 0xca, 0x3a, 0x4a, 0xb1, 0x24, 0x7d, 0x1f, 0x9a, 0x8f,
-	0x7b, 0x78, 0xa2, 0x22, 0xde, 0xd5, 0x01, 0xd4, 0xcc, 0xc6, 0xb9, 0x0d, 0xd9, 0x84, 0x41, 0x7f,
-	0x0c, 0xbb, 0xe7, 0x5c, 0x9a, 0x45, 0xc6, 0x1d, 0x3a, 0xce, 0x53, 0xfe, 0xd3, 0x4e, 0xb5, 0x64,
-	0x6a, 0x9b, 0xe5, 0xf4, 0x36, 0xe9, 0x1f, 0x4a, 0xb0, 0x37, 0x23, 0xcc, 0x58, 0xe1, 0xc0, 0x5a,
-	0x97, 0xf9, 0x2c, 0xe8, 0x71, 0x2b, 0xcd, 0x90, 0xea, 0x8a, 0x04, 0xa1, 0xe2, 0x6b, 0x61, 0x9a,
-	0x40, 0x87, 0x5f, 0x4f, 0x74, 0xd8, 0x6e, 0xb8, 0xf8, 0x3d, 0xd7, 0xbd, 0x0e, 0xac, 0x4d, 0x78,
-	0xd0, 0xf7, 0x82, 0x21, 0x86, 0x69, 0xd5, 0xb5, 0x24, 0xfd, 0x15, 0x34, 0x9e, 0x32, 0xdf, 0x8f,
-	0xad, 0xd8, 0x85, 0xd5, 0x88, 0x8b, 0xa9, 0x2f, 0x8d, 0x11, 0x86, 0x52, 0x91, 0xcc, 0x5f, 0xf3,
-	0x9e, 0x8a, 0x3f, 0x1e, 0x45, 0xe6, 0x94, 0xc1, 0xb0, 0x3e, 0x8b, 0x22, 0x72, 0x0c, 0x0d, 0x2e,
-	0xa4, 0x37, 0x66, 0x92, 0x77, 0x86, 0x4c, 0x98, 0x43, 0xaf, 0x5b, 0xde, 0x39, 0x13, 0xf4, 0x14,
-	0x76, 0x9e, 0x5c, 0x3f, 0xf1, 0xc3, 0xde, 0xb7, 0x5f, 0xa0, 0x59, 0x29, 0xbc, 0x30, 0x56, 0x97,
-	0x32, 0xde, 0x7a, 0x17, 0xc8, 0x39, 0x97, 0x9f, 0x5e, 0x07, 0x4c, 0xc8, 0xeb, 0xb4, 0x85, 0x63,
-	0x2f, 0xe0, 0x51, 0x8c, 0x2e, 0x9a, 0xa2, 0x7f, 0x2e, 0x03, 0x79, 0x19, 0xb1, 0x40, 0xb0, 0x9e,
-	0x82, 0x44, 0x2b, 0x9c, 0x40, 0x75, 0x10, 0x85, 0x63, 0xb3, 0x1d, 0xfc, 0x56, 0x17, 0x41, 0x86,
-	0x66, 0x0f, 0x65, 0x19, 0x2a, 0x07, 0x5f, 0x31, 0x7f, 0x6a, 0x21, 0x40, 0x13, 0x89, 0xdb, 0xab,
-	0x69, 0xb7, 0xdf, 0x81, 0xda, 0x90, 0x89, 0xce, 0x24, 0xf2, 0x7a, 0x1c, 0x9d, 0x59, 0x73, 0xd7,
-	0x87, 0x4c, 0x5c, 0x28, 0xda, 0x0e, 0xfa, 0xde, 0xd8, 0x93, 0x26, 0x7e, 0xd5, 0xe0, 0x73, 0x45,
-	0x93, 0x33, 0x85, 0x35, 0x81, 0x8c, 0x58, 0x4f, 0x62, 0xd0, 0xd6, 0xcf, 0x76, 0xcd, 0xed, 0x7d,
-	0x6a, 0xd8, 0xc6, 0x66, 0x37, 0x9e, 0xa7, 0x36, 0xdb, 0xf5, 0x02, 0x16, 0x5d, 0x23, 0x2a, 0x34,
-	0x5c, 0x43, 0x29, 0xdc, 0xb2, 0xf7, 0xc2, 0xa9, 0xe3, 0x48, 0x4c, 0xc7, 0x81, 0xb1, 0x63, 0x6e,
-	0xe2, 0xf5, 0x84, 0xd3, 0x1b, 0xd8, 0xca, 0x29, 0x51, 0xa2, 0x45, 0x38, 0x8d, 0xe2, 0x70, 0x33,
-	0x94, 0x3a, 0x69, 0xfd, 0xd5, 0x41, 0x29, 0xe6, 0xa4, 0x35, 0xeb, 0xa5, 0x0a, 0xb2, 0x16, 0xac,
-	0x0f, 0xa6, 0x01, 0x3a, 0xd9, 0x62, 0xa6, 0xa5, 0x95, 0x6e, 0x16, 0x0d, 0x05, 0xba, 0xac, 0xe6,
-	0xe2, 0x37, 0x6d, 0xc3, 0xfe, 0x25, 0x0f, 0xfa, 0x2e, 0x7b, 0x55, 0x7c, 0x3c, 0x08, 0xf4, 0x25,
-	0xdc, 0x84, 0x06, 0xfa, 0x5f, 0xc0, 0x9e, 0x5a, 0x90, 0x99, 0x9d, 0x1c, 0xbe, 0x7c, 0x3d, 0x62,
-	0x62, 0x64, 0x8d, 0xd6, 0x94, 0xc2, 0x0f, 0xeb, 0xb3, 0x4e, 0x82, 0x69, 0x88, 0x1f, 0x96, 0xff,
-	0xd8, 0x60, 0x5b, 0x07, 0x6e, 0x9f, 0x73, 0x89, 0x61, 0xf8, 0xe4, 0xfa, 0x0b, 0x26, 0x46, 0x29,
-	0x53, 0x52, 0x92, 0xf1, 0x9b, 0x9c, 0xc1, 0xed, 0xc1, 0xd4, 0xf7, 0x3b, 0x03, 0xcf, 0xf7, 0x3b,
-	0x32, 0x31, 0x08, 0x85, 0xaf, 0xbb, 0xdb, 0x6a, 0xf0, 0x73, 0xcf, 0xf7, 0x53, 0xb6, 0x52, 0x8e,
-	0x77, 0xdc, 0x2a, 0xf8, 0x77, 0x22, 0xfd, 0x3f, 0x52, 0xf3, 0x08, 0xee, 0x9c, 0x73, 0x99, 0xe2,
-	0x2c, 0xdd, 0x0d, 0xfd, 0x18, 0xee, 0xe5, 0x97, 0xe4, 0xa3, 0x62, 0x2e, 0xa6, 0xd1, 0xbf, 0x56,
-	0x61, 0x03, 0x37, 0x15, 0x1f, 0x46, 0x91, 0xc3, 0xee, 0x41, 0x7d, 0xc2, 0x22, 0x1e, 0xc8, 0x0e,
-	0x0e, 0x99, 0xe8, 0xd1, 0x2c, 0x65, 0x5e, 0xca, 0x05, 0x95, 0x8c, 0x0b, 0x8a, 0x6f, 0x5b, 0xfa,
-	0x7d, 0x5e, 0xc9, 0xbd, 0xcf, 0x07, 0x50, 0x93, 0xde, 0x98, 0x0b, 0xc9, 0xc6, 0x13, 0xbc, 0x6c,
-	0x15, 0x37, 0x61, 0x64, 0x9e, 0xaa, 0xb5, 0xec, 0x53, 0x75, 0x17, 0x00, 0x53, 0x9f, 0x4e, 0x14,
-	0x86, 0xd2, 0x3c, 0x10, 0x35, 0xe4, 0xb8, 0x61, 0x28, 0xd5, 0x4a, 0xf9, 0x5a, 0xe8, 0xc1, 0x9a,
-	0xf6, 0x81, 0x7c, 0x2d, 0x70, 0x48, 0xa1, 0xe0, 0x15, 0x0f, 0xa4, 0x19, 0x05, 0x83, 0x82, 0xc8,
-	0xc2, 0x09, 0x8f, 0x61, 0x33, 0x4e, 0xb1, 0xf4, 0x9c, 0x3a, 0xde, 0xf4, 0xd6, 0x69, 0xcc, 0xd6,
-	0xf7, 0x5d, 0x7f, 0xab, 0x35, 0xee, 0x46, 0x2f, 0x4d, 0x2a, 0x47, 0x20, 0xa2, 0x39, 0x0d, 0x0d,
-	0x46, 0x48, 0x90, 0x43, 0x80, 0x88, 0x05, 0xfd, 0x70, 0x7c, 0xc9, 0x79, 0xdf, 0xd9, 0xd0, 0x8a,
-	0x13, 0x0e, 0x39, 0x82, 0xba, 0xa6, 0x2e, 0xa2, 0x30, 0x1c, 0x38, 0x9b, 0x1a, 0x7d, 0x53, 0x2c,
-	0x65, 0xbb, 0x27, 0x3a, 0x03, 0x2f, 0x60, 0xbe, 0x27, 0xaf, 0x9d, 0x2d, 0x8c, 0x2c, 0xf0, 0xc4,
-	0xe7, 0x86, 0x43, 0x7e, 0x04, 0x8d, 0x54, 0xe8, 0x09, 0xa7, 0x8f, 0x19, 0x46, 0xcb, 0x60, 0x54,
-	0xc1, 0x6d, 0x74, 0x33, 0xf3, 0xe9, 0x3f, 0x2a, 0xb0, 0x5d, 0x74, 0x67, 0x8b, 0xc2, 0xc4, 0x01,
-	0x7b, 0x1a, 0xf9, 0x8c, 0xcc, 0xe2, 0x75, 0x65, 0x06, 0xaf, 0xab, 0xb3, 0x78, 0xbd, 0x52, 0x88,
-	0xd7, 0xab, 0xe9, 0x08, 0xca, 0x44, 0xc9, 0x5a, 0x3e, 0x4a, 0x2c, 0x56, 0xae, 0x27, 0x58, 0x19,
-	0x43, 0x52, 0x2d, 0x81, 0xa4, 0x2c, 0xea, 0xc3, 0x22, 0xd4, 0xaf, 0xe7, 0x50, 0xbf, 0x08, 0x99,
-	0x1a, 0x85, 0xc8, 0x84, 0x88, 0x2c, 0x99, 0x9c, 0x0a, 0x3c, 0xdf, 0x15, 0xd7, 0x50, 0x2a, 0x20,
-	0x95, 0xfc, 0xa9, 0xe0, 0x7d, 0x73, 0xb0, 0x6b, 0x43, 0x26, 0xbe, 0x14, 0xbc, 0x4f, 0xee, 0xc3,
-	0x46, 0xea, 0x59, 0x0e, 0x23, 0x3c, 0xd6, 0x9a, 0xdb, 0x48, 0x1e, 0xe6, 0x30, 0x22, 0xff, 0x07,
-	0x9b, 0x76, 0x92, 0x79, 0xdb, 0x9b, 0x38, 0xcb, 0x2e, 0x75, 0xf5, 0x13, 0x7f, 0x0c, 0x8d, 0xae,
-	0xba, 0xdf, 0x1d, 0x73, 0x3f, 0x6f, 0xa1, 0x1b, 0xeb, 0xdd, 0xe4, 0xc1, 0xa6, 0x1f, 0xc0, 0xad,
-	0x17, 0xfc, 0x95, 0x49, 0x5f, 0x2c, 0x64, 0x1c, 0x02, 0x4c, 0x98, 0x10, 0x93, 0x51, 0xa4, 0x6e,
-	0x69, 0xc9, 0xde, 0x78, 0xcb, 0xa1, 0xa7, 0x40, 0xd2, 0x8b, 0x92, 0x74, 0x67, 0x0e, 0xd0, 0xf8,
-	0xb0, 0xf3, 0x65, 0xa0, 0x94, 0xe6, 0xf4, 0xcc, 0x4f, 0xb7, 0xb2, 0x16, 0x94, 0xf3, 0x16, 0x28,
-	0x14, 0xe9, 0x4f, 0x23, 0x16, 0xbf, 0x58, 0x55, 0x37, 0xa6, 0x69, 0x1b, 0x6e, 0xe7, 0xb4, 0x15,
-	0x66, 0x42, 0xeb, 0x36, 0x13, 0x52, 0xdb, 0x79, 0xfe, 0x06, 0xc6, 0xd1, 0xf7, 0x60, 0xfb, 0xf9,
-	0x1b, 0x88, 0xff, 0x29, 0x6c, 0x5d, 0x7a, 0xc3, 0x20, 0x0d, 0xe5, 0xf3, 0x37, 0x6e, 0xaf, 0x56,
-	0x59, 0x87, 0x2a, 0x5e, 0xad, 0x26, 0x54, 0x98, 0x3f, 0x34, 0x69, 0xa1, 0xfa, 0xa4, 0x0f, 0xa0,
-	0x99, 0x88, 0x4c, 0x2e, 0xe5, 0xcc, 0xbb, 0xfb, 0x1b, 0xd8, 0x3f, 0xe7, 0x01, 0x8f, 0x14, 0x10,
-	0xc6, 0xc8, 0xb2, 0xdc, 0x88, 0x04, 0xf2, 0x85, 0xc2, 0x26, 0x6d, 0x8b, 0x81, 0x7c, 0xc4, 0xa6,
-	0xfb, 0xb0, 0xa1, 0xf2, 0x58, 0x21, 0xc3, 0x48, 0xbf, 0x0a, 0x15, 0x9c, 0xd2, 0xb0, 0x4c, 0x65,
-	0x18, 0x7d, 0x09, 0xad, 0x22, 0xe5, 0x49, 0xe1, 0x71, 0x15, 0x0d, 0xb4, 0x02, 0x6d, 0xf2, 0xda,
-	0x55, 0x34, 0x40, 0xe9, 0x77, 0xa0, 0xa6, 0x86, 0x26, 0x88, 0x7b, 0x5a, 0xb9, 0x9a, 0x8b, 0xa0,
-	0x47, 0x7f, 0x0b, 0x47, 0x6a, 0xeb, 0x29, 0x58, 0xba, 0x88, 0xc3, 0xc2, 0xee, 0xec, 0x63, 0xa8,
-	0xa7, 0x9f, 0xdc, 0x12, 0x02, 0xf6, 0x7e, 0x11, 0xec, 0xe9, 0xec, 0x2c, 0x3d, 0x7b, 0x59, 0xe8,
-	0xd1, 0x1f, 0xc0, 0xf1, 0x02, 0x03, 0x16, 0x1c, 0x86, 0xb2, 0x3c, 0x9b, 0x04, 0xfd, 0x8f, 0x2d,
-	0x6f, 0x43, 0xf3, 0xdc, 0x20, 0x5c, 0x6c, 0x68, 0x06, 0x06, 0x4b, 0x59, 0x18, 0xa4, 0xc7, 0x50,
-	0x5f, 0x96, 0x80, 0x3c, 0x82, 0xfa, 0x39, 0x4b, 0x0a, 0xaf, 0x26, 0x54, 0x54, 0xa9, 0xa0, 0x67,
-	0xa8, 0x4f, 0xc5, 0x49, 0xca, 0x0b, 0xf5, 0x49, 0x3f, 0x84, 0xcd, 0xcf, 0xf4, 0xfb, 0x6a, 0x57,
-	0xbd, 0x05, 0xab, 0xfa, 0xc5, 0xc5, 0x02, 0xa0, 0x7e, 0xd6, 0x30, 0x1b, 0xc6, 0x69, 0xae, 0x19,
-	0xa3, 0x8f, 0x60, 0x05, 0x19, 0x6f, 0xd0, 0x60, 0x78, 0x00, 0x8d, 0x8b, 0x49, 0x14, 0x0e, 0x52,
-	0xd9, 0x9a, 0xef, 0x09, 0xc9, 0x03, 0x9b, 0x6c, 0x6a, 0x8a, 0xbe, 0x0d, 0x1b, 0x66, 0xde, 0x92,
-	0xbb, 0xfc, 0x09, 0xdc, 0x3a, 0xe7, 0xf2, 0x29, 0xf6, 0x4b, 0xe2, 0xc9, 0x27, 0xb0, 0xaa, 0x3b,
-	0x28, 0xe6, 0xbc, 0x9a, 0xa7, 0xba, 0xb5, 0xa2, 0xf3, 0x02, 0x35, 0xd3, 0x8c, 0x53, 0x09, 0xbb,
-	0x5f, 0xf1, 0xc8, 0x1b, 0x5c, 0xab, 0x08, 0x62, 0x72, 0x1a, 0xc5, 0x07, 0xdf, 0x84, 0xca, 0x58,
-	0x0c, 0xad, 0xe3, 0xc6, 0x62, 0xa8, 0x9e, 0x39, 0x61, 0x67, 0x99, 0x4d, 0x25, 0x8c, 0xf4, 0xe5,
-	0xad, 0x64, 0x2f, 0xaf, 0x41, 0x8b, 0x6a, 0x82, 0x16, 0x3f, 0x81, 0xbd, 0x19, 0xad, 0x8b, 0xf7,
-	0x99, 0x6d, 0x24, 0x64, 0xc0, 0xef, 0x11, 0x26, 0xdb, 0x2f, 0xdc, 0x27, 0xd7, 0xe6, 0x91, 0x5b,
-	0x8e, 0x97, 0x5f, 0x61, 0xd5, 0xf7, 0xc2, 0xfd, 0x82, 0x05, 0x7d, 0x3f, 0xde, 0xf1, 0x0e, 0xac,
-	0x08, 0xc9, 0x22, 0x9b, 0x38, 0x6b, 0x02, 0xc3, 0x25, 0xe8, 0x9b, 0xba, 0x58, 0x7d, 0xa6, 0x2b,
-	0x7f, 0x8d, 0xf4, 0x71, 0xe5, 0xff, 0x9e, 0x6e, 0x69, 0xc4, 0x72, 0x93, 0x3d, 0x8d, 0x90, 0x63,
-	0x0f, 0x59, 0x53, 0xf4, 0x0c, 0x1c, 0x9c, 0xfe, 0xdc, 0x13, 0x52, 0x65, 0xd6, 0x69, 0x63, 0xe6,
-	0xad, 0x79, 0x8d, 0xe7, 0xad, 0xd7, 0xa4, 0x1f, 0x3a, 0x6b, 0x51, 0x29, 0x63, 0x51, 0xb2, 0xa7,
-	0x72, 0xc1, 0x9e, 0x2a, 0xc9, 0x9e, 0x8e, 0x4d, 0xac, 0x56, 0x31, 0xdc, 0x37, 0x4c, 0xb8, 0xbf,
-	0x70, 0x9f, 0x49, 0x3e, 0x36, 0xa1, 0x3b, 0x82, 0x55, 0x4d, 0x2f, 0xc0, 0x69, 0xa5, 0xae, 0x17,
-	0xc6, 0xe1, 0xa1, 0x09, 0x2c, 0xa7, 0x79, 0xdf, 0x63, 0xb6, 0x96, 0x33, 0x94, 0xe2, 0xbf, 0x4a,
-	0x5a, 0x09, 0x35, 0xd7, 0x50, 0xf4, 0xff, 0x71, 0x8f, 0x9f, 0x3e, 0xbb, 0xd0, 0x9b, 0x5c, 0x5c,
-	0xc1, 0xdf, 0xe8, 0x0a, 0xde, 0x4e, 0xfe, 0xaf, 0x79, 0x84, 0x66, 0x3c, 0xb2, 0x69, 0x3c, 0xf2,
-	0xe9, 0xb3, 0x8b, 0x94, 0x4b, 0xbe, 0x84, 0x35, 0xc3, 0x58, 0xe0, 0x93, 0x56, 0xaa, 0x26, 0x2f,
-	0xdb, 0xfa, 0xc2, 0xd4, 0xde, 0x85, 0x5d, 0x81, 0xb3, 0xef, 0x1b, 0x00, 0x8f, 0x27, 0xde, 0x25,
-	0x8f, 0xae, 0x54, 0xee, 0xf7, 0x0d, 0xd4, 0x53, 0x8d, 0x32, 0xb2, 0x67, 0x0f, 0x27, 0xd7, 0xa8,
-	0x6c, 0xd9, 0x34, 0xba, 0xa0, 0xab, 0x46, 0xf7, 0xbf, 0xfb, 0xfb, 0x3f, 0xff, 0x58, 0xde, 0x26,
-	0xb7, 0xda, 0x57, 0x8f, 0xda, 0x53, 0xc1, 0xa3, 0x76, 0xc0, 0xbb, 0x58, 0x8f, 0x90, 0x5f, 0xc2,
-	0xde, 0x73, 0x26, 0xb9, 0x90, 0xcf, 0xa2, 0x88, 0xa3, 0x97, 0xba, 0x3e, 0xc7, 0x2a, 0x6c, 0xbe,
-	0xaa, 0x1d, 0x33, 0x90, 0x29, 0xd6, 0xe8, 0x0e, 0x2a, 0xd9, 0x24, 0x8d, 0x58, 0x89, 0xef, 0x75,
-	0x49, 0x04, 0x5b, 0xb9, 0x7e, 0x14, 0xb9, 0x9b, 0x58, 0x5a, 0xd0, 0xf4, 0x6a, 0x1d, 0xce, 0x1b,
-	0x36, 0x7a, 0x8e, 0x50, 0x4f, 0x8b, 0xde, 0x8e, 0xf5, 0x30, 0xd3, 0x6f, 0x53, 0xd3, 0x3e, 0x2a,
-	0x3d, 0x24, 0x17, 0x50, 0x7d, 0xca, 0x7c, 0x9f, 0xcc, 0x7f, 0xa8, 0x5a, 0xdb, 0xb6, 0x31, 0x92,
-	0x6a, 0x4d, 0x51, 0x07, 0x25, 0x13, 0xba, 0x11, 0x4b, 0xee, 0x31, 0xdf, 0x57, 0x12, 0x6f, 0x80,
-	0xcc, 0x76, 0x18, 0xc8, 0x91, 0x11, 0x32, 0xb7, 0xf9, 0x10, 0xef, 0x65, 0x4e, 0xb7, 0x81, 0x52,
-	0xd4, 0x78, 0x40, 0xf7, 0x62, 0x8d, 0x11, 0x7b, 0x95, 0x7a, 0x43, 0x95, 0xee, 0x11, 0x6c, 0x66,
-	0xdb, 0x09, 0xe4, 0x20, 0xf1, 0xd0, 0x6c, 0x97, 0x61, 0xce, 0xe9, 0xcc, 0x6a, 0x1a, 0x66, 0x56,
-	0x2b, 0x4d, 0x01, 0x34, 0xf3, 0x7d, 0x05, 0x72, 0x38, 0xab, 0x2b, 0xdd, 0x70, 0x98, 0xa3, 0xed,
-	0x2d, 0xd4, 0x76, 0x48, 0xf7, 0x8b, 0xb4, 0xe1, 0x7a, 0xa5, 0xef, 0xbb, 0x12, 0x82, 0x77, 0xc6,
-	0x31, 0x3d, 0xee, 0x4d, 0x24, 0xa1, 0x89, 0xd6, 0x79, 0xfd, 0x87, 0xd6, 0x82, 0xba, 0x91, 0xbe,
-	0x83, 0xfa, 0xef, 0xd3, 0xc3, 0xb4, 0xfe, 0x59, 0x3d, 0xca, 0x88, 0xdf, 0x95, 0x10, 0x87, 0x0b,
-	0x7b, 0x16, 0xe4, 0xc1, 0x1c, 0x3b, 0x72, 0x4d, 0x8d, 0x85, 0xb6, 0xbc, 0x8b, 0xb6, 0x3c, 0xa0,
-	0xc7, 0x73, 0x6c, 0x49, 0xa4, 0x29, 0x73, 0x3a, 0x50, 0x8b, 0x7f, 0x61, 0xc4, 0x37, 0x30, 0xff,
-	0x03, 0xa4, 0xe5, 0xcc, 0x0e, 0x18, 0x6d, 0x77, 0x51, 0xdb, 0x1e, 0x25, 0xb1, 0x36, 0x61, 0xe7,
-	0x7c, 0x54, 0x7a, 0xf8, 0x7e, 0xc9, 0xe0, 0x89, 0x4d, 0xbc, 0xe6, 0x5f, 0x72, 0x3b, 0x90, 0x4f,
-	0xd1, 0xe8, 0x01, 0x6a, 0xd8, 0x25, 0x3b, 0xe9, 0xfd, 0xc4, 0xf2, 0xbe, 0x81, 0xfa, 0x67, 0x49,
-	0x47, 0x76, 0xd1, 0x15, 0x24, 0x89, 0x82, 0x58, 0xf6, 0x3d, 0x94, 0xbd, 0x4f, 0x13, 0xd9, 0xa9,
-	0xf6, 0xae, 0x72, 0x0f, 0x43, 0x38, 0xd1, 0xf9, 0x9a, 0xb9, 0x0d, 0x56, 0x4e, 0x3a, 0x36, 0x6e,
-	0xa7, 0x33, 0xb6, 0x44, 0xfc, 0x7d, 0x14, 0x7f, 0x97, 0x3a, 0x69, 0xd3, 0xd3, 0xc2, 0xb4, 0x0a,
-	0x48, 0x9a, 0xc2, 0xe4, 0x8e, 0x8d, 0xef, 0x82, 0xbe, 0x72, 0x6b, 0x3f, 0x09, 0x8f, 0x5c, 0x13,
-	0x99, 0xde, 0x41, 0x55, 0xb7, 0x69, 0x33, 0x56, 0xd5, 0xd7, 0x33, 0x94, 0x8a, 0x29, 0x6c, 0xe5,
-	0x32, 0xa0, 0x18, 0x14, 0x8b, 0xf3, 0xb1, 0x18, 0x48, 0xe6, 0x24, 0x4e, 0x05, 0x3b, 0xbb, 0xca,
-	0xce, 0xfc, 0xa8, 0xf4, 0xf0, 0xec, 0x6f, 0x00, 0x8d, 0xc7, 0xfd, 0xb1, 0x17, 0xd8, 0xb7, 0xe5,
-	0x6b, 0x58, 0xb7, 0xff, 0x2a, 0x96, 0x07, 0x42, 0xfe, 0xaf, 0x06, 0x6d, 0xa1, 0xce, 0x1d, 0x82,
-	0xa1, 0xc6, 0x94, 0xdc, 0x18, 0x89, 0x49, 0x0f, 0x20, 0x29, 0xc9, 0x89, 0x0d, 0xd7, 0x99, 0xd2,
-	0x3e, 0xf6, 0xe0, 0x6c, 0xfd, 0x9e, 0xc5, 0xf9, 0x8c, 0xf8, 0x76, 0xc0, 0x5f, 0x29, 0x37, 0x86,
-	0xb0, 0x91, 0xa9, 0xac, 0xe3, 0xc3, 0x2a, 0xaa, 0xee, 0x5b, 0x07, 0xc5, 0x83, 0x45, 0x0e, 0xcc,
-	0x6a, 0x9b, 0xe2, 0x02, 0xa5, 0x70, 0x08, 0xf5, 0x54, 0xa5, 0x1d, 0x07, 0xf7, 0x6c, 0xb5, 0x1e,
-	0x03, 0x42, 0x41, 0x61, 0x4e, 0x8f, 0x51, 0xd5, 0x1d, 0xba, 0x3b, 0xab, 0xca, 0x2a, 0x0a, 0x60,
-	0x2b, 0xf7, 0x64, 0x2c, 0xba, 0x49, 0xcb, 0x5e, 0x99, 0x02, 0x4f, 0xe6, 0xde, 0x98, 0x9f, 0xc3,
-	0xba, 0x2d, 0xe0, 0x89, 0xfd, 0x67, 0x90, 0x6b, 0x12, 0xc4, 0x71, 0x90, 0xaf, 0xf4, 0xe9, 0x21,
-	0x8a, 0x77, 0xe8, 0x76, 0x22, 0x5e, 0x15, 0x06, 0xed, 0x91, 0xb9, 0x50, 0xdf, 0x95, 0x54, 0x92,
-	0x96, 0xaf, 0xbc, 0xe3, 0xd7, 0x73, 0x6e, 0x47, 0xa0, 0x75, 0xbc, 0x60, 0x86, 0xd1, 0xfd, 0x36,
-	0xea, 0x3e, 0xa6, 0x07, 0x89, 0xee, 0xe1, 0xcc, 0x6c, 0x65, 0xc4, 0xef, 0x4b, 0x70, 0x37, 0x57,
-	0x27, 0xff, 0xcc, 0x93, 0xa3, 0xa4, 0xe4, 0x25, 0x6f, 0xa7, 0xf6, 0xb7, 0xa8, 0x28, 0x6e, 0x9d,
-	0x2c, 0x9f, 0x98, 0xcd, 0xbb, 0xe8, 0x66, 0xd6, 0x33, 0xca, 0x9e, 0x3f, 0x29, 0x7b, 0xb2, 0xe7,
-	0x35, 0xcf, 0x9e, 0x25, 0x45, 0xfa, 0xd2, 0xe3, 0x3f, 0x45, 0x2b, 0x4e, 0xe8, 0xfd, 0xc2, 0xe3,
-	0xcf, 0x6a, 0x55, 0xa6, 0x5d, 0x02, 0x5c, 0xaa, 0xb4, 0x18, 0x4b, 0x50, 0x62, 0x33, 0xa5, 0x74,
-	0xe1, 0x1a, 0xbf, 0xfa, 0x99, 0x2a, 0xd5, 0x02, 0x02, 0xdd, 0x4a, 0x14, 0x4d, 0xd4, 0x04, 0x1d,
-	0x61, 0xb5, 0xb8, 0x52, 0x9d, 0x8f, 0x35, 0x4e, 0x02, 0xa8, 0xd9, 0xa2, 0xd6, 0xe2, 0x29, 0xd9,
-	0x4e, 0x1f, 0xb4, 0x95, 0xf7, 0x35, 0xac, 0xdb, 0x7f, 0xf4, 0xcb, 0x71, 0x2c, 0xff, 0x37, 0xbf,
-	0x08, 0xc7, 0x82, 0xb0, 0xcf, 0xbd, 0x60, 0x10, 0x76, 0x57, 0xf1, 0xa7, 0xd7, 0x07, 0xff, 0x0a,
-	0x00, 0x00, 0xff, 0xff, 0xbf, 0xa2, 0x0b, 0x8e, 0x28, 0x21, 0x00, 0x00,
+	// 3928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0xdc, 0x48,
+	0x76, 0x47, 0x7f, 0x48, 0xea, 0x7e, 0xdd, 0x2d, 0xb5, 0x28, 0xd9, 0xa2, 0x68, 0x5b, 0x96, 0xca,
+	0x9b, 0x19, 0xd9, 0x3b, 0x96, 0xd6, 0x1a, 0x60, 0x76, 0xe1, 0x4d, 0x02, 0x78, 0x3c, 0x5e, 0xd9,
+	0x89, 0x63, 0x28, 0x94, 0x67, 0x76, 0x91, 0x64, 0xd3, 0xcb, 0x26, 0xab, 0xbb, 0xb9, 0xc3, 0x26,
+	0x99, 0x62, 0xb5, 0x3e, 0x9c, 0x00, 0x01, 0xe6, 0x9a, 0x04, 0x39, 0xe4, 0x12, 0x24, 0xc1, 0x9e,
+	0xf2, 0xf7, 0xe4, 0xb2, 0x08, 0x72, 0xcd, 0x21, 0xff, 0x40, 0x2e, 0x39, 0x06, 0x41, 0x7d, 0xb1,
+	0x8a, 0x6c, 0x52, 0x8a, 0x83, 0x60, 0x6f, 0x7c, 0xaf, 0x5e, 0xd5, 0x7b, 0xf5, 0xea, 0xd5, 0xaf,
+	0x5e, 0xbd, 0x22, 0x74, 0x49, 0xea, 0x1f, 0xa5, 0x24, 0xa1, 0x89, 0xb5, 0x42, 0x52, 0x3f, 0x1d,
+	0x3b, 0xf7, 0xa7, 0x49, 0x32, 0x8d, 0xf0, 0xb1, 0x97, 0x86, 0xc7, 0x5e, 0x1c, 0x27, 0xd4, 0xa3,
+	0x61, 0x12, 0x67, 0x42, 0xc8, 0xf9, 0xd1, 0x34, 0xa4, 0xb3, 0xc5, 0xf8, 0xc8, 0x4f, 0xe6, 0xc7,
+	0x31, 0x1e, 0x2f, 0x22, 0x2f, 0x0b, 0x93, 0xe3, 0x69, 0xf2, 0x54, 0x12, 0xc7, 0x7e, 0x12, 0x67,
+	0x38, 0xce, 0x16, 0xd9, 0x71, 0x3a, 0x3e, 0xce, 0xa8, 0x47, 0xb1, 0xec, 0xf9, 0xc5, 0x6d, 0x3d,
+	0x63, 0x3c, 0x8e, 0x30, 0x65, 0xdd, 0xfc, 0x24, 0x9e, 0x84, 0x53, 0xd1, 0x0f, 0x3d, 0x81, 0xe1,
+	0xf9, 0x62, 0x9c, 0xf9, 0x24, 0x1c, 0x63, 0x17, 0xff, 0xd9, 0x02, 0x67, 0xd4, 0xba, 0x0b, 0xab,
+	0x34, 0x49, 0x43, 0x3f, 0xb3, 0x1b, 0xfb, 0xad, 0xc3, 0xae, 0x2b, 0x29, 0xf4, 0x3b, 0xb0, 0x69,
+	0xc8, 0x66, 0x29, 0xb3, 0xc5, 0xda, 0x86, 0x15, 0xde, 0x6c, 0x37, 0xf6, 0x1b, 0x87, 0x5d, 0x57,
+	0x10, 0x96, 0x05, 0xed, 0xc0, 0xa3, 0x9e, 0xdd, 0xe4, 0x4c, 0xfe, 0x8d, 0x2c, 0x18, 0xbe, 0x4b,
+	0xe2, 0x33, 0x8f, 0x78, 0xf3, 0x4c, 0xaa, 0x42, 0xff, 0xd4, 0x64, 0xcc, 0x00, 0xbf, 0x89, 0x27,
+	0x49, 0x3e, 0xe4, 0x3a, 0x34, 0xc3, 0x40, 0x8e, 0xd7, 0x0c, 0x03, 0x6b, 0x17, 0x3a, 0xfe, 0xcc,
+	0x0b, 0xe3, 0x51, 0x18, 0xf0, 0x01, 0x07, 0xee, 0x1a, 0xa7, 0xdf, 0x04, 0x96, 0x03, 0x1d, 0x3f,
+	0x09, 0xe3, 0xb1, 0x97, 0x61, 0xbb, 0xc5, 0x3b, 0xe4, 0xb4, 0xf5, 0x00, 0x20, 0xc5, 0x98, 0x8c,
+	0xfc, 0x64, 0x11, 0x53, 0xbb, 0xcd, 0x3b, 0x76, 0x19, 0xe7, 0x25, 0x63, 0x58, 0x08, 0xfa, 0xd9,
+	0x75, 0xec, 0xcf, 0x48, 0x12, 0x87, 0x1f, 0x70, 0x60, 0xaf, 0xec, 0x37, 0x0e, 0x3b, 0x6e, 0x81,
+	0x67, 0x3d, 0x84, 0xde, 0x78, 0xe1, 0x7f, 0x8b, 0xe9, 0x28, 0x0b, 0x3f, 0x60, 0x7b, 0x75, 0xbf,
+	0x71, 0xb8, 0xe2, 0x82, 0x60, 0x9d, 0x87, 0x1f, 0xb0, 0xf5, 0x18, 0x86, 0xdc, 0x8f, 0x7e, 0x12,
+	0x8d, 0x2e, 0x30, 0xc9, 0xc2, 0x24, 0xb6, 0x81, 0xdb, 0xb1, 0xa1, 0xf8, 0xdf, 0x08, 0xb6, 0x75,
+	0x02, 0x3d, 0x92, 0x2c, 0x28, 0x1e, 0x51, 0x6f, 0x1c, 0x61, 0xbb, 0xb7, 0xdf, 0x3a, 0xec, 0x9d,
+	0x6c, 0x1e, 0xf1, 0xb0, 0x38, 0x72, 0x59, 0xcb, 0x7b, 0xd6, 0xe0, 0x02, 0xc9, 0xbf, 0xd1, 0x17,
+	0x00, 0xba, 0x65, 0xc9, 0x2f, 0x36, 0xac, 0x79, 0x41, 0x40, 0x70, 0x96, 0xd9, 0x4d, 0xbe, 0x50,
+	0x8a, 0x44, 0xff, 0xd6, 0x80, 0xad, 0x53, 0x4c, 0xdf, 0xe1, 0xf1, 0x39, 0x8b, 0x91, 0xdc, 0xb3,
+	0xa6, 0x27, 0x1b, 0x45, 0x4f, 0x5a, 0xd0, 0xa6, 0x5e, 0x18, 0xa9, 0x15, 0x63, 0xdf, 0xd6, 0x10,
+	0x5a, 0x51, 0x38, 0x96, 0x8e, 0x65, 0x9f, 0x2c, 0x34, 0x66, 0x38, 0x9c, 0xce, 0x84, 0x3f, 0xdb,
+	0xae, 0xa4, 0x2a, 0xfd, 0xb0, 0x5a, 0xed, 0x87, 0xb2, 0xdf, 0xd7, 0x2a, 0xfc, 0x6e, 0xc3, 0x9a,
+	0x1a, 0xa5, 0xc3, 0x47, 0x51, 0x24, 0xfa, 0xd7, 0x26, 0x6c, 0x9f, 0x5f, 0xc7, 0xfe, 0x19, 0x49,
+	0xa6, 0x6c, 0xaa, 0xf9, 0xd4, 0x6c, 0x58, 0x63, 0x43, 0x84, 0xf1, 0x94, 0xcf, 0xac, 0xe3, 0x2a,
+	0xd2, 0xfa, 0x14, 0x36, 0x32, 0xea, 0x11, 0x1a, 0xc6, 0xd3, 0x91, 0x34, 0xbe, 0xc9, 0x8d, 0x5f,
+	0x57, 0xec, 0xd7, 0x62, 0x12, 0xbf, 0x05, 0xeb, 0xfe, 0x82, 0x10, 0x1c, 0x53, 0x25, 0xd7, 0xe2,
+	0x72, 0x03, 0xc9, 0xd5, 0x62, 0xb3, 0x70, 0x3a, 0xc3, 0x19, 0x1d, 0x15, 0x7c, 0x31, 0x90, 0x5c,
+	0x2d, 0x96, 0xe2, 0x38, 0x60, 0x5a, 0xfd, 0xd9, 0x22, 0xfe, 0x36, 0xe3, 0x11, 0x36, 0x70, 0x07,
+	0x92, 0xfb, 0x92, 0x33, 0x99, 0x75, 0x61, 0x3c, 0x89, 0x58, 0x17, 0x25, 0xb7, 0xca, 0xe5, 0xd6,
+	0x15, 0x5b, 0x0a, 0x3e, 0x82, 0x41, 0x90, 0x5c, 0xc6, 0x51, 0xe2, 0x05, 0x23, 0xe2, 0x51, 0xcc,
+	0x1d, 0xd7, 0x70, 0xfb, 0x8a, 0xe9, 0x7a, 0x14, 0x33, 0xa5, 0xf8, 0x0a, 0xfb, 0x0b, 0x06, 0x2a,
+	0x42, 0xaa, 0xc3, 0xa5, 0x06, 0x39, 0x97, 0x8b, 0x0d, 0xa1, 0x85, 0xa9, 0x67, 0x77, 0xf7, 0x1b,
+	0x87, 0x2d, 0x97, 0x7d, 0xa2, 0x1f, 0xc0, 0xf0, 0x85, 0xcf, 0x77, 0x8a, 0x76, 0xe9, 0x7d, 0xe8,
+	0xca, 0x80, 0xc2, 0x0a, 0x0a, 0x34, 0x03, 0xfd, 0x1e, 0xdc, 0x3d, 0xc5, 0x54, 0x76, 0x92, 0x61,
+	0x26, 0xf0, 0xc3, 0x88, 0x4b, 0x11, 0xac, 0x8a, 0x34, 0xc2, 0xa7, 0x69, 0x86, 0x0f, 0xfa, 0xdb,
+	0x06, 0xec, 0x2c, 0x0d, 0xa6, 0x17, 0x76, 0xec, 0x45, 0x5e, 0xec, 0x63, 0x35, 0x9a, 0x24, 0x19,
+	0xf4, 0xc4, 0x09, 0xe3, 0x8b, 0xc1, 0x04, 0xc1, 0x03, 0xf9, 0x3a, 0x15, 0x70, 0x30, 0x70, 0xf9,
+	0x77, 0x6d, 0xd8, 0xda, 0xb0, 0x26, 0x57, 0x83, 0x2f, 0x4e, 0xdb, 0x55, 0x24, 0xfa, 0x25, 0xf4,
+	0x5f, 0x7a, 0x51, 0x94, 0x5b, 0x71, 0x17, 0x56, 0x09, 0xce, 0x16, 0x11, 0x95, 0x46, 0x48, 0x8a,
+	0x21, 0x84, 0x70, 0x2d, 0x1e, 0x61, 0x42, 0xe4, 0xee, 0x01, 0xc9, 0x7a, 0x45, 0x88, 0x75, 0x00,
+	0x7d, 0x9c, 0xd1, 0x70, 0xee, 0x51, 0x3c, 0x9a, 0x7a, 0x99, 0xdc, 0x4c, 0x3d, 0xc5, 0x3b, 0xf5,
+	0x32, 0x74, 0x04, 0xdb, 0x5f, 0x5e, 0x7f, 0x19, 0x25, 0xfe, 0xb7, 0x22, 0x74, 0x0c, 0x1c, 0x96,
+	0x56, 0x37, 0x0a, 0xde, 0xfa, 0x0c, 0xac, 0x53, 0x4c, 0xbf, 0xba, 0x8e, 0xbd, 0x8c, 0x5e, 0x9b,
+	0x16, 0xce, 0xc3, 0x18, 0x93, 0x1c, 0xb5, 0x05, 0x85, 0x4e, 0x61, 0xe7, 0x0f, 0xd8, 0xd7, 0x19,
+	0x26, 0x93, 0x84, 0xcc, 0x99, 0xe7, 0x0c, 0x05, 0x19, 0x26, 0xa1, 0x17, 0x71, 0x05, 0x2d, 0x57,
+	0x52, 0xcc, 0xb1, 0x02, 0x34, 0x05, 0xda, 0x0a, 0x02, 0x9d, 0x83, 0xbd, 0x3c, 0x90, 0x54, 0xfe,
+	0x43, 0xe8, 0x06, 0xdc, 0x9e, 0x50, 0x86, 0x4a, 0xef, 0x64, 0x57, 0x42, 0x9b, 0xb4, 0xd3, 0xec,
+	0xa5, 0x65, 0x51, 0x04, 0xc3, 0x57, 0x17, 0x61, 0x80, 0xcd, 0xc1, 0x1e, 0xeb, 0x55, 0x11, 0x43,
+	0x6d, 0xc8, 0xa1, 0x72, 0x49, 0xd5, 0x6e, 0x3d, 0x85, 0xae, 0x9f, 0xcc, 0xe7, 0x21, 0xa5, 0x38,
+	0xb0, 0x9b, 0xd5, 0xc2, 0x5a, 0x02, 0xfd, 0xaa, 0x01, 0x1d, 0xc5, 0x67, 0xb3, 0xe4, 0x2e, 0x52,
+	0x27, 0x17, 0x27, 0x58, 0xd0, 0xd3, 0x70, 0x8e, 0x33, 0xea, 0xcd, 0x53, 0x3e, 0xff, 0x96, 0xab,
+	0x19, 0x2c, 0xb8, 0xf0, 0x45, 0x18, 0xc9, 0x55, 0xe4, 0xdf, 0xcc, 0x8b, 0x63, 0xb6, 0x78, 0x99,
+	0xdd, 0x16, 0x8e, 0x17, 0x94, 0xb1, 0x7c, 0x2b, 0x85, 0xa0, 0x73, 0xa0, 0x43, 0x70, 0x9a, 0x10,
+	0x8a, 0x89, 0xc4, 0xc8, 0x9c, 0x46, 0xbf, 0x6e, 0xc0, 0xbd, 0x9f, 0x84, 0xb1, 0x17, 0x85, 0xf4,
+	0xfa, 0x25, 0x26, 0x34, 0x9c, 0x84, 0xbe, 0xb9, 0x19, 0x2c, 0x68, 0xcf, 0xbc, 0x6c, 0x26, 0x4d,
+	0xe6, 0xdf, 0x75, 0x9b, 0x8a, 0xc3, 0x99, 0x18, 0x02, 0x07, 0x23, 0xde, 0x4b, 0x58, 0x3d, 0xc8,
+	0xb9, 0xaf, 0x59, 0xf7, 0xc7, 0x30, 0x34, 0xc4, 0xcc, 0x5d, 0xb2, 0xa1, 0x05, 0xc5, 0x88, 0x5f,
+	0x40, 0xf7, 0x32, 0xa4, 0xb1, 0x00, 0x84, 0x15, 0xee, 0x6d, 0x5b, 0x7a, 0xfb, 0xc5, 0x74, 0x4a,
+	0xf0, 0xd4, 0xa3, 0x38, 0xf8, 0xa9, 0x90, 0x70, 0xb5, 0x28, 0xfa, 0xab, 0x06, 0x6c, 0x2e, 0x09,
+	0x18, 0x7e, 0x6b, 0x94, 0xfd, 0x26, 0xa3, 0xb2, 0x59, 0x88, 0x4a, 0x26, 0x1f, 0xd2, 0xb9, 0x97,
+	0xca, 0x79, 0x48, 0x8a, 0x23, 0x7f, 0x38, 0xe5, 0x91, 0x2f, 0x16, 0x40, 0x91, 0x6c, 0x85, 0xd9,
+	0xa7, 0xb0, 0xb5, 0xeb, 0x0a, 0x02, 0x9d, 0xc2, 0x9d, 0x97, 0x2a, 0x8d, 0x2a, 0xe0, 0x56, 0xcd,
+	0x7e, 0xab, 0x33, 0x08, 0xfd, 0x63, 0x13, 0xee, 0x96, 0x47, 0xd2, 0x9b, 0xb1, 0x72, 0x28, 0xb5,
+	0x7e, 0x4d, 0x63, 0xfd, 0x0a, 0x11, 0xd7, 0x2a, 0x47, 0x9c, 0x56, 0xde, 0x2e, 0x78, 0xe3, 0x00,
+	0xfa, 0x62, 0x17, 0x5d, 0x8f, 0x48, 0x92, 0x88, 0x18, 0xeb, 0xba, 0x3d, 0xc9, 0x73, 0x93, 0x84,
+	0xa3, 0x9b, 0x24, 0xed, 0x55, 0xe1, 0x18, 0x49, 0x5a, 0x47, 0x00, 0xbe, 0x17, 0x07, 0x61, 0xe0,
+	0x51, 0x9c, 0xd9, 0x6b, 0x7c, 0x25, 0xd7, 0xe5, 0x4a, 0x9e, 0x25, 0x01, 0xcb, 0xc8, 0x5c, 0x43,
+	0xc2, 0x3a, 0x81, 0x7e, 0xca, 0x8e, 0x4a, 0x3f, 0x4c, 0xbd, 0x98, 0x66, 0x76, 0xa7, 0xb2, 0x47,
+	0x41, 0x06, 0xa5, 0xb0, 0x26, 0x1b, 0x96, 0x12, 0x97, 0xc7, 0x30, 0x9c, 0x61, 0x8f, 0xd0, 0x31,
+	0xf6, 0xe8, 0xa8, 0xe0, 0xda, 0x8d, 0x9c, 0x7f, 0x9e, 0x43, 0x91, 0xd8, 0xa4, 0x2d, 0x73, 0x93,
+	0xb2, 0x85, 0xf5, 0x13, 0x82, 0xb9, 0x4f, 0xba, 0xae, 0x20, 0xd0, 0x31, 0xec, 0x9c, 0x91, 0x24,
+	0x4d, 0x32, 0x4c, 0xce, 0xfd, 0x19, 0x0e, 0x16, 0x51, 0xbe, 0xb4, 0x39, 0xa2, 0x35, 0x4c, 0x44,
+	0x7b, 0x05, 0xf6, 0x72, 0x87, 0x1c, 0x84, 0x56, 0xb2, 0x28, 0xa1, 0x0a, 0xcd, 0xb6, 0xd4, 0x5c,
+	0x95, 0x7c, 0x94, 0x50, 0x57, 0x48, 0xa0, 0x5f, 0x40, 0xdf, 0x64, 0x17, 0x17, 0xb4, 0x51, 0xbf,
+	0xa0, 0xc5, 0xf0, 0x76, 0xa0, 0x93, 0xca, 0x51, 0x54, 0x2a, 0xab, 0x68, 0xf4, 0x7d, 0xd8, 0x72,
+	0x71, 0x42, 0xa6, 0xaf, 0xc3, 0x8c, 0x26, 0xe4, 0xda, 0x98, 0x55, 0x14, 0xce, 0xc3, 0x7c, 0x56,
+	0x9c, 0x40, 0xbf, 0x0d, 0xdb, 0x45, 0x61, 0x39, 0xa3, 0xef, 0xb1, 0x23, 0x2c, 0x21, 0x53, 0x35,
+	0xa5, 0xbe, 0xca, 0x3d, 0x19, 0xd3, 0x95, 0x6d, 0xe8, 0x9f, 0x9b, 0xb0, 0xc2, 0x39, 0xd6, 0x67,
+	0xd0, 0x49, 0xa2, 0x60, 0xc4, 0xb3, 0x42, 0xa6, 0xc0, 0xc8, 0x56, 0x59, 0x3b, 0x3f, 0xb0, 0xdc,
+	0xb5, 0x24, 0x0a, 0xde, 0xb3, 0x5c, 0xf1, 0x33, 0xe8, 0xc4, 0xf8, 0x72, 0x94, 0xe7, 0x90, 0xd5,
+	0xd2, 0x31, 0xbe, 0xe4, 0xd2, 0x4f, 0xa1, 0xc3, 0x4e, 0x02, 0x66, 0xa0, 0xdd, 0xaa, 0x93, 0xce,
+	0x45, 0xd8, 0x44, 0x03, 0x9c, 0xd2, 0x99, 0x04, 0x26, 0x41, 0xf0, 0x3c, 0x23, 0x4d, 0xa3, 0x50,
+	0x26, 0xef, 0x6d, 0x57, 0x91, 0x6c, 0x73, 0x10, 0x7c, 0x81, 0x09, 0xc5, 0xc1, 0x88, 0x5e, 0x89,
+	0x8c, 0xaa, 0xed, 0xf6, 0x14, 0xef, 0xfd, 0x55, 0xc6, 0x0e, 0x6e, 0x29, 0xcd, 0x25, 0xd6, 0xb8,
+	0x04, 0x48, 0x16, 0x13, 0x28, 0xac, 0x62, 0xa7, 0xb4, 0x8a, 0xe8, 0x47, 0x00, 0xda, 0xd2, 0x8f,
+	0xd9, 0xee, 0xe8, 0x5f, 0x1a, 0x60, 0x2d, 0x9f, 0x89, 0xb5, 0x67, 0xf1, 0x01, 0xf4, 0x79, 0x9a,
+	0x5a, 0x4c, 0x5d, 0x7b, 0x9c, 0x27, 0x61, 0xf9, 0x01, 0x00, 0x8e, 0x83, 0x62, 0xce, 0xda, 0xc5,
+	0xb1, 0x42, 0xed, 0xe3, 0x3c, 0x31, 0x68, 0xf3, 0x75, 0xdf, 0x91, 0x9e, 0x5e, 0x3a, 0xcc, 0xa5,
+	0x98, 0xf5, 0x14, 0xac, 0x1c, 0xbb, 0x47, 0x04, 0xfb, 0x09, 0x09, 0xf2, 0xfb, 0xd1, 0x66, 0xde,
+	0xe2, 0xca, 0x06, 0xf4, 0x9f, 0x0d, 0x18, 0x96, 0xc7, 0xaa, 0x39, 0x5c, 0x1d, 0xe8, 0xe0, 0xab,
+	0x14, 0xfb, 0xe2, 0xb4, 0x66, 0x31, 0x9b, 0xd3, 0x32, 0xfe, 0x83, 0x85, 0x8f, 0x03, 0x99, 0xbb,
+	0xe5, 0xb4, 0xc8, 0x6d, 0xb2, 0x0c, 0x07, 0xf2, 0x1a, 0x27, 0xa9, 0x4a, 0x20, 0x59, 0xa9, 0x05,
+	0x12, 0x01, 0x19, 0xab, 0x06, 0x64, 0xb0, 0x45, 0xd6, 0x27, 0xda, 0x9a, 0xb8, 0x22, 0xe6, 0x0c,
+	0x16, 0x60, 0xe2, 0x64, 0xce, 0x78, 0x00, 0x0c, 0x5c, 0x45, 0xa2, 0x7f, 0x68, 0x82, 0xf5, 0x9e,
+	0x78, 0x71, 0xe6, 0xf9, 0x3c, 0xa9, 0x96, 0x1b, 0xd2, 0x82, 0xf6, 0x84, 0x24, 0x73, 0x75, 0x3c,
+	0xb3, 0x6f, 0x06, 0x7e, 0x34, 0x91, 0x11, 0xd0, 0xa4, 0x09, 0x33, 0xe4, 0xc2, 0x8b, 0x16, 0xea,
+	0xbe, 0x2a, 0x08, 0x9d, 0xcb, 0xb6, 0xcd, 0x5c, 0xf6, 0x1e, 0x74, 0xa7, 0x5e, 0x36, 0x4a, 0x49,
+	0xe8, 0x63, 0x89, 0xf0, 0x9d, 0xa9, 0x97, 0x9d, 0x91, 0x50, 0x37, 0x0a, 0x04, 0x58, 0xcd, 0x1b,
+	0xdf, 0x32, 0xda, 0x3a, 0x61, 0x17, 0xe3, 0x98, 0x12, 0xcf, 0xa7, 0x7c, 0x5e, 0xbd, 0x93, 0xbb,
+	0x72, 0xd9, 0x5f, 0x4a, 0xb6, 0xb4, 0xd9, 0xcd, 0xe5, 0xc4, 0x01, 0x1b, 0x7b, 0xe4, 0x9a, 0x5f,
+	0x61, 0xfb, 0xae, 0xa4, 0xe4, 0xca, 0xf0, 0x4b, 0x9c, 0xdd, 0xe3, 0x2d, 0x39, 0x9d, 0x67, 0xdb,
+	0xdb, 0x62, 0xc6, 0xec, 0x1b, 0x7d, 0x80, 0x8d, 0x92, 0x12, 0x1e, 0xdd, 0xc9, 0x82, 0xe4, 0x39,
+	0xbc, 0xa4, 0xd8, 0x2e, 0x14, 0x5f, 0x23, 0x3e, 0x8a, 0x4c, 0x9f, 0x05, 0xeb, 0x3d, 0xcb, 0xdc,
+	0x1d, 0xe8, 0x4c, 0x16, 0x31, 0x77, 0xb2, 0x42, 0x45, 0x45, 0x33, 0xdd, 0x1e, 0x83, 0x33, 0x71,
+	0x08, 0xf0, 0x6f, 0x74, 0x0c, 0xbb, 0xe7, 0x38, 0x0e, 0x5c, 0xef, 0xb2, 0x7a, 0x79, 0x78, 0x55,
+	0xa2, 0xc1, 0x27, 0xc1, 0xbf, 0xd1, 0x9f, 0xc0, 0x0e, 0xeb, 0x50, 0x90, 0xd6, 0x87, 0x38, 0xbd,
+	0x32, 0xd2, 0x2d, 0x49, 0xf1, 0x8c, 0x49, 0xce, 0x6f, 0xa4, 0x2f, 0xe0, 0xfc, 0xb2, 0xab, 0xf8,
+	0x2f, 0x04, 0x1b, 0x8d, 0xe0, 0xce, 0x29, 0xa6, 0x1c, 0x24, 0xbe, 0xbc, 0x66, 0xe9, 0x96, 0x61,
+	0xca, 0x52, 0x22, 0x77, 0x02, 0x77, 0x26, 0x8b, 0x28, 0x1a, 0x4d, 0xc2, 0x28, 0x1a, 0x51, 0x6d,
+	0x10, 0x1f, 0xbc, 0xe3, 0x6e, 0xb1, 0xc6, 0x9f, 0x84, 0x51, 0x64, 0xd8, 0x8a, 0x30, 0xec, 0x18,
+	0x0a, 0xfe, 0x37, 0xd7, 0x87, 0xff, 0x93, 0x9a, 0x67, 0x70, 0xef, 0x14, 0x53, 0x83, 0x73, 0xeb,
+	0x6c, 0xd0, 0x8f, 0xe1, 0x61, 0xb9, 0x4b, 0x39, 0x2a, 0x6a, 0x2f, 0x8a, 0xe8, 0x57, 0x6d, 0x18,
+	0x88, 0x43, 0xe0, 0xa6, 0xcc, 0xf7, 0x21, 0xf4, 0x52, 0x4f, 0xdc, 0xd7, 0x35, 0xca, 0x82, 0x60,
+	0xbd, 0x2e, 0xa6, 0xc6, 0xad, 0x82, 0x0b, 0xaa, 0x77, 0x9b, 0x59, 0x4c, 0x5a, 0x29, 0x15, 0x93,
+	0x0a, 0xa7, 0xc1, 0x6a, 0xf9, 0x4c, 0x37, 0xeb, 0x2a, 0x6b, 0xc5, 0xba, 0xca, 0x03, 0x00, 0x5e,
+	0xa7, 0x13, 0x59, 0x9a, 0xa8, 0x66, 0x74, 0x39, 0x87, 0xe7, 0x68, 0xbb, 0xd0, 0xa1, 0x57, 0x99,
+	0x68, 0xec, 0x0a, 0x1f, 0xd0, 0xab, 0x8c, 0x37, 0xb1, 0xab, 0xe5, 0x05, 0x8e, 0xa9, 0x6c, 0x15,
+	0x65, 0x25, 0x10, 0x2c, 0x2e, 0xf0, 0x02, 0xd6, 0xf3, 0x7a, 0xa0, 0x90, 0xe9, 0xf1, 0x9d, 0xee,
+	0x1c, 0xe5, 0x6c, 0xb1, 0xdf, 0xc5, 0x37, 0xeb, 0xe3, 0x0e, 0x7c, 0x93, 0xd4, 0x30, 0xdd, 0x37,
+	0x61, 0x7a, 0x0f, 0x80, 0x78, 0x71, 0x90, 0xcc, 0xcf, 0x31, 0x0e, 0xec, 0x81, 0x50, 0xac, 0x39,
+	0xd6, 0x3e, 0xf4, 0x04, 0x75, 0x46, 0x92, 0x64, 0x62, 0xaf, 0x8b, 0xd4, 0xd3, 0x60, 0x31, 0xdb,
+	0xc3, 0x6c, 0x34, 0x91, 0x37, 0x19, 0x7b, 0x83, 0x47, 0x16, 0x84, 0x99, 0xba, 0xdb, 0x58, 0xbf,
+	0x0b, 0x7d, 0x23, 0xf4, 0x32, 0x3b, 0xe0, 0x47, 0x93, 0x23, 0x31, 0xaa, 0x62, 0x37, 0xba, 0x05,
+	0x79, 0xf4, 0xef, 0x2d, 0xd8, 0xaa, 0xda, 0xb3, 0x55, 0x61, 0x62, 0x83, 0x5a, 0x8d, 0x72, 0xf9,
+	0x50, 0xe1, 0x75, 0x6b, 0x09, 0xaf, 0xdb, 0xcb, 0x78, 0xbd, 0x52, 0x89, 0xd7, 0xab, 0x66, 0x04,
+	0x15, 0xa2, 0x64, 0xad, 0xe2, 0xf2, 0xc8, 0x51, 0xae, 0xa3, 0xb1, 0x32, 0x87, 0xa4, 0xae, 0x86,
+	0xa4, 0x22, 0xea, 0xc3, 0x4d, 0xa8, 0xdf, 0x2b, 0xa1, 0x7e, 0x15, 0x32, 0xf5, 0x2b, 0x91, 0x89,
+	0x23, 0x32, 0xf5, 0xe8, 0x22, 0xe3, 0xeb, 0xbb, 0xe2, 0x4a, 0x8a, 0x05, 0x24, 0x1b, 0x7f, 0xc1,
+	0x0e, 0x5b, 0xb1, 0xb0, 0x6b, 0x53, 0x2f, 0xfb, 0x9a, 0x9d, 0xb6, 0x8f, 0x60, 0x60, 0xd4, 0x3a,
+	0x12, 0xc2, 0x97, 0xb5, 0xeb, 0xf6, 0x75, 0xb5, 0x23, 0x21, 0xba, 0x02, 0x85, 0x47, 0xb2, 0x60,
+	0x32, 0x14, 0xb7, 0x4e, 0xc9, 0x75, 0x39, 0x93, 0xa5, 0x35, 0xfc, 0xba, 0xa7, 0xb2, 0x96, 0x4d,
+	0x91, 0xd6, 0x8c, 0x75, 0x15, 0x04, 0x7d, 0x0e, 0x9b, 0xef, 0xf0, 0xa5, 0xac, 0x09, 0x29, 0xc8,
+	0xd8, 0x03, 0x48, 0xbd, 0x2c, 0x4b, 0x67, 0x84, 0xed, 0xd2, 0x86, 0xda, 0xf1, 0x8a, 0x83, 0x8e,
+	0xc0, 0x32, 0x3b, 0xe9, 0x1a, 0x52, 0x0d, 0xd0, 0x44, 0xb0, 0xfd, 0x75, 0xcc, 0x94, 0x96, 0xf4,
+	0xd4, 0xf6, 0x28, 0x59, 0xd0, 0x2c, 0x5b, 0xc0, 0x50, 0x24, 0x58, 0x10, 0x2f, 0x3f, 0xb1, 0xda,
+	0x6e, 0x4e, 0xa3, 0x63, 0xb8, 0x53, 0xd2, 0x56, 0x59, 0x5e, 0xea, 0xa8, 0xf2, 0x12, 0x9b, 0xce,
+	0xdb, 0x8f, 0x30, 0x0e, 0x3d, 0x85, 0xad, 0xb7, 0x1f, 0x31, 0xfc, 0x1f, 0xc2, 0xc6, 0x79, 0x38,
+	0x8d, 0x4d, 0x28, 0xaf, 0x9f, 0xb8, 0x99, 0xcc, 0xf6, 0xe5, 0xd6, 0x1a, 0x42, 0xcb, 0x8b, 0xa6,
+	0x32, 0x5f, 0x63, 0x9f, 0xe8, 0x13, 0x18, 0xea, 0x21, 0xf5, 0xa6, 0x5c, 0x3a, 0x77, 0xff, 0x1c,
+	0x76, 0x4f, 0x71, 0x8c, 0x09, 0x03, 0xc2, 0x1c, 0x59, 0x6e, 0x37, 0x42, 0x43, 0x7e, 0x86, 0x65,
+	0x12, 0xd9, 0x57, 0x90, 0xcf, 0xb1, 0xe9, 0x11, 0x0c, 0xd4, 0xb5, 0x41, 0x17, 0x3d, 0xfa, 0x6e,
+	0x5f, 0x31, 0x99, 0x61, 0xe8, 0x3d, 0x38, 0x55, 0xca, 0x75, 0x95, 0xfc, 0x82, 0x4c, 0x84, 0x02,
+	0x61, 0xf2, 0xda, 0x05, 0x99, 0xf0, 0xd1, 0xef, 0x41, 0x97, 0x35, 0xa5, 0x1c, 0xf7, 0x84, 0x72,
+	0x26, 0xcb, 0x41, 0x0f, 0xfd, 0x25, 0xec, 0xb3, 0xa9, 0x1b, 0xb0, 0x74, 0x96, 0x87, 0x85, 0x9a,
+	0xd9, 0x8f, 0xa1, 0x67, 0x1e, 0xb9, 0xe2, 0x5e, 0xb5, 0x5b, 0x05, 0x7b, 0x5c, 0xde, 0x35, 0xa5,
+	0x6f, 0x0b, 0x3d, 0xf4, 0x43, 0x38, 0xb8, 0xc1, 0x80, 0x1b, 0x16, 0x83, 0x59, 0x5e, 0x4c, 0x82,
+	0x7e, 0xc3, 0x96, 0x1f, 0xc3, 0xf0, 0x54, 0x22, 0x5c, 0x6e, 0x68, 0x01, 0x06, 0x1b, 0x45, 0x18,
+	0x44, 0x07, 0xd0, 0xbb, 0x2d, 0x01, 0x79, 0x06, 0xbd, 0x53, 0x4f, 0x57, 0xb3, 0x87, 0xd0, 0x62,
+	0xf5, 0x57, 0x21, 0xc1, 0x3e, 0x19, 0x47, 0xd7, 0x6c, 0xd9, 0x27, 0xfa, 0x02, 0xd6, 0x5f, 0x89,
+	0xf3, 0xd5, 0xb8, 0x34, 0x8b, 0x13, 0xb7, 0x74, 0x69, 0xe6, 0x62, 0xae, 0x6c, 0x43, 0xcf, 0x60,
+	0x85, 0x33, 0x3e, 0xe2, 0x35, 0xec, 0x13, 0xe8, 0x9f, 0xa5, 0x24, 0x99, 0x18, 0xd9, 0x5a, 0x14,
+	0x66, 0x14, 0xc7, 0x2a, 0xd9, 0x14, 0x14, 0xfa, 0x14, 0x06, 0x52, 0xee, 0x96, 0xbd, 0xfc, 0x18,
+	0x36, 0xd9, 0x2d, 0x8c, 0x67, 0x4d, 0xd9, 0xcd, 0x75, 0x8f, 0xaf, 0xc0, 0x32, 0x45, 0xf5, 0xc0,
+	0xcc, 0x6f, 0x79, 0xad, 0x5f, 0x52, 0xb5, 0x45, 0xfb, 0x23, 0xb0, 0x5e, 0x04, 0x17, 0x6c, 0x5f,
+	0xbd, 0x0f, 0xe7, 0x66, 0xf1, 0x3f, 0xc3, 0x7e, 0x12, 0x07, 0x99, 0xbc, 0xc8, 0x2a, 0x12, 0x7d,
+	0x0e, 0x5b, 0x05, 0x79, 0xfd, 0xca, 0x50, 0x5f, 0x2d, 0x61, 0x6f, 0x8e, 0xa7, 0x98, 0xbe, 0xe4,
+	0x4f, 0x96, 0x79, 0x97, 0x43, 0x58, 0x15, 0x8f, 0x98, 0x32, 0x0a, 0x87, 0x47, 0xe2, 0x75, 0x53,
+	0x64, 0x3b, 0x4c, 0x52, 0xb6, 0x23, 0x0a, 0x77, 0xbf, 0xc1, 0x24, 0x9c, 0x5c, 0xb3, 0x7d, 0xe1,
+	0xd1, 0x05, 0xc9, 0xed, 0x1c, 0x42, 0x6b, 0x9e, 0x4d, 0x55, 0x38, 0xcc, 0xb3, 0x29, 0x33, 0x24,
+	0x53, 0x52, 0x72, 0xa9, 0x34, 0xc3, 0x84, 0xa4, 0x56, 0x11, 0x92, 0x24, 0x06, 0xb6, 0x35, 0x06,
+	0xfe, 0x3e, 0xec, 0x2c, 0x69, 0xbd, 0x79, 0xf5, 0x8a, 0x6f, 0x79, 0x05, 0x48, 0x7f, 0xc6, 0xaf,
+	0x10, 0xef, 0xdc, 0x2f, 0xaf, 0xe5, 0xd1, 0x7d, 0xfb, 0x29, 0xf0, 0x0d, 0x7f, 0x20, 0x78, 0xe7,
+	0xbe, 0xf6, 0xe2, 0xa0, 0x50, 0x03, 0xe3, 0x55, 0x03, 0x79, 0x1d, 0x10, 0x04, 0xdf, 0x04, 0x71,
+	0x20, 0x97, 0x96, 0x7d, 0x9a, 0x8f, 0x6f, 0xe2, 0xfc, 0x52, 0x24, 0x3b, 0x5d, 0x0a, 0xe3, 0x9a,
+	0x81, 0xc3, 0x38, 0x2a, 0x74, 0x05, 0x85, 0x4e, 0xc0, 0xe6, 0xe2, 0x6f, 0xc3, 0x8c, 0xb2, 0xfb,
+	0x82, 0x69, 0x4c, 0x5d, 0x9f, 0x2b, 0xd8, 0xcc, 0xfb, 0x98, 0xc7, 0xb7, 0xb2, 0xa8, 0x51, 0xb0,
+	0x48, 0xcf, 0xa9, 0x59, 0x31, 0xa7, 0x96, 0x9e, 0xd3, 0x81, 0xdc, 0x81, 0xa2, 0x02, 0x32, 0x90,
+	0x9b, 0xf8, 0x9d, 0xfb, 0x86, 0xe2, 0xb9, 0xdc, 0x90, 0x33, 0x58, 0x15, 0xf4, 0x0d, 0xa7, 0x4f,
+	0x5e, 0x44, 0x68, 0x9a, 0x45, 0x04, 0x56, 0x9d, 0xc0, 0x41, 0xe8, 0xa9, 0x1b, 0xaa, 0xa4, 0x18,
+	0xff, 0x52, 0xd7, 0xd3, 0xbb, 0xae, 0xa4, 0xd0, 0xf7, 0xf9, 0x1c, 0xbf, 0x7a, 0x73, 0x26, 0x26,
+	0x79, 0xf3, 0x63, 0xcf, 0x07, 0xb0, 0x4c, 0xe1, 0xff, 0x37, 0x8f, 0xa0, 0x82, 0x47, 0x54, 0x29,
+	0xf7, 0xab, 0x37, 0x67, 0x86, 0x4b, 0xbe, 0x86, 0x35, 0xc9, 0xb8, 0xc1, 0x27, 0x8e, 0x51, 0x69,
+	0x68, 0xaa, 0x5b, 0x93, 0xa0, 0xab, 0x6b, 0x1d, 0x27, 0xff, 0x65, 0x01, 0xbc, 0x48, 0xc3, 0x73,
+	0x4c, 0x2e, 0x58, 0x46, 0xfb, 0x73, 0xe8, 0x19, 0x6f, 0xd5, 0x96, 0x2a, 0x4f, 0x95, 0xff, 0x15,
+	0x70, 0xd4, 0xe5, 0xa0, 0xe2, 0x61, 0x1b, 0xed, 0x7e, 0xf7, 0xeb, 0xff, 0xf8, 0xbb, 0xe6, 0x96,
+	0xb5, 0x79, 0x7c, 0xf1, 0xec, 0x78, 0x91, 0x61, 0xc2, 0xfe, 0x77, 0xe0, 0xb7, 0x2c, 0x6b, 0x02,
+	0x1b, 0xa7, 0x98, 0x9a, 0x6f, 0xc6, 0xf5, 0x2a, 0xee, 0xc9, 0x86, 0xaa, 0x17, 0x66, 0xf4, 0x80,
+	0xeb, 0xd8, 0xb1, 0xee, 0xe4, 0x3a, 0xd8, 0x0b, 0x73, 0xaa, 0x06, 0xfd, 0x53, 0xd8, 0x79, 0xeb,
+	0x51, 0x9c, 0xd1, 0x37, 0x84, 0xd7, 0x19, 0xb3, 0x70, 0x1c, 0x09, 0x88, 0xad, 0xd7, 0xb7, 0x2d,
+	0x1b, 0x0a, 0x57, 0x5d, 0xb4, 0xcd, 0x15, 0xad, 0x5b, 0xfd, 0x5c, 0x11, 0x7b, 0x7a, 0x27, 0x7c,
+	0x1e, 0xe6, 0x13, 0xa9, 0xf5, 0x40, 0x7b, 0xa4, 0xe2, 0x1d, 0xd6, 0xd9, 0xab, 0x6b, 0x96, 0x7a,
+	0xf6, 0xb9, 0x1e, 0x07, 0xe9, 0x09, 0x79, 0x42, 0x8c, 0x3b, 0xee, 0x79, 0xe3, 0x89, 0x75, 0x06,
+	0x6d, 0xf6, 0x0a, 0x6a, 0xd5, 0x1f, 0xf3, 0x8e, 0x2a, 0x8c, 0x9b, 0xaf, 0xa5, 0xc8, 0xe6, 0x23,
+	0x5b, 0x68, 0x90, 0x8f, 0xec, 0x7b, 0x51, 0xc4, 0x46, 0xfc, 0x00, 0xd6, 0x72, 0x7d, 0xc6, 0xda,
+	0x57, 0x7e, 0xaf, 0x2b, 0xdd, 0x38, 0x7b, 0x86, 0x44, 0xc5, 0xbd, 0x0f, 0x21, 0xae, 0xf1, 0xfe,
+	0xf3, 0xc6, 0x13, 0xb4, 0x93, 0x2b, 0x25, 0xde, 0xa5, 0x99, 0x84, 0xcc, 0x60, 0xbd, 0x58, 0x8c,
+	0xb1, 0xee, 0x6b, 0x0f, 0x2d, 0xd7, 0x68, 0x6a, 0x56, 0x47, 0x6a, 0x32, 0xd4, 0x4c, 0x0b, 0xbd,
+	0xd9, 0x2c, 0x63, 0x18, 0x96, 0xab, 0x32, 0xd6, 0xde, 0xb2, 0x2e, 0xb3, 0x5c, 0x53, 0xa3, 0xed,
+	0x7b, 0x5c, 0xdb, 0x1e, 0xda, 0xad, 0xd2, 0xc6, 0xfb, 0x33, 0x7d, 0xdf, 0x35, 0xf8, 0x21, 0x51,
+	0x70, 0x8c, 0x8f, 0xc3, 0x94, 0x5a, 0x48, 0x6b, 0xad, 0xab, 0xde, 0x38, 0x37, 0xdc, 0xba, 0xd1,
+	0x63, 0xae, 0xff, 0x11, 0xf3, 0xeb, 0x9e, 0x69, 0x42, 0x85, 0xaa, 0xbf, 0x6e, 0x70, 0xbc, 0xaf,
+	0xac, 0xf8, 0x58, 0x9f, 0xd4, 0xd8, 0x51, 0x2a, 0x09, 0xdd, 0x68, 0xcb, 0x67, 0xdc, 0x96, 0x4f,
+	0x98, 0x2d, 0x07, 0x35, 0xb6, 0x18, 0x1a, 0x47, 0xd0, 0xcd, 0xff, 0x56, 0xca, 0x77, 0x60, 0xf9,
+	0x5f, 0x27, 0xc7, 0x5e, 0x6e, 0x28, 0x6e, 0x77, 0x64, 0xe9, 0xed, 0xae, 0x64, 0x9e, 0x37, 0x9e,
+	0xfc, 0xa0, 0x21, 0x71, 0x4b, 0xa5, 0xad, 0xf5, 0x9b, 0x5c, 0x35, 0x94, 0x13, 0x5c, 0x74, 0x9f,
+	0x6b, 0xb8, 0x6b, 0x6d, 0x9b, 0x93, 0xc9, 0xc7, 0xfb, 0x39, 0xf4, 0x5e, 0xe9, 0x9f, 0x04, 0x6e,
+	0xda, 0x82, 0x96, 0x56, 0x90, 0x8f, 0xfd, 0x90, 0x8f, 0xbd, 0xcb, 0x7c, 0xa5, 0x87, 0x37, 0x7e,
+	0x3a, 0xb0, 0x3c, 0x0e, 0x27, 0x22, 0xdb, 0x95, 0xbb, 0x41, 0x8d, 0x63, 0xc6, 0xc6, 0x1d, 0x33,
+	0xdf, 0xd5, 0xc3, 0x3f, 0xe2, 0xc3, 0x3f, 0x60, 0xc3, 0xdb, 0xa6, 0xf5, 0x85, 0xf1, 0x3c, 0x00,
+	0xfd, 0x9f, 0x82, 0xa5, 0xb0, 0xb5, 0xea, 0x57, 0x07, 0x67, 0x57, 0x87, 0x47, 0xe9, 0xbf, 0x06,
+	0x74, 0x8f, 0xab, 0xba, 0xc3, 0x54, 0x0d, 0x73, 0x55, 0xea, 0x21, 0xf3, 0x2f, 0x78, 0x46, 0xb2,
+	0xf4, 0xfa, 0xb0, 0x57, 0xf7, 0xc4, 0x21, 0xd5, 0x3d, 0xac, 0x6d, 0xaf, 0xdd, 0x76, 0xf3, 0x92,
+	0x28, 0xdb, 0x76, 0x11, 0xf7, 0xa1, 0xf9, 0xd8, 0x66, 0x39, 0xe6, 0x33, 0x56, 0xf1, 0xb9, 0xce,
+	0xb9, 0x57, 0xd9, 0x56, 0x04, 0x63, 0x36, 0x4d, 0x8d, 0xc7, 0xc4, 0x1c, 0xfa, 0x8f, 0x78, 0xbc,
+	0xe5, 0xbf, 0x2f, 0xdc, 0x1a, 0x6f, 0xe5, 0xff, 0x2a, 0x2a, 0x0e, 0x49, 0xac, 0x06, 0xbb, 0xe6,
+	0x3f, 0xf3, 0x54, 0xfc, 0x79, 0x50, 0x19, 0x14, 0x0a, 0x54, 0x6e, 0xf8, 0x53, 0x01, 0x7d, 0xca,
+	0x95, 0x1d, 0xa0, 0xfb, 0xb9, 0xb2, 0xc9, 0xb2, 0x34, 0x73, 0x22, 0x55, 0x19, 0xbe, 0xf1, 0x8e,
+	0x9e, 0x03, 0x73, 0xe5, 0x43, 0xbd, 0xf3, 0xa0, 0xa6, 0xb5, 0x16, 0xa1, 0xfd, 0x82, 0x20, 0xd3,
+	0x2a, 0x02, 0xa7, 0xfc, 0xfa, 0x9b, 0x07, 0x4e, 0xcd, 0x3b, 0xb2, 0xf3, 0xb0, 0xb6, 0xbd, 0x18,
+	0x38, 0x6c, 0x19, 0x75, 0xec, 0xa4, 0x65, 0x35, 0x0b, 0xd8, 0x28, 0x5d, 0x10, 0xf2, 0xb3, 0xbc,
+	0xfa, 0xba, 0xe2, 0xec, 0xd5, 0x35, 0x17, 0x37, 0xa4, 0xb1, 0x1b, 0x2f, 0x8a, 0x92, 0xcf, 0x1b,
+	0x4f, 0x4e, 0xfe, 0xbb, 0x07, 0xfd, 0x17, 0xc1, 0x3c, 0x8c, 0x55, 0xea, 0xf5, 0x33, 0xe8, 0xa8,
+	0xbf, 0xbe, 0x6e, 0x8f, 0xa7, 0xf2, 0xff, 0x61, 0xc8, 0xe1, 0x3a, 0xb7, 0x2d, 0x8e, 0x90, 0x1e,
+	0x1b, 0x37, 0x4f, 0x20, 0x2c, 0x1f, 0x40, 0xd7, 0xe1, 0x2c, 0x85, 0xb2, 0x4b, 0xf5, 0x3c, 0x67,
+	0xb7, 0xa2, 0xa5, 0x2a, 0x3d, 0x29, 0x0c, 0x7f, 0x1c, 0xe3, 0x4b, 0xb6, 0x88, 0x09, 0x0c, 0x0a,
+	0xe5, 0xb4, 0x1c, 0x63, 0xaa, 0x4a, 0x7a, 0xce, 0xfd, 0xea, 0xc6, 0x2a, 0x07, 0x16, 0xb5, 0x2d,
+	0x78, 0x07, 0xa6, 0x70, 0x0a, 0x3d, 0xa3, 0xbc, 0x96, 0x63, 0xf2, 0x72, 0x89, 0xce, 0x71, 0xaa,
+	0x9a, 0xa4, 0xaa, 0x03, 0xae, 0xea, 0x1e, 0xba, 0xbb, 0xac, 0x4a, 0x29, 0x8a, 0x61, 0xa3, 0x94,
+	0xe9, 0xdc, 0x74, 0x00, 0xdc, 0x96, 0x1c, 0x55, 0x78, 0xd2, 0xc8, 0x8b, 0x98, 0xbe, 0x3f, 0x86,
+	0x8e, 0xaa, 0xda, 0x59, 0xea, 0xa1, 0xb0, 0x54, 0x19, 0x74, 0x76, 0x96, 0xf8, 0x72, 0xf8, 0x3d,
+	0x3e, 0xbc, 0xcd, 0x62, 0x7e, 0x4b, 0x6b, 0x60, 0x57, 0xe7, 0x63, 0x5e, 0x24, 0xfc, 0xae, 0x01,
+	0xd6, 0x72, 0xb9, 0x2d, 0x4f, 0xfa, 0x6a, 0xcb, 0x80, 0xce, 0xc1, 0x0d, 0x12, 0x45, 0x98, 0x61,
+	0xba, 0xef, 0x6b, 0xdd, 0xd3, 0x65, 0x6d, 0x7f, 0xd3, 0x80, 0x07, 0xa5, 0xe2, 0xd8, 0x4f, 0x43,
+	0x3a, 0xd3, 0x75, 0x2e, 0xeb, 0x53, 0x63, 0x7e, 0x37, 0x55, 0xc2, 0x9c, 0xc3, 0xdb, 0x05, 0x8b,
+	0x88, 0xcb, 0xac, 0x5b, 0x2f, 0x7a, 0xc6, 0xfa, 0x7b, 0x66, 0x4f, 0x71, 0xbd, 0xea, 0xec, 0xb9,
+	0xa5, 0x32, 0x77, 0xeb, 0xf2, 0x1f, 0x71, 0x2b, 0x0e, 0xd1, 0xa3, 0xca, 0xe5, 0x2f, 0x6a, 0x65,
+	0xc1, 0x70, 0x0e, 0x70, 0x4e, 0x3d, 0x42, 0x79, 0xdd, 0xc9, 0xca, 0xff, 0x7c, 0x31, 0xaa, 0x55,
+	0xce, 0x76, 0x91, 0x59, 0x04, 0x04, 0xb4, 0xa1, 0x15, 0xa5, 0x4c, 0x40, 0x44, 0x58, 0x37, 0x2f,
+	0xe4, 0xd4, 0x63, 0x8d, 0xad, 0xf3, 0x80, 0x62, 0xcd, 0x47, 0xa5, 0x01, 0xd6, 0x96, 0xb9, 0xca,
+	0x6a, 0xbc, 0x9f, 0x41, 0x47, 0xfd, 0x45, 0x7e, 0x3b, 0x8e, 0x95, 0xff, 0x37, 0xaf, 0xc2, 0xb1,
+	0x38, 0x09, 0x70, 0xc8, 0x46, 0xfb, 0x05, 0x80, 0x2e, 0x95, 0xe5, 0x38, 0xb6, 0x54, 0x68, 0x73,
+	0x76, 0x2b, 0x5a, 0xaa, 0x12, 0x49, 0x31, 0x7c, 0x80, 0x2f, 0x78, 0x42, 0xc1, 0x1c, 0xf3, 0x4b,
+	0xe8, 0x19, 0x65, 0xb1, 0x7c, 0x9b, 0x2f, 0x97, 0xd6, 0x1c, 0xa7, 0xaa, 0xa9, 0x2a, 0x61, 0xd1,
+	0x4a, 0x3c, 0x2d, 0xfa, 0xbc, 0xf1, 0x64, 0xbc, 0xca, 0xdf, 0xed, 0x3f, 0xff, 0x9f, 0x01, 0x00,
+	0x03, 0x46, 0x65, 0x87, 0x98, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3213,6 +4676,8 @@ const _ = grpc.SupportPackageIsVersion4
 type ApiServiceClient interface {
 	// Return the state of the neb.
 	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Return the progress of the block sync.
+	GetSyncProgress(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error)
 	// Return the latest irreversible block.
 	LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// Return the state of the account.
@@ -3237,6 +4702,18 @@ type ApiServiceClient interface {
 	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
 	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
 	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+	// Return the performance of the miners in the dynasty serials.
+	GetMinerPerformance(ctx context.Context, in *MinerPerformanceRequest, opts ...grpc.CallOption) (*MinerPerformanceResponse, error)
+	// Return the latest reorgs of the chain, newest first.
+	GetReorgHistory(ctx context.Context, in *ReorgHistoryRequest, opts ...grpc.CallOption) (*ReorgHistoryResponse, error)
+	// Return the pending and committed evidence of the evil miners.
+	GetEvidence(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*EvidenceResponse, error)
+	// Return the finality certificate of a finalized block.
+	GetFinalityCertificate(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*FinalityCertificateResponse, error)
+	// Return the dynasty, candidates and participants at a past height or dynasty serial.
+	GetConsensusState(ctx context.Context, in *ConsensusStateRequest, opts ...grpc.CallOption) (*ConsensusStateResponse, error)
+	// Return the proposers expected to mint the blocks of the next slots.
+	GetProposerSchedule(ctx context.Context, in *ProposerScheduleRequest, opts ...grpc.CallOption) (*ProposerScheduleResponse, error)
 	// Verify Signature.
 	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error)
 }
@@ -3258,6 +4735,15 @@ func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest
 	return out, nil
 }
 
+func (c *apiServiceClient) GetSyncProgress(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncProgressResponse, error) {
+	out := new(SyncProgressResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetSyncProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/LatestIrreversibleBlock", in, out, opts...)
@@ -3398,6 +4884,60 @@ func (c *apiServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequ
 	return out, nil
 }
 
+func (c *apiServiceClient) GetMinerPerformance(ctx context.Context, in *MinerPerformanceRequest, opts ...grpc.CallOption) (*MinerPerformanceResponse, error) {
+	out := new(MinerPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetMinerPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetReorgHistory(ctx context.Context, in *ReorgHistoryRequest, opts ...grpc.CallOption) (*ReorgHistoryResponse, error) {
+	out := new(ReorgHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetReorgHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEvidence(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*EvidenceResponse, error) {
+	out := new(EvidenceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetFinalityCertificate(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*FinalityCertificateResponse, error) {
+	out := new(FinalityCertificateResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetFinalityCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetConsensusState(ctx context.Context, in *ConsensusStateRequest, opts ...grpc.CallOption) (*ConsensusStateResponse, error) {
+	out := new(ConsensusStateResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetConsensusState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetProposerSchedule(ctx context.Context, in *ProposerScheduleRequest, opts ...grpc.CallOption) (*ProposerScheduleResponse, error) {
+	out := new(ProposerScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetProposerSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error) {
 	out := new(VerifySignatureResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/VerifySignature", in, out, opts...)
@@ -3411,6 +4951,8 @@ func (c *apiServiceClient) VerifySignature(ctx context.Context, in *VerifySignat
 type ApiServiceServer interface {
 	// Return the state of the neb.
 	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
+	// Return the progress of the block sync.
+	GetSyncProgress(context.Context, *NonParamsRequest) (*SyncProgressResponse, error)
 	// Return the latest irreversible block.
 	LatestIrreversibleBlock(context.Context, *NonParamsRequest) (*BlockResponse, error)
 	// Return the state of the account.
@@ -3435,6 +4977,18 @@ type ApiServiceServer interface {
 	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
 	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
 	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
+	// Return the performance of the miners in the dynasty serials.
+	GetMinerPerformance(context.Context, *MinerPerformanceRequest) (*MinerPerformanceResponse, error)
+	// Return the latest reorgs of the chain, newest first.
+	GetReorgHistory(context.Context, *ReorgHistoryRequest) (*ReorgHistoryResponse, error)
+	// Return the pending and committed evidence of the evil miners.
+	GetEvidence(context.Context, *NonParamsRequest) (*EvidenceResponse, error)
+	// Return the finality certificate of a finalized block.
+	GetFinalityCertificate(context.Context, *HashRequest) (*FinalityCertificateResponse, error)
+	// Return the dynasty, candidates and participants at a past height or dynasty serial.
+	GetConsensusState(context.Context, *ConsensusStateRequest) (*ConsensusStateResponse, error)
+	// Return the proposers expected to mint the blocks of the next slots.
+	GetProposerSchedule(context.Context, *ProposerScheduleRequest) (*ProposerScheduleResponse, error)
 	// Verify Signature.
 	VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error)
 }
@@ -3446,6 +5000,9 @@ type UnimplementedApiServiceServer struct {
 func (*UnimplementedApiServiceServer) GetNebState(ctx context.Context, req *NonParamsRequest) (*GetNebStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNebState not implemented")
 }
+func (*UnimplementedApiServiceServer) GetSyncProgress(ctx context.Context, req *NonParamsRequest) (*SyncProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncProgress not implemented")
+}
 func (*UnimplementedApiServiceServer) LatestIrreversibleBlock(ctx context.Context, req *NonParamsRequest) (*BlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LatestIrreversibleBlock not implemented")
 }
@@ -3485,6 +5042,24 @@ func (*UnimplementedApiServiceServer) GetEventsByHash(ctx context.Context, req *
 func (*UnimplementedApiServiceServer) GetDynasty(ctx context.Context, req *ByBlockHeightRequest) (*GetDynastyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDynasty not implemented")
 }
+func (*UnimplementedApiServiceServer) GetMinerPerformance(ctx context.Context, req *MinerPerformanceRequest) (*MinerPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinerPerformance not implemented")
+}
+func (*UnimplementedApiServiceServer) GetReorgHistory(ctx context.Context, req *ReorgHistoryRequest) (*ReorgHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorgHistory not implemented")
+}
+func (*UnimplementedApiServiceServer) GetEvidence(ctx context.Context, req *NonParamsRequest) (*EvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidence not implemented")
+}
+func (*UnimplementedApiServiceServer) GetFinalityCertificate(ctx context.Context, req *HashRequest) (*FinalityCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalityCertificate not implemented")
+}
+func (*UnimplementedApiServiceServer) GetConsensusState(ctx context.Context, req *ConsensusStateRequest) (*ConsensusStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusState not implemented")
+}
+func (*UnimplementedApiServiceServer) GetProposerSchedule(ctx context.Context, req *ProposerScheduleRequest) (*ProposerScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProposerSchedule not implemented")
+}
 func (*UnimplementedApiServiceServer) VerifySignature(ctx context.Context, req *VerifySignatureRequest) (*VerifySignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
 }
@@ -3511,6 +5086,24 @@ func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetSyncProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetSyncProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetSyncProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetSyncProgress(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_LatestIrreversibleBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
@@ -3748,6 +5341,114 @@ func _ApiService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetMinerPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetMinerPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetMinerPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetMinerPerformance(ctx, req.(*MinerPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetReorgHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorgHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetReorgHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetReorgHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetReorgHistory(ctx, req.(*ReorgHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEvidence(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetFinalityCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetFinalityCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetFinalityCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetFinalityCertificate(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetConsensusState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsensusStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetConsensusState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetConsensusState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetConsensusState(ctx, req.(*ConsensusStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetProposerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetProposerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetProposerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetProposerSchedule(ctx, req.(*ProposerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifySignatureRequest)
 	if err := dec(in); err != nil {
@@ -3774,6 +5475,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNebState",
 			Handler:    _ApiService_GetNebState_Handler,
 		},
+		{
+			MethodName: "GetSyncProgress",
+			Handler:    _ApiService_GetSyncProgress_Handler,
+		},
 		{
 			MethodName: "LatestIrreversibleBlock",
 			Handler:    _ApiService_LatestIrreversibleBlock_Handler,
@@ -3822,6 +5527,30 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDynasty",
 			Handler:    _ApiService_GetDynasty_Handler,
 		},
+		{
+			MethodName: "GetMinerPerformance",
+			Handler:    _ApiService_GetMinerPerformance_Handler,
+		},
+		{
+			MethodName: "GetReorgHistory",
+			Handler:    _ApiService_GetReorgHistory_Handler,
+		},
+		{
+			MethodName: "GetEvidence",
+			Handler:    _ApiService_GetEvidence_Handler,
+		},
+		{
+			MethodName: "GetFinalityCertificate",
+			Handler:    _ApiService_GetFinalityCertificate_Handler,
+		},
+		{
+			MethodName: "GetConsensusState",
+			Handler:    _ApiService_GetConsensusState_Handler,
+		},
+		{
+			MethodName: "GetProposerSchedule",
+			Handler:    _ApiService_GetProposerSchedule_Handler,
+		},
 		{
 			MethodName: "VerifySignature",
 			Handler:    _ApiService_VerifySignature_Handler,
@@ -3863,6 +5592,10 @@ type AdminServiceClient interface {
 	GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Seal empty blocks at once on the dev chain.
+	MineBlocks(ctx context.Context, in *MineBlocksRequest, opts ...grpc.CallOption) (*MineBlocksResponse, error)
+	// Move the clock of the dev chain forward.
+	AdvanceTime(ctx context.Context, in *AdvanceTimeRequest, opts ...grpc.CallOption) (*AdvanceTimeResponse, error)
 }
 
 type adminServiceClient struct {
@@ -3981,6 +5714,24 @@ func (c *adminServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest,
 	return out, nil
 }
 
+func (c *adminServiceClient) MineBlocks(ctx context.Context, in *MineBlocksRequest, opts ...grpc.CallOption) (*MineBlocksResponse, error) {
+	out := new(MineBlocksResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/MineBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdvanceTime(ctx context.Context, in *AdvanceTimeRequest, opts ...grpc.CallOption) (*AdvanceTimeResponse, error) {
+	out := new(AdvanceTimeResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/AdvanceTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	// Accounts return account list.
@@ -4005,6 +5756,10 @@ type AdminServiceServer interface {
 	GetConfig(context.Context, *NonParamsRequest) (*GetConfigResponse, error)
 	// Return the p2p node info.
 	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Seal empty blocks at once on the dev chain.
+	MineBlocks(context.Context, *MineBlocksRequest) (*MineBlocksResponse, error)
+	// Move the clock of the dev chain forward.
+	AdvanceTime(context.Context, *AdvanceTimeRequest) (*AdvanceTimeResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -4047,6 +5802,12 @@ func (*UnimplementedAdminServiceServer) GetConfig(ctx context.Context, req *NonP
 func (*UnimplementedAdminServiceServer) NodeInfo(ctx context.Context, req *NonParamsRequest) (*NodeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NodeInfo not implemented")
 }
+func (*UnimplementedAdminServiceServer) MineBlocks(ctx context.Context, req *MineBlocksRequest) (*MineBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MineBlocks not implemented")
+}
+func (*UnimplementedAdminServiceServer) AdvanceTime(ctx context.Context, req *AdvanceTimeRequest) (*AdvanceTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceTime not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -4268,6 +6029,42 @@ func _AdminService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_MineBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MineBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).MineBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/MineBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).MineBlocks(ctx, req.(*MineBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdvanceTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdvanceTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/AdvanceTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdvanceTime(ctx, req.(*AdvanceTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -4320,6 +6117,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NodeInfo",
 			Handler:    _AdminService_NodeInfo_Handler,
 		},
+		{
+			MethodName: "MineBlocks",
+			Handler:    _AdminService_MineBlocks_Handler,
+		},
+		{
+			MethodName: "AdvanceTime",
+			Handler:    _AdminService_AdvanceTime_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -74,11 +74,16 @@ func NewServer(neblet core.Neblet) *Server {
 		grpc.MaxRecvMsgSize(MaxRecvMsgSize))
 
 	srv := &Server{neblet: neblet, rpcServer: rpc, rpcConfig: cfg}
-	api := &APIService{server: srv}
-	admin := &AdminService{server: srv}
-
-	rpcpb.RegisterApiServiceServer(rpc, api)
-	rpcpb.RegisterAdminServiceServer(rpc, admin)
+	if chain := neblet.Config().Chain; chain != nil && chain.LightClient {
+		// a light client serves the verified headers and account states only.
+		rpcpb.RegisterApiServiceServer(rpc, &LightAPIService{server: srv})
+	} else {
+		api := &APIService{server: srv}
+		admin := &AdminService{server: srv}
+
+		rpcpb.RegisterApiServiceServer(rpc, api)
+		rpcpb.RegisterAdminServiceServer(rpc, admin)
+	}
 	// Register reflection service on gRPC server.
 	// TODO: Enable reflection only for testing mode.
 	reflection.Register(rpc)
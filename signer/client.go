@@ -0,0 +1,207 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"context"
+	"net"
+	"time"
+
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/rpc"
+	rpcpb "github.com/nebulasio/go-nebulas/rpc/pb"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// NewSigner return the signer of the remote sign server in the protocol of config.
+func NewSigner(server string, conf *nebletpb.RemoteSignerConfig) (Signer, error) {
+	protocol := ProtocolAdmin
+	timeout := DefaultTimeout
+	if conf != nil {
+		if len(conf.Protocol) > 0 {
+			protocol = conf.Protocol
+		}
+		if conf.Timeout > 0 {
+			timeout = time.Duration(conf.Timeout) * time.Second
+		}
+	}
+
+	switch protocol {
+	case ProtocolAdmin:
+		conn, err := rpc.Dial(server)
+		if err != nil {
+			return nil, err
+		}
+		return &adminSigner{conn: conn, client: rpcpb.NewAdminServiceClient(conn), timeout: timeout}, nil
+	case ProtocolSigner:
+		serverName := conf.ServerName
+		if len(serverName) == 0 {
+			host, _, err := net.SplitHostPort(server)
+			if err != nil {
+				return nil, err
+			}
+			serverName = host
+		}
+		tlsConfig, err := NewClientTLSConfig(conf.TlsCert, conf.TlsKey, conf.TlsCa, serverName)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := grpc.Dial(server, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		if err != nil {
+			return nil, err
+		}
+		return &remoteSigner{conn: conn, client: signerpb.NewRemoteSignerClient(conn), timeout: timeout}, nil
+	default:
+		return nil, ErrInvalidSignerProtocol
+	}
+}
+
+// remoteSigner signs by the signer service.
+type remoteSigner struct {
+	conn    *grpc.ClientConn
+	client  signerpb.RemoteSignerClient
+	timeout time.Duration
+}
+
+func (s *remoteSigner) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.SignBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sign, nil
+}
+
+func (s *remoteSigner) SignWitness(ctx context.Context, req *signerpb.SignWitnessRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.SignWitness(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sign, nil
+}
+
+func (s *remoteSigner) SignTransaction(ctx context.Context, req *signerpb.SignTransactionRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.SignTransaction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sign, nil
+}
+
+func (s *remoteSigner) SignLease(ctx context.Context, req *signerpb.SignLeaseRequest) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.SignLease(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Sign, nil
+}
+
+func (s *remoteSigner) GenerateRandomSeed(ctx context.Context, req *signerpb.GenerateRandomSeedRequest) ([]byte, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.GenerateRandomSeed(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.VrfSeed, resp.VrfProof, nil
+}
+
+func (s *remoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+// adminSigner signs by the admin api of a neblet, the context of the signing is dropped.
+type adminSigner struct {
+	conn    *grpc.ClientConn
+	client  rpcpb.AdminServiceClient
+	timeout time.Duration
+}
+
+func (s *adminSigner) signHash(ctx context.Context, address string, hash []byte, alg uint32) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.SignHash(ctx, &rpcpb.SignHashRequest{
+		Address: address,
+		Hash:    hash,
+		Alg:     alg,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+func (s *adminSigner) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) ([]byte, error) {
+	block, err := blockPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.signHash(ctx, req.Address, block.Hash(), req.Alg)
+}
+
+func (s *adminSigner) SignWitness(ctx context.Context, req *signerpb.SignWitnessRequest) ([]byte, error) {
+	_, hash, err := witnessPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.signHash(ctx, req.Address, hash, req.Alg)
+}
+
+func (s *adminSigner) SignTransaction(ctx context.Context, req *signerpb.SignTransactionRequest) ([]byte, error) {
+	_, hash, err := transactionPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.signHash(ctx, req.Address, hash, req.Alg)
+}
+
+func (s *adminSigner) SignLease(ctx context.Context, req *signerpb.SignLeaseRequest) ([]byte, error) {
+	_, hash, err := leasePreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.signHash(ctx, req.Address, hash, req.Alg)
+}
+
+func (s *adminSigner) GenerateRandomSeed(ctx context.Context, req *signerpb.GenerateRandomSeedRequest) ([]byte, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	resp, err := s.client.GenerateRandomSeed(ctx, &rpcpb.GenerateRandomSeedRequest{
+		Address:      req.Address,
+		ParentSeed:   req.ParentSeed,
+		AncestorHash: req.AncestorHash,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp.VrfSeed, resp.VrfProof, nil
+}
+
+func (s *adminSigner) Close() error {
+	return s.conn.Close()
+}
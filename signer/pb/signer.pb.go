@@ -0,0 +1,694 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: signer.proto
+
+package signerpb
+
+import (
+	context "context"
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+	pb1 "github.com/nebulasio/go-nebulas/consensus/pb"
+	pb "github.com/nebulasio/go-nebulas/core/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// The requests carry the preimages of the hashes to sign, the signer recomputes the hashes
+// and applies its policy to the fields committed by them.
+type SignBlockRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Alg     uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// the block to mint, without the transaction bodies.
+	Block                *pb.CompactBlock `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SignBlockRequest) Reset()         { *m = SignBlockRequest{} }
+func (m *SignBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*SignBlockRequest) ProtoMessage()    {}
+func (*SignBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{0}
+}
+func (m *SignBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignBlockRequest.Unmarshal(m, b)
+}
+func (m *SignBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *SignBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBlockRequest.Merge(m, src)
+}
+func (m *SignBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_SignBlockRequest.Size(m)
+}
+func (m *SignBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignBlockRequest proto.InternalMessageInfo
+
+func (m *SignBlockRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignBlockRequest) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignBlockRequest) GetBlock() *pb.CompactBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+type SignWitnessRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Alg     uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// the blocks to witness, without the transaction bodies.
+	Blocks               []*pb.CompactBlock `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SignWitnessRequest) Reset()         { *m = SignWitnessRequest{} }
+func (m *SignWitnessRequest) String() string { return proto.CompactTextString(m) }
+func (*SignWitnessRequest) ProtoMessage()    {}
+func (*SignWitnessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{1}
+}
+func (m *SignWitnessRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignWitnessRequest.Unmarshal(m, b)
+}
+func (m *SignWitnessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignWitnessRequest.Marshal(b, m, deterministic)
+}
+func (m *SignWitnessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignWitnessRequest.Merge(m, src)
+}
+func (m *SignWitnessRequest) XXX_Size() int {
+	return xxx_messageInfo_SignWitnessRequest.Size(m)
+}
+func (m *SignWitnessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignWitnessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignWitnessRequest proto.InternalMessageInfo
+
+func (m *SignWitnessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignWitnessRequest) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignWitnessRequest) GetBlocks() []*pb.CompactBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type SignTransactionRequest struct {
+	Address              string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Alg                  uint32          `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	Transaction          *pb.Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
+func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionRequest) ProtoMessage()    {}
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{2}
+}
+func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
+}
+func (m *SignTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionRequest.Merge(m, src)
+}
+func (m *SignTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionRequest.Size(m)
+}
+func (m *SignTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionRequest proto.InternalMessageInfo
+
+func (m *SignTransactionRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignTransactionRequest) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignTransactionRequest) GetTransaction() *pb.Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+type SignLeaseRequest struct {
+	Address              string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Alg                  uint32          `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	Lease                *pb1.MinerLease `protobuf:"bytes,3,opt,name=lease,proto3" json:"lease,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SignLeaseRequest) Reset()         { *m = SignLeaseRequest{} }
+func (m *SignLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*SignLeaseRequest) ProtoMessage()    {}
+func (*SignLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{3}
+}
+func (m *SignLeaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignLeaseRequest.Unmarshal(m, b)
+}
+func (m *SignLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignLeaseRequest.Marshal(b, m, deterministic)
+}
+func (m *SignLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignLeaseRequest.Merge(m, src)
+}
+func (m *SignLeaseRequest) XXX_Size() int {
+	return xxx_messageInfo_SignLeaseRequest.Size(m)
+}
+func (m *SignLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignLeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignLeaseRequest proto.InternalMessageInfo
+
+func (m *SignLeaseRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignLeaseRequest) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignLeaseRequest) GetLease() *pb1.MinerLease {
+	if m != nil {
+		return m.Lease
+	}
+	return nil
+}
+
+type SignResponse struct {
+	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignResponse) Reset()         { *m = SignResponse{} }
+func (m *SignResponse) String() string { return proto.CompactTextString(m) }
+func (*SignResponse) ProtoMessage()    {}
+func (*SignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{4}
+}
+func (m *SignResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignResponse.Unmarshal(m, b)
+}
+func (m *SignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignResponse.Marshal(b, m, deterministic)
+}
+func (m *SignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignResponse.Merge(m, src)
+}
+func (m *SignResponse) XXX_Size() int {
+	return xxx_messageInfo_SignResponse.Size(m)
+}
+func (m *SignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignResponse proto.InternalMessageInfo
+
+func (m *SignResponse) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+type GenerateRandomSeedRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AncestorHash         []byte   `protobuf:"bytes,2,opt,name=ancestor_hash,json=ancestorHash,proto3" json:"ancestor_hash,omitempty"`
+	ParentSeed           []byte   `protobuf:"bytes,3,opt,name=parent_seed,json=parentSeed,proto3" json:"parent_seed,omitempty"`
+	Height               uint64   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Slot                 int64    `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	ParentHash           []byte   `protobuf:"bytes,6,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateRandomSeedRequest) Reset()         { *m = GenerateRandomSeedRequest{} }
+func (m *GenerateRandomSeedRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateRandomSeedRequest) ProtoMessage()    {}
+func (*GenerateRandomSeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{5}
+}
+func (m *GenerateRandomSeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateRandomSeedRequest.Unmarshal(m, b)
+}
+func (m *GenerateRandomSeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateRandomSeedRequest.Marshal(b, m, deterministic)
+}
+func (m *GenerateRandomSeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateRandomSeedRequest.Merge(m, src)
+}
+func (m *GenerateRandomSeedRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateRandomSeedRequest.Size(m)
+}
+func (m *GenerateRandomSeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateRandomSeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateRandomSeedRequest proto.InternalMessageInfo
+
+func (m *GenerateRandomSeedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GenerateRandomSeedRequest) GetAncestorHash() []byte {
+	if m != nil {
+		return m.AncestorHash
+	}
+	return nil
+}
+
+func (m *GenerateRandomSeedRequest) GetParentSeed() []byte {
+	if m != nil {
+		return m.ParentSeed
+	}
+	return nil
+}
+
+func (m *GenerateRandomSeedRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GenerateRandomSeedRequest) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *GenerateRandomSeedRequest) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+type GenerateRandomSeedResponse struct {
+	VrfSeed              []byte   `protobuf:"bytes,1,opt,name=vrf_seed,json=vrfSeed,proto3" json:"vrf_seed,omitempty"`
+	VrfProof             []byte   `protobuf:"bytes,2,opt,name=vrf_proof,json=vrfProof,proto3" json:"vrf_proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateRandomSeedResponse) Reset()         { *m = GenerateRandomSeedResponse{} }
+func (m *GenerateRandomSeedResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateRandomSeedResponse) ProtoMessage()    {}
+func (*GenerateRandomSeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df2490657d73dbfd, []int{6}
+}
+func (m *GenerateRandomSeedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateRandomSeedResponse.Unmarshal(m, b)
+}
+func (m *GenerateRandomSeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateRandomSeedResponse.Marshal(b, m, deterministic)
+}
+func (m *GenerateRandomSeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateRandomSeedResponse.Merge(m, src)
+}
+func (m *GenerateRandomSeedResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateRandomSeedResponse.Size(m)
+}
+func (m *GenerateRandomSeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateRandomSeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateRandomSeedResponse proto.InternalMessageInfo
+
+func (m *GenerateRandomSeedResponse) GetVrfSeed() []byte {
+	if m != nil {
+		return m.VrfSeed
+	}
+	return nil
+}
+
+func (m *GenerateRandomSeedResponse) GetVrfProof() []byte {
+	if m != nil {
+		return m.VrfProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignBlockRequest)(nil), "signerpb.SignBlockRequest")
+	proto.RegisterType((*SignWitnessRequest)(nil), "signerpb.SignWitnessRequest")
+	proto.RegisterType((*SignTransactionRequest)(nil), "signerpb.SignTransactionRequest")
+	proto.RegisterType((*SignLeaseRequest)(nil), "signerpb.SignLeaseRequest")
+	proto.RegisterType((*SignResponse)(nil), "signerpb.SignResponse")
+	proto.RegisterType((*GenerateRandomSeedRequest)(nil), "signerpb.GenerateRandomSeedRequest")
+	proto.RegisterType((*GenerateRandomSeedResponse)(nil), "signerpb.GenerateRandomSeedResponse")
+}
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptor_df2490657d73dbfd) }
+
+var fileDescriptor_df2490657d73dbfd = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x51, 0x6f, 0xd3, 0x3c,
+	0x14, 0xfd, 0xf2, 0x65, 0xed, 0xd6, 0xdb, 0x4c, 0x4c, 0x06, 0x95, 0x2c, 0x20, 0x11, 0x65, 0x3c,
+	0x54, 0x88, 0xa5, 0xd2, 0x26, 0x24, 0x5e, 0x01, 0x21, 0x78, 0x60, 0x12, 0x72, 0x27, 0xf1, 0x38,
+	0x39, 0xe9, 0x6d, 0x12, 0x68, 0xed, 0x60, 0xbb, 0x7b, 0xe1, 0x37, 0xf0, 0xbf, 0xf8, 0x59, 0xc8,
+	0x76, 0xb2, 0xa5, 0x1d, 0xeb, 0xa4, 0xbe, 0xf9, 0x26, 0xe7, 0x9e, 0x73, 0x6e, 0x7c, 0x6e, 0x20,
+	0x50, 0x55, 0xc1, 0x51, 0xa6, 0xb5, 0x14, 0x5a, 0x90, 0x03, 0x57, 0xd5, 0x59, 0x74, 0x5e, 0x54,
+	0xba, 0x5c, 0x65, 0x69, 0x2e, 0x96, 0x13, 0x8e, 0xd9, 0x6a, 0xc1, 0x54, 0x25, 0x26, 0x85, 0x38,
+	0x6d, 0x8a, 0x49, 0x2e, 0x24, 0x4e, 0xea, 0x6c, 0x92, 0x2d, 0x44, 0xfe, 0xc3, 0xb5, 0x47, 0x6f,
+	0x1f, 0x6e, 0xe2, 0x0a, 0xb9, 0x5a, 0x29, 0xd3, 0xb9, 0x40, 0xa6, 0xd0, 0x75, 0x26, 0xdf, 0xe1,
+	0x68, 0x5a, 0x15, 0xfc, 0xbd, 0x21, 0xa3, 0xf8, 0x73, 0x85, 0x4a, 0x93, 0x10, 0xf6, 0xd9, 0x6c,
+	0x26, 0x51, 0xa9, 0xd0, 0x8b, 0xbd, 0xf1, 0x80, 0xb6, 0x25, 0x39, 0x02, 0x9f, 0x2d, 0x8a, 0xf0,
+	0xff, 0xd8, 0x1b, 0x1f, 0x52, 0x73, 0x24, 0xaf, 0xa0, 0x67, 0x8d, 0x84, 0x7e, 0xec, 0x8d, 0x87,
+	0x67, 0x4f, 0x52, 0x63, 0xaf, 0xce, 0xd2, 0x0f, 0x62, 0x59, 0xb3, 0x5c, 0x3b, 0x5e, 0x07, 0x49,
+	0x38, 0x10, 0xa3, 0xf5, 0xad, 0xd2, 0x1c, 0x95, 0xda, 0x45, 0xed, 0x35, 0xf4, 0x2d, 0x95, 0x0a,
+	0xfd, 0xd8, 0xbf, 0x57, 0xae, 0xc1, 0x24, 0xbf, 0x60, 0x64, 0xf4, 0x2e, 0x25, 0xe3, 0x8a, 0xe5,
+	0xba, 0x12, 0x7c, 0x17, 0xcd, 0x37, 0x30, 0xd4, 0xb7, 0x0c, 0xcd, 0x9c, 0x8f, 0x5b, 0xe1, 0x2e,
+	0x79, 0x17, 0x97, 0x2c, 0xdd, 0x87, 0xfd, 0x82, 0x4c, 0xe1, 0x2e, 0xb2, 0xa7, 0xd0, 0xb3, 0xf7,
+	0xd4, 0x08, 0x3e, 0x4d, 0x6f, 0xae, 0xb0, 0xce, 0xd2, 0x8b, 0x8a, 0xa3, 0x74, 0xd4, 0x0e, 0x95,
+	0x24, 0x10, 0x18, 0x39, 0x8a, 0xaa, 0x36, 0x38, 0x42, 0x60, 0xcf, 0x44, 0xca, 0xea, 0x04, 0xd4,
+	0x9e, 0x93, 0x3f, 0x1e, 0x1c, 0x7f, 0x42, 0x8e, 0x92, 0x69, 0xa4, 0x8c, 0xcf, 0xc4, 0x72, 0x8a,
+	0x38, 0x7b, 0xd8, 0xdc, 0x09, 0x1c, 0x32, 0x9e, 0xa3, 0xd2, 0x42, 0x5e, 0x95, 0x4c, 0x95, 0xd6,
+	0x66, 0x40, 0x83, 0xf6, 0xe1, 0x67, 0xa6, 0x4a, 0xf2, 0x02, 0x86, 0x35, 0x93, 0xc8, 0xf5, 0x95,
+	0x42, 0x9c, 0x59, 0xd7, 0x01, 0x05, 0xf7, 0xc8, 0xc8, 0x90, 0x11, 0xf4, 0x4b, 0xac, 0x8a, 0x52,
+	0x87, 0x7b, 0xb1, 0x37, 0xde, 0xa3, 0x4d, 0x65, 0x9d, 0x2e, 0x84, 0x0e, 0x7b, 0xb1, 0x37, 0xf6,
+	0xa9, 0x3d, 0x77, 0xc8, 0xac, 0x5e, 0xbf, 0x4b, 0x66, 0xd4, 0x92, 0x4b, 0x88, 0xfe, 0x35, 0x49,
+	0x33, 0xfc, 0x31, 0x1c, 0x5c, 0xcb, 0xb9, 0x33, 0xe2, 0x3e, 0xc0, 0xfe, 0xb5, 0x9c, 0x5b, 0x17,
+	0xcf, 0x60, 0x60, 0x5e, 0xd5, 0x52, 0x88, 0x79, 0x33, 0x87, 0xc1, 0x7e, 0x35, 0xf5, 0xd9, 0x6f,
+	0x1f, 0x02, 0x8a, 0x4b, 0xa1, 0x71, 0x6a, 0xd7, 0x91, 0xbc, 0x83, 0xc1, 0xcd, 0x76, 0x90, 0x28,
+	0x6d, 0x97, 0x34, 0xdd, 0x5c, 0x99, 0x68, 0xb4, 0xfe, 0xae, 0x75, 0x92, 0xfc, 0x47, 0x3e, 0xc2,
+	0xb0, 0x13, 0x7a, 0xf2, 0x7c, 0x1d, 0xb8, 0xbe, 0x0b, 0x5b, 0x68, 0x2e, 0xe0, 0xd1, 0x46, 0x96,
+	0x49, 0xbc, 0x0e, 0xbe, 0x1b, 0xf3, 0x2d, 0x74, 0xcd, 0x60, 0x36, 0x42, 0x9b, 0x83, 0x75, 0x23,
+	0xbb, 0x85, 0x82, 0x01, 0xb9, 0x7b, 0x05, 0xe4, 0xe4, 0x16, 0x7f, 0x6f, 0xd4, 0xa2, 0x97, 0xdb,
+	0x41, 0xad, 0x44, 0xd6, 0xb7, 0xff, 0xa8, 0xf3, 0xbf, 0x03, 0x00, 0xac, 0x09, 0x2f, 0x10, 0x2c,
+	0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	// Sign the hash of a block to mint.
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Sign the witness of the reversible blocks.
+	SignWitness(ctx context.Context, in *SignWitnessRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Sign the consensus transaction of the miner.
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Sign the heartbeat of the active miner in failover.
+	SignLease(ctx context.Context, in *SignLeaseRequest, opts ...grpc.CallOption) (*SignResponse, error)
+	// Generate the VRF seed of a block to mint.
+	GenerateRandomSeed(ctx context.Context, in *GenerateRandomSeedRequest, opts ...grpc.CallOption) (*GenerateRandomSeedResponse, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.RemoteSigner/SignBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignWitness(ctx context.Context, in *SignWitnessRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.RemoteSigner/SignWitness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.RemoteSigner/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) SignLease(ctx context.Context, in *SignLeaseRequest, opts ...grpc.CallOption) (*SignResponse, error) {
+	out := new(SignResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.RemoteSigner/SignLease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) GenerateRandomSeed(ctx context.Context, in *GenerateRandomSeedRequest, opts ...grpc.CallOption) (*GenerateRandomSeedResponse, error) {
+	out := new(GenerateRandomSeedResponse)
+	err := c.cc.Invoke(ctx, "/signerpb.RemoteSigner/GenerateRandomSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	// Sign the hash of a block to mint.
+	SignBlock(context.Context, *SignBlockRequest) (*SignResponse, error)
+	// Sign the witness of the reversible blocks.
+	SignWitness(context.Context, *SignWitnessRequest) (*SignResponse, error)
+	// Sign the consensus transaction of the miner.
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignResponse, error)
+	// Sign the heartbeat of the active miner in failover.
+	SignLease(context.Context, *SignLeaseRequest) (*SignResponse, error)
+	// Generate the VRF seed of a block to mint.
+	GenerateRandomSeed(context.Context, *GenerateRandomSeedRequest) (*GenerateRandomSeedResponse, error)
+}
+
+// UnimplementedRemoteSignerServer can be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServer struct {
+}
+
+func (*UnimplementedRemoteSignerServer) SignBlock(ctx context.Context, req *SignBlockRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignBlock not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignWitness(ctx context.Context, req *SignWitnessRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWitness not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+func (*UnimplementedRemoteSignerServer) SignLease(ctx context.Context, req *SignLeaseRequest) (*SignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignLease not implemented")
+}
+func (*UnimplementedRemoteSignerServer) GenerateRandomSeed(ctx context.Context, req *GenerateRandomSeedRequest) (*GenerateRandomSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRandomSeed not implemented")
+}
+
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.RemoteSigner/SignBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignWitness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWitnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignWitness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.RemoteSigner/SignWitness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignWitness(ctx, req.(*SignWitnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.RemoteSigner/SignTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_SignLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).SignLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.RemoteSigner/SignLease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).SignLease(ctx, req.(*SignLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_GenerateRandomSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRandomSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).GenerateRandomSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signerpb.RemoteSigner/GenerateRandomSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).GenerateRandomSeed(ctx, req.(*GenerateRandomSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "signerpb.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignBlock",
+			Handler:    _RemoteSigner_SignBlock_Handler,
+		},
+		{
+			MethodName: "SignWitness",
+			Handler:    _RemoteSigner_SignWitness_Handler,
+		},
+		{
+			MethodName: "SignTransaction",
+			Handler:    _RemoteSigner_SignTransaction_Handler,
+		},
+		{
+			MethodName: "SignLease",
+			Handler:    _RemoteSigner_SignLease_Handler,
+		},
+		{
+			MethodName: "GenerateRandomSeed",
+			Handler:    _RemoteSigner_GenerateRandomSeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
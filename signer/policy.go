@@ -0,0 +1,231 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"encoding/json"
+	"sync"
+
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Policy decides whether a request is signed, by the fields committed by the hash to sign.
+type Policy interface {
+	CheckBlock(string, *core.CompactBlock) error
+	CheckWitness(string, []*core.CompactBlock) error
+	CheckTransaction(string, *core.Transaction) error
+	CheckLease(string, *consensuspb.MinerLease) error
+	CheckRandomSeed(*signerpb.GenerateRandomSeedRequest) error
+}
+
+type signedSlot struct {
+	Slot int64  `json:"slot"`
+	Hash string `json:"hash"`
+}
+
+// policyRecord is the latest signed by an address.
+type policyRecord struct {
+	Block     *signedSlot       `json:"block,omitempty"`
+	Witnesses map[uint64]string `json:"witnesses,omitempty"`
+	// timestamp of the latest lease, unit is ms.
+	Lease int64 `json:"lease,omitempty"`
+}
+
+type policyRecords struct {
+	Version int                      `json:"version"`
+	Records map[string]*policyRecord `json:"records"`
+}
+
+// DefaultPolicy signs the consensus transactions only, and never signs a block in a signed slot
+// or an earlier one, witnesses another block at a witnessed height, or signs a lease older than
+// the signed one. The signed ones are persisted in the storage to survive the restarts.
+type DefaultPolicy struct {
+	mu sync.Mutex
+
+	chainID uint32
+	storage storage.Storage
+	records map[string]*policyRecord
+}
+
+// NewDefaultPolicy return the default policy on the chain, 0 for any chain, persisted in the storage.
+func NewDefaultPolicy(chainID uint32, stor storage.Storage) (*DefaultPolicy, error) {
+	p := &DefaultPolicy{
+		chainID: chainID,
+		storage: stor,
+		records: make(map[string]*policyRecord),
+	}
+	data, err := stor.Get([]byte(policyRecordsKey))
+	if err == storage.ErrKeyNotFound {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	records := new(policyRecords)
+	if err := json.Unmarshal(data, records); err != nil {
+		return nil, err
+	}
+	if records.Version != policyRecordsVersion {
+		return nil, ErrInvalidPolicyRecords
+	}
+	for address, record := range records.Records {
+		if record == nil {
+			return nil, ErrInvalidPolicyRecords
+		}
+		p.records[address] = record
+	}
+	return p, nil
+}
+
+// CheckBlock records the block to sign if it's not signed over.
+func (p *DefaultPolicy) CheckBlock(address string, block *core.CompactBlock) error {
+	if err := p.checkChainID(block.ChainID()); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record := p.record(address)
+	hash := byteutils.Hex(block.Hash())
+	if signed := record.Block; signed != nil {
+		if block.Timestamp() < signed.Slot {
+			return ErrSignSlotTooOld
+		}
+		if block.Timestamp() == signed.Slot {
+			if signed.Hash != hash {
+				return ErrSignDoubleBlock
+			}
+			return nil
+		}
+	}
+	record.Block = &signedSlot{Slot: block.Timestamp(), Hash: hash}
+	return p.save()
+}
+
+// CheckWitness records the blocks to witness if none conflicts with the witnessed ones.
+// The heights are not committed by the hashes, they are taken as sent by the miner.
+func (p *DefaultPolicy) CheckWitness(address string, blocks []*core.CompactBlock) error {
+	for _, block := range blocks {
+		if err := p.checkChainID(block.ChainID()); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record := p.record(address)
+	if record.Witnesses == nil {
+		record.Witnesses = make(map[uint64]string)
+	}
+	witnessed := record.Witnesses
+	for _, block := range blocks {
+		if hash, ok := witnessed[block.Height()]; ok && hash != byteutils.Hex(block.Hash()) {
+			return ErrSignDoubleWitness
+		}
+	}
+	for _, block := range blocks {
+		witnessed[block.Height()] = byteutils.Hex(block.Hash())
+	}
+	// drop the lowest ones out of the limit.
+	for len(witnessed) > MaxWitnessRecords {
+		lowest := uint64(0)
+		first := true
+		for height := range witnessed {
+			if first || height < lowest {
+				lowest, first = height, false
+			}
+		}
+		delete(witnessed, lowest)
+	}
+	return p.save()
+}
+
+// CheckTransaction allows the consensus transactions only.
+func (p *DefaultPolicy) CheckTransaction(address string, tx *core.Transaction) error {
+	if err := p.checkChainID(tx.ChainID()); err != nil {
+		return err
+	}
+	if tx.Type() != core.TxPayloadPodType {
+		return ErrSignPayloadType
+	}
+	return nil
+}
+
+// CheckLease records the lease to sign if it's not older than the signed one,
+// and it's not holding a block before the signed one or another block in the signed slot.
+func (p *DefaultPolicy) CheckLease(address string, lease *consensuspb.MinerLease) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	record := p.record(address)
+	if lease.Timestamp < record.Lease {
+		return ErrSignLeaseTooOld
+	}
+	if signed := record.Block; signed != nil {
+		if lease.Slot < signed.Slot || (lease.Slot == signed.Slot && byteutils.Hex(lease.Hash) != signed.Hash) {
+			return ErrSignLeaseBlock
+		}
+	}
+	if lease.Timestamp == record.Lease {
+		return nil
+	}
+	record.Lease = lease.Timestamp
+	return p.save()
+}
+
+// CheckRandomSeed refuses the seeds of the slots before the signed block.
+func (p *DefaultPolicy) CheckRandomSeed(req *signerpb.GenerateRandomSeedRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if signed := p.record(req.Address).Block; signed != nil && req.Slot < signed.Slot {
+		return ErrSignSlotTooOld
+	}
+	return nil
+}
+
+func (p *DefaultPolicy) checkChainID(chainID uint32) error {
+	if p.chainID != 0 && chainID != p.chainID {
+		return ErrSignChainIDMismatch
+	}
+	return nil
+}
+
+func (p *DefaultPolicy) record(address string) *policyRecord {
+	record, ok := p.records[address]
+	if !ok {
+		record = &policyRecord{}
+		p.records[address] = record
+	}
+	return record
+}
+
+func (p *DefaultPolicy) save() error {
+	data, err := json.Marshal(&policyRecords{Version: policyRecordsVersion, Records: p.records})
+	if err != nil {
+		return err
+	}
+	return p.storage.Put([]byte(policyRecordsKey), data)
+}
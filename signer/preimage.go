@@ -0,0 +1,121 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/sha3"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// WitnessHash return the hash signed by the witness of the blocks.
+func WitnessHash(blockHashs []byteutils.Hash) byteutils.Hash {
+	hasher := sha3.New256()
+	for _, v := range blockHashs {
+		hasher.Write(v)
+	}
+	return hasher.Sum(nil)
+}
+
+// LeaseHash return the hash signed by the miner lease.
+func LeaseHash(lease *consensuspb.MinerLease) byteutils.Hash {
+	hasher := sha3.New256()
+	hasher.Write(lease.Miner)
+	hasher.Write([]byte(lease.Holder))
+	hasher.Write(byteutils.FromInt64(lease.Slot))
+	hasher.Write(lease.Hash)
+	hasher.Write(byteutils.FromInt64(lease.Timestamp))
+	return hasher.Sum(nil)
+}
+
+// compactBlock return the block of the proto, its hash is verified against the header.
+func compactBlock(msg *corepb.CompactBlock) (*core.CompactBlock, error) {
+	if msg == nil {
+		return nil, ErrInvalidSignRequest
+	}
+	block := new(core.CompactBlock)
+	if err := block.FromProto(msg); err != nil {
+		return nil, err
+	}
+	if err := block.VerifyHash(block.ChainID()); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// blockPreimage return the block to sign in the request.
+func blockPreimage(req *signerpb.SignBlockRequest) (*core.CompactBlock, error) {
+	if req.Block == nil || req.Block.Header == nil {
+		return nil, ErrInvalidSignRequest
+	}
+	// the block is not signed yet, it's signed in the alg of the request.
+	header := *req.Block.Header
+	header.Alg = req.Alg
+	msg := *req.Block
+	msg.Header = &header
+	return compactBlock(&msg)
+}
+
+// witnessPreimage return the blocks to witness in the request and the hash of the witness.
+func witnessPreimage(req *signerpb.SignWitnessRequest) ([]*core.CompactBlock, byteutils.Hash, error) {
+	if len(req.Blocks) == 0 {
+		return nil, nil, ErrInvalidSignRequest
+	}
+	blocks := make([]*core.CompactBlock, len(req.Blocks))
+	hashs := make([]byteutils.Hash, len(req.Blocks))
+	for k, v := range req.Blocks {
+		block, err := compactBlock(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		blocks[k] = block
+		hashs[k] = block.Hash()
+	}
+	return blocks, WitnessHash(hashs), nil
+}
+
+// transactionPreimage return the transaction to sign in the request and its hash.
+func transactionPreimage(req *signerpb.SignTransactionRequest) (*core.Transaction, byteutils.Hash, error) {
+	if req.Transaction == nil {
+		return nil, nil, ErrInvalidSignRequest
+	}
+	// the transaction is not signed yet, it's signed in the alg of the request.
+	msg := *req.Transaction
+	msg.Alg = req.Alg
+	tx := new(core.Transaction)
+	if err := tx.FromProto(&msg); err != nil {
+		return nil, nil, err
+	}
+	hash, err := tx.HashTransaction()
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, hash, nil
+}
+
+// leasePreimage return the lease to sign in the request and its hash.
+func leasePreimage(req *signerpb.SignLeaseRequest) (*consensuspb.MinerLease, byteutils.Hash, error) {
+	if req.Lease == nil {
+		return nil, nil, ErrInvalidSignRequest
+	}
+	return req.Lease, LeaseHash(req.Lease), nil
+}
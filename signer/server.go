@@ -0,0 +1,203 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// KeyManager signs with the unlocked keys, e.g. the account manager.
+type KeyManager interface {
+	SignHash(*core.Address, byteutils.Hash, keystore.Algorithm) ([]byte, error)
+	GenerateRandomSeed(*core.Address, []byte, []byte) ([]byte, []byte, error)
+}
+
+// Server is the signer service for the miners.
+type Server struct {
+	km        KeyManager
+	policy    Policy
+	addresses map[string]bool
+
+	rpcServer *grpc.Server
+}
+
+// NewServer return the signer service signing for the addresses with mutual TLS.
+func NewServer(km KeyManager, addresses []*core.Address, policy Policy, tlsConfig *tls.Config) *Server {
+	s := &Server{
+		km:        km,
+		policy:    policy,
+		addresses: make(map[string]bool),
+		rpcServer: grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig))),
+	}
+	for _, addr := range addresses {
+		s.addresses[addr.String()] = true
+	}
+	signerpb.RegisterRemoteSignerServer(s.rpcServer, s)
+	return s
+}
+
+// Start listens and serves in background.
+func (s *Server) Start(listen string) (net.Addr, error) {
+	listener, err := net.Listen("tcp", listen)
+	if err != nil {
+		return nil, err
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"listen": listener.Addr(),
+	}).Info("Started signer service.")
+
+	go func() {
+		if err := s.rpcServer.Serve(listener); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Error("Signer service stopped.")
+		}
+	}()
+	return listener.Addr(), nil
+}
+
+// Stop stops the service.
+func (s *Server) Stop() {
+	s.rpcServer.Stop()
+	logging.CLog().Info("Stopped signer service.")
+}
+
+// SignBlock sign the hash of a block
+func (s *Server) SignBlock(ctx context.Context, req *signerpb.SignBlockRequest) (*signerpb.SignResponse, error) {
+	addr, err := s.checkAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	block, err := blockPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.CheckBlock(req.Address, block); err != nil {
+		return nil, s.refuse("block", req, err)
+	}
+	return s.sign(addr, block.Hash(), req.Alg)
+}
+
+// SignWitness sign the witness of blocks
+func (s *Server) SignWitness(ctx context.Context, req *signerpb.SignWitnessRequest) (*signerpb.SignResponse, error) {
+	addr, err := s.checkAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	blocks, hash, err := witnessPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.CheckWitness(req.Address, blocks); err != nil {
+		return nil, s.refuse("witness", req, err)
+	}
+	return s.sign(addr, hash, req.Alg)
+}
+
+// SignTransaction sign the hash of a consensus transaction
+func (s *Server) SignTransaction(ctx context.Context, req *signerpb.SignTransactionRequest) (*signerpb.SignResponse, error) {
+	addr, err := s.checkAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	tx, hash, err := transactionPreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	if !tx.From().Equals(addr) {
+		return nil, ErrInvalidSignRequest
+	}
+	if err := s.policy.CheckTransaction(req.Address, tx); err != nil {
+		return nil, s.refuse("transaction", req, err)
+	}
+	return s.sign(addr, hash, req.Alg)
+}
+
+// SignLease sign the heartbeat of the active miner
+func (s *Server) SignLease(ctx context.Context, req *signerpb.SignLeaseRequest) (*signerpb.SignResponse, error) {
+	addr, err := s.checkAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	lease, hash, err := leasePreimage(req)
+	if err != nil {
+		return nil, err
+	}
+	if !byteutils.Equal(addr.Bytes(), lease.Miner) {
+		return nil, ErrInvalidSignRequest
+	}
+	if err := s.policy.CheckLease(req.Address, lease); err != nil {
+		return nil, s.refuse("lease", req, err)
+	}
+	return s.sign(addr, hash, req.Alg)
+}
+
+// GenerateRandomSeed generate the VRF seed of a block
+func (s *Server) GenerateRandomSeed(ctx context.Context, req *signerpb.GenerateRandomSeedRequest) (*signerpb.GenerateRandomSeedResponse, error) {
+	addr, err := s.checkAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.AncestorHash) == 0 {
+		return nil, ErrInvalidSignRequest
+	}
+	if err := s.policy.CheckRandomSeed(req); err != nil {
+		return nil, s.refuse("random seed", req, err)
+	}
+	seed, proof, err := s.km.GenerateRandomSeed(addr, req.AncestorHash, req.ParentSeed)
+	if err != nil {
+		return nil, err
+	}
+	return &signerpb.GenerateRandomSeedResponse{VrfSeed: seed, VrfProof: proof}, nil
+}
+
+func (s *Server) checkAddress(address string) (*core.Address, error) {
+	if !s.addresses[address] {
+		return nil, ErrUnknownSignerAddress
+	}
+	return core.AddressParse(address)
+}
+
+func (s *Server) sign(addr *core.Address, hash []byte, alg uint32) (*signerpb.SignResponse, error) {
+	sign, err := s.km.SignHash(addr, hash, keystore.Algorithm(alg))
+	if err != nil {
+		return nil, err
+	}
+	return &signerpb.SignResponse{Sign: sign}, nil
+}
+
+func (s *Server) refuse(kind string, req interface{}, err error) error {
+	logging.CLog().WithFields(logrus.Fields{
+		"kind": kind,
+		"req":  req,
+		"err":  err,
+	}).Warn("Refused to sign by policy.")
+	return err
+}
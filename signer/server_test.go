@@ -0,0 +1,284 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/common/dag"
+	dagpb "github.com/nebulasio/go-nebulas/common/dag/pb"
+	consensuspb "github.com/nebulasio/go-nebulas/consensus/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAddress = "n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE"
+
+type mockKeyManager struct{}
+
+func (m *mockKeyManager) SignHash(addr *core.Address, hash byteutils.Hash, alg keystore.Algorithm) ([]byte, error) {
+	return append([]byte("sign"), hash...), nil
+}
+
+func (m *mockKeyManager) GenerateRandomSeed(addr *core.Address, ancestorHash, parentSeed []byte) ([]byte, []byte, error) {
+	return []byte("seed"), []byte("proof"), nil
+}
+
+// writeCert issues a certificate by the parent, a self-signed CA if parent is nil.
+func writeCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return cert, key
+}
+
+// mockBlock return the compact block of a slot, hashed as core does.
+func mockBlock(t *testing.T, chainID uint32, height uint64, slot int64) *corepb.CompactBlock {
+	coinbase, err := core.AddressParse(testAddress)
+	assert.Nil(t, err)
+	dependency, err := dag.NewDag().ToProto()
+	assert.Nil(t, err)
+	header := &corepb.BlockHeader{
+		ParentHash:    []byte(fmt.Sprintf("parent-%d", height)),
+		Coinbase:      coinbase.Bytes(),
+		Timestamp:     slot,
+		ChainId:       chainID,
+		Alg:           uint32(keystore.SECP256K1),
+		ConsensusRoot: &consensuspb.ConsensusRoot{},
+	}
+	header.Hash, err = core.HashPbBlock(&corepb.Block{Header: header, Dependency: dependency.(*dagpb.Dag), Height: height})
+	assert.Nil(t, err)
+	return &corepb.CompactBlock{Header: header, Dependency: dependency.(*dagpb.Dag), Height: height}
+}
+
+func mockCompactBlock(t *testing.T, chainID uint32, height uint64, slot int64) *core.CompactBlock {
+	block := new(core.CompactBlock)
+	assert.Nil(t, block.FromProto(mockBlock(t, chainID, height, slot)))
+	return block
+}
+
+func mockTransaction(t *testing.T, chainID uint32, payloadType string) *core.Transaction {
+	from, err := core.AddressParse(testAddress)
+	assert.Nil(t, err)
+	tx, err := core.NewTransaction(chainID, from, from, util.NewUint128(), 1, payloadType, []byte("{}"), core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, err)
+	return tx
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	p, err := NewDefaultPolicy(100, stor)
+	assert.Nil(t, err)
+
+	block := mockCompactBlock(t, 100, 2, 30)
+	assert.Nil(t, p.CheckBlock(testAddress, block))
+	assert.Nil(t, p.CheckBlock(testAddress, block))
+	assert.Equal(t, ErrSignDoubleBlock, p.CheckBlock(testAddress, mockCompactBlock(t, 100, 3, 30)))
+	assert.Equal(t, ErrSignSlotTooOld, p.CheckBlock(testAddress, mockCompactBlock(t, 100, 2, 15)))
+	assert.Equal(t, ErrSignChainIDMismatch, p.CheckBlock(testAddress, mockCompactBlock(t, 1, 2, 45)))
+	assert.Equal(t, ErrSignSlotTooOld, p.CheckRandomSeed(&signerpb.GenerateRandomSeedRequest{Address: testAddress, Slot: 15}))
+	assert.Nil(t, p.CheckRandomSeed(&signerpb.GenerateRandomSeedRequest{Address: testAddress, Slot: 45}))
+
+	witnessed := mockCompactBlock(t, 100, 2, 15)
+	assert.Nil(t, p.CheckWitness(testAddress, []*core.CompactBlock{witnessed}))
+	assert.Equal(t, ErrSignDoubleWitness, p.CheckWitness(testAddress, []*core.CompactBlock{mockCompactBlock(t, 100, 2, 45)}))
+	assert.Equal(t, ErrSignChainIDMismatch, p.CheckWitness(testAddress, []*core.CompactBlock{mockCompactBlock(t, 1, 3, 45)}))
+	for i := 0; i < MaxWitnessRecords; i++ {
+		assert.Nil(t, p.CheckWitness(testAddress, []*core.CompactBlock{mockCompactBlock(t, 100, uint64(3+i), 15)}))
+	}
+	assert.Equal(t, MaxWitnessRecords, len(p.records[testAddress].Witnesses))
+	assert.Nil(t, p.CheckWitness(testAddress, []*core.CompactBlock{mockCompactBlock(t, 100, 2, 45)}))
+
+	assert.Equal(t, ErrSignPayloadType, p.CheckTransaction(testAddress, mockTransaction(t, 100, core.TxPayloadBinaryType)))
+	assert.Equal(t, ErrSignChainIDMismatch, p.CheckTransaction(testAddress, mockTransaction(t, 1, core.TxPayloadPodType)))
+	assert.Nil(t, p.CheckTransaction(testAddress, mockTransaction(t, 100, core.TxPayloadPodType)))
+
+	// the lease holds the signed block, and never goes back.
+	lease := &consensuspb.MinerLease{Slot: 30, Hash: block.Hash(), Timestamp: 31000}
+	assert.Nil(t, p.CheckLease(testAddress, lease))
+	assert.Nil(t, p.CheckLease(testAddress, lease))
+	assert.Equal(t, ErrSignLeaseTooOld, p.CheckLease(testAddress, &consensuspb.MinerLease{Slot: 30, Hash: block.Hash(), Timestamp: 30000}))
+	assert.Equal(t, ErrSignLeaseBlock, p.CheckLease(testAddress, &consensuspb.MinerLease{Slot: 15, Timestamp: 32000}))
+	assert.Equal(t, ErrSignLeaseBlock, p.CheckLease(testAddress, &consensuspb.MinerLease{Slot: 30, Hash: []byte("b"), Timestamp: 32000}))
+	assert.Nil(t, p.CheckLease(testAddress, &consensuspb.MinerLease{Slot: 45, Timestamp: 32000}))
+
+	// the signed ones are kept after restarts.
+	p, err = NewDefaultPolicy(100, stor)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrSignDoubleBlock, p.CheckBlock(testAddress, mockCompactBlock(t, 100, 3, 30)))
+	assert.Equal(t, ErrSignDoubleWitness, p.CheckWitness(testAddress, []*core.CompactBlock{mockCompactBlock(t, 100, 3, 45)}))
+	assert.Equal(t, ErrSignLeaseTooOld, p.CheckLease(testAddress, lease))
+
+	assert.Nil(t, stor.Put([]byte(policyRecordsKey), []byte(`{"version":2}`)))
+	_, err = NewDefaultPolicy(100, stor)
+	assert.Equal(t, ErrInvalidPolicyRecords, err)
+}
+
+func TestServer_preimage(t *testing.T) {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	policy, err := NewDefaultPolicy(100, stor)
+	assert.Nil(t, err)
+	addr, err := core.AddressParse(testAddress)
+	assert.Nil(t, err)
+	server := &Server{km: &mockKeyManager{}, policy: policy, addresses: map[string]bool{testAddress: true}}
+
+	// the hashes are recomputed by the signer.
+	block := mockBlock(t, 100, 2, 15)
+	alg := uint32(keystore.SECP256K1)
+	resp, err := server.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress, Alg: alg, Block: block})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("sign"), block.Header.Hash...), resp.Sign)
+	forged := mockBlock(t, 100, 2, 30)
+	forged.Header.Hash = block.Header.Hash
+	_, err = server.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress, Alg: alg, Block: forged})
+	assert.Equal(t, core.ErrInvalidBlockHash, err)
+	_, err = server.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress})
+	assert.Equal(t, ErrInvalidSignRequest, err)
+
+	resp, err = server.SignWitness(context.Background(), &signerpb.SignWitnessRequest{Address: testAddress, Blocks: []*corepb.CompactBlock{block}})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("sign"), WitnessHash([]byteutils.Hash{block.Header.Hash})...), resp.Sign)
+	_, err = server.SignWitness(context.Background(), &signerpb.SignWitnessRequest{Address: testAddress, Blocks: []*corepb.CompactBlock{forged}})
+	assert.Equal(t, core.ErrInvalidBlockHash, err)
+
+	tx := mockTransaction(t, 100, core.TxPayloadPodType)
+	pbTx, err := tx.ToProto()
+	assert.Nil(t, err)
+	hash, err := tx.HashTransaction()
+	assert.Nil(t, err)
+	resp, err = server.SignTransaction(context.Background(), &signerpb.SignTransactionRequest{Address: testAddress, Alg: alg, Transaction: pbTx.(*corepb.Transaction)})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("sign"), hash...), resp.Sign)
+
+	lease := &consensuspb.MinerLease{Miner: addr.Bytes(), Holder: "a", Slot: 15, Hash: block.Header.Hash, Timestamp: 16000}
+	resp, err = server.SignLease(context.Background(), &signerpb.SignLeaseRequest{Address: testAddress, Lease: lease})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("sign"), LeaseHash(lease)...), resp.Sign)
+	lease.Miner = []byte("other")
+	_, err = server.SignLease(context.Background(), &signerpb.SignLeaseRequest{Address: testAddress, Lease: lease})
+	assert.Equal(t, ErrInvalidSignRequest, err)
+}
+
+func TestServer_mutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	ca, caKey := writeCert(t, dir, "ca", nil, nil)
+	writeCert(t, dir, "signer", ca, caKey)
+	writeCert(t, dir, "miner", ca, caKey)
+	other, otherKey := writeCert(t, dir, "other-ca", nil, nil)
+	writeCert(t, dir, "other", other, otherKey)
+
+	tlsConfig, err := NewServerTLSConfig(filepath.Join(dir, "signer.crt"), filepath.Join(dir, "signer.key"), filepath.Join(dir, "ca.crt"))
+	assert.Nil(t, err)
+	addr, err := core.AddressParse(testAddress)
+	assert.Nil(t, err)
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	policy, err := NewDefaultPolicy(0, stor)
+	assert.Nil(t, err)
+	server := NewServer(&mockKeyManager{}, []*core.Address{addr}, policy, tlsConfig)
+	listen, err := server.Start("127.0.0.1:0")
+	assert.Nil(t, err)
+	defer server.Stop()
+
+	conf := &nebletpb.RemoteSignerConfig{
+		Protocol: ProtocolSigner,
+		TlsCert:  filepath.Join(dir, "miner.crt"),
+		TlsKey:   filepath.Join(dir, "miner.key"),
+		TlsCa:    filepath.Join(dir, "ca.crt"),
+	}
+	s, err := NewSigner(listen.String(), conf)
+	assert.Nil(t, err)
+	defer s.Close()
+
+	block := mockBlock(t, 100, 2, 15)
+	sign, err := s.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress, Alg: uint32(keystore.SECP256K1), Block: block})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]byte("sign"), block.Header.Hash...), sign)
+	_, err = s.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress, Alg: uint32(keystore.SECP256K1), Block: mockBlock(t, 100, 3, 15)})
+	assert.NotNil(t, err)
+	_, err = s.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: "n1GmkKH6nBMw4rrjt16RrJ9WcgvKUtAZP1s", Block: block})
+	assert.NotNil(t, err)
+	seed, proof, err := s.GenerateRandomSeed(context.Background(), &signerpb.GenerateRandomSeedRequest{Address: testAddress, AncestorHash: []byte("a"), Slot: 30})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("seed"), seed)
+	assert.Equal(t, []byte("proof"), proof)
+
+	// the miners not issued by the ca are refused.
+	conf.TlsCert = filepath.Join(dir, "other.crt")
+	conf.TlsKey = filepath.Join(dir, "other.key")
+	s2, err := NewSigner(listen.String(), conf)
+	assert.Nil(t, err)
+	defer s2.Close()
+	_, err = s2.SignBlock(context.Background(), &signerpb.SignBlockRequest{Address: testAddress, Block: mockBlock(t, 100, 3, 45)})
+	assert.NotNil(t, err)
+
+	_, err = NewSigner(listen.String(), &nebletpb.RemoteSignerConfig{Protocol: ProtocolSigner})
+	assert.Equal(t, ErrMissingSignerTLS, err)
+	_, err = NewSigner(listen.String(), &nebletpb.RemoteSignerConfig{Protocol: "http"})
+	assert.Equal(t, ErrInvalidSignerProtocol, err)
+}
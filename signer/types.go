@@ -0,0 +1,66 @@
+// Copyright (C) 2017-2019 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package signer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	signerpb "github.com/nebulasio/go-nebulas/signer/pb"
+)
+
+// Errors in remote signer
+var (
+	ErrInvalidSignerProtocol = errors.New("invalid remote signer protocol, should be signer or admin")
+	ErrMissingSignerTLS      = errors.New("missing the tls certificate, key or ca of the remote signer")
+	ErrInvalidSignerCA       = errors.New("failed to parse the ca certificate of the remote signer")
+	ErrUnknownSignerAddress  = errors.New("the address is not served by the signer")
+	ErrInvalidSignRequest    = errors.New("invalid sign request")
+	ErrSignChainIDMismatch   = errors.New("refuse to sign for another chain")
+	ErrSignSlotTooOld        = errors.New("refuse to sign a block in a slot before the signed one")
+	ErrSignDoubleBlock       = errors.New("refuse to sign another block in a signed slot")
+	ErrSignDoubleWitness     = errors.New("refuse to witness another block at a witnessed height")
+	ErrSignPayloadType       = errors.New("refuse to sign a transaction which is not a consensus one")
+	ErrSignLeaseTooOld       = errors.New("refuse to sign a lease older than the signed one")
+	ErrSignLeaseBlock        = errors.New("refuse to sign a lease holding a block conflicting with the signed one")
+	ErrInvalidPolicyRecords  = errors.New("invalid signer policy records")
+)
+
+// the remote signer protocols and defaults.
+const (
+	ProtocolSigner = "signer"
+	ProtocolAdmin  = "admin"
+
+	DefaultTimeout    = 3 * time.Second
+	MaxWitnessRecords = 512
+
+	policyRecordsKey     = "signer_policy"
+	policyRecordsVersion = 1
+)
+
+// Signer signs for a miner remotely.
+type Signer interface {
+	SignBlock(context.Context, *signerpb.SignBlockRequest) ([]byte, error)
+	SignWitness(context.Context, *signerpb.SignWitnessRequest) ([]byte, error)
+	SignTransaction(context.Context, *signerpb.SignTransactionRequest) ([]byte, error)
+	SignLease(context.Context, *signerpb.SignLeaseRequest) ([]byte, error)
+	GenerateRandomSeed(context.Context, *signerpb.GenerateRandomSeedRequest) ([]byte, []byte, error)
+	Close() error
+}
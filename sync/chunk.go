@@ -24,6 +24,7 @@ import (
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core"
 	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/storage"
 	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
 
@@ -46,7 +47,7 @@ func NewChunk(blockChain *core.BlockChain) *Chunk {
 	}
 }
 
-func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash) (*syncpb.ChunkHeaders, error) {
+func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash, chunkSize uint64, maxChunks int) (*syncpb.ChunkHeaders, error) {
 	syncpoint := c.blockChain.GetBlockOnCanonicalChainByHash(syncpointHash)
 	if syncpoint == nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -55,11 +56,11 @@ func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash) (*syncpb.Chun
 		return nil, ErrCannotFindBlockByHash
 	}
 	tail := c.blockChain.TailBlock()
-	if int(tail.Height())-int(syncpoint.Height()) <= core.ChunkSize {
+	if tail.Height() <= syncpoint.Height()+chunkSize {
 		logging.VLog().WithFields(logrus.Fields{
 			"err": ErrTooSmallGapToSync,
 		}).Debug("Failed to generate sync blocks meta info")
-		return &syncpb.ChunkHeaders{}, ErrTooSmallGapToSync
+		return &syncpb.ChunkHeaders{TailHeight: tail.Height()}, ErrTooSmallGapToSync
 	}
 
 	chunkHeaders := []*syncpb.ChunkHeader{}
@@ -78,10 +79,13 @@ func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash) (*syncpb.Chun
 		return nil, err
 	}
 
-	startChunk := (syncpoint.Height() - 1) / core.ChunkSize
-	endChunk := (tail.Height() - 1) / core.ChunkSize
+	startChunk := (syncpoint.Height() - 1) / chunkSize
+	endChunk := (tail.Height() - 1) / chunkSize
+	if err := c.blockChain.CheckHistoryAvailable(startChunk*chunkSize + 2); err != nil {
+		return nil, err
+	}
 	curChunk := startChunk
-	for curChunk < endChunk && curChunk-startChunk < MaxChunkPerSyncRequest {
+	for curChunk < endChunk && curChunk-startChunk < uint64(maxChunks) {
 		headers := [][]byte{}
 		blocksTrie, err := trie.NewTrie(nil, stor, false)
 		if err != nil {
@@ -91,8 +95,8 @@ func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash) (*syncpb.Chun
 			return nil, err
 		}
 
-		startHeight := curChunk*core.ChunkSize + 2
-		endHeight := (curChunk+1)*core.ChunkSize + 2
+		startHeight := curChunk*chunkSize + 2
+		endHeight := (curChunk+1)*chunkSize + 2
 		curHeight := startHeight
 		for curHeight < endHeight {
 			block := c.blockChain.GetBlockOnCanonicalChainByHeight(curHeight)
@@ -116,10 +120,67 @@ func (c *Chunk) generateChunkHeaders(syncpointHash byteutils.Hash) (*syncpb.Chun
 		"syncpoint": syncpoint,
 		"start":     startChunk,
 		"end":       endChunk,
-		"limit":     MaxChunkPerSyncRequest,
+		"limit":     maxChunks,
 		"synced":    len(chunkHeaders),
 	}).Debug("Succeed to generate chunks meta info.")
-	return &syncpb.ChunkHeaders{ChunkHeaders: chunkHeaders, Root: chunksTrie.RootHash()}, nil
+	return &syncpb.ChunkHeaders{ChunkHeaders: chunkHeaders, Root: chunksTrie.RootHash(), TailHeight: tail.Height()}, nil
+}
+
+// newChunkHeader return the chunk header of the given block hashes.
+func newChunkHeader(hashes [][]byte) (*syncpb.ChunkHeader, error) {
+	stor, err := storage.NewMemoryStorage()
+	if err != nil {
+		return nil, err
+	}
+	blocksTrie, err := trie.NewTrie(nil, stor, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range hashes {
+		if _, err := blocksTrie.Put(v, v); err != nil {
+			return nil, err
+		}
+	}
+	return &syncpb.ChunkHeader{Headers: hashes, Root: blocksTrie.RootHash()}, nil
+}
+
+// acceptedChunkHeadersSize return the size of the chunk headers if they are in the requested sizes,
+// or in the default sizes as sent by the old peers ignoring the requested ones.
+func acceptedChunkHeadersSize(chunkHeaders *syncpb.ChunkHeaders, chunkSize uint64, maxChunks int) (uint64, bool) {
+	if verifyChunkHeadersSize(chunkHeaders, chunkSize, maxChunks) {
+		return chunkSize, true
+	}
+	if verifyChunkHeadersSize(chunkHeaders, core.ChunkSize, MaxChunkPerSyncRequest) {
+		return core.ChunkSize, true
+	}
+	return 0, false
+}
+
+// verifyChunkHeadersSize check the chunk headers are in the requested sizes.
+func verifyChunkHeadersSize(chunkHeaders *syncpb.ChunkHeaders, chunkSize uint64, maxChunks int) bool {
+	if len(chunkHeaders.ChunkHeaders) > maxChunks {
+		return false
+	}
+	for _, chunkHeader := range chunkHeaders.ChunkHeaders {
+		if uint64(len(chunkHeader.Headers)) != chunkSize {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyChunkHeadersCheckpoints verify the blocks in chunk headers generated from the sync point against the checkpoints.
+func verifyChunkHeadersCheckpoints(blockChain *core.BlockChain, syncPointHeight uint64, chunkSize uint64, chunkHeaders *syncpb.ChunkHeaders) error {
+	startChunk := (syncPointHeight - 1) / chunkSize
+	for k, chunkHeader := range chunkHeaders.ChunkHeaders {
+		startHeight := (startChunk+uint64(k))*chunkSize + 2
+		for j, header := range chunkHeader.Headers {
+			if err := blockChain.VerifyCheckpoint(startHeight+uint64(j), header); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func verifyChunkHeaders(chunkHeaders *syncpb.ChunkHeaders) (bool, error) {
@@ -163,7 +224,12 @@ func verifyChunkHeaders(chunkHeaders *syncpb.ChunkHeaders) (bool, error) {
 	return bytes.Compare(chunksTrie.RootHash(), chunkHeaders.Root) == 0, nil
 }
 
-func (c *Chunk) generateChunkData(chunkHeader *syncpb.ChunkHeader) (*syncpb.ChunkData, error) {
+// chunkBlocks return the blocks of a chunk on the canonical chain.
+func (c *Chunk) chunkBlocks(chunkHeader *syncpb.ChunkHeader) ([]*core.Block, error) {
+	if len(chunkHeader.Headers) > MaxChunkSize {
+		return nil, ErrWrongChunkDataSize
+	}
+
 	stor, err := storage.NewMemoryStorage()
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -180,7 +246,7 @@ func (c *Chunk) generateChunkData(chunkHeader *syncpb.ChunkHeader) (*syncpb.Chun
 		return nil, err
 	}
 
-	blocks := []*corepb.Block{}
+	blocks := []*core.Block{}
 	for k, v := range chunkHeader.Headers {
 		block := c.blockChain.GetBlockOnCanonicalChainByHash(v)
 		if block == nil {
@@ -191,15 +257,7 @@ func (c *Chunk) generateChunkData(chunkHeader *syncpb.ChunkHeader) (*syncpb.Chun
 			}).Debug("Failed to find the block on canonical chain.")
 			return nil, ErrCannotFindBlockByHash
 		}
-		pbBlock, err := block.ToProto()
-		if err != nil {
-			logging.VLog().WithFields(logrus.Fields{
-				"block": block,
-				"err":   err,
-			}).Debug("Failed to serialize block.")
-			return nil, err
-		}
-		blocks = append(blocks, pbBlock.(*corepb.Block))
+		blocks = append(blocks, block)
 		blocksTrie.Put(block.Hash(), block.Hash())
 	}
 
@@ -212,12 +270,89 @@ func (c *Chunk) generateChunkData(chunkHeader *syncpb.ChunkHeader) (*syncpb.Chun
 		}).Debug("Wrong chunk header root hash.")
 		return nil, ErrWrongChunkHeaderRootHash
 	}
+	return blocks, nil
+}
+
+func (c *Chunk) generateChunkData(chunkHeader *syncpb.ChunkHeader) (*syncpb.ChunkData, error) {
+	blocks, err := c.chunkBlocks(chunkHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	pbBlocks := []*corepb.Block{}
+	for _, block := range blocks {
+		pbBlock, err := block.ToProto()
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Debug("Failed to serialize block.")
+			return nil, err
+		}
+		pbBlocks = append(pbBlocks, pbBlock.(*corepb.Block))
+	}
 
 	logging.VLog().WithFields(logrus.Fields{
-		"size": len(blocks),
+		"size": len(pbBlocks),
 	}).Debug("Succeed to generate chunk.")
 
-	return &syncpb.ChunkData{Blocks: blocks, Root: blocksTrie.RootHash()}, nil
+	return &syncpb.ChunkData{Blocks: pbBlocks, Root: chunkHeader.Root}, nil
+}
+
+func (c *Chunk) generateBlockHeaders(chunkHeader *syncpb.ChunkHeader) (*syncpb.BlockHeaders, error) {
+	blocks, err := c.chunkBlocks(chunkHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []*corepb.CompactBlock{}
+	for _, block := range blocks {
+		header, err := core.NewCompactBlock(block).ToProto()
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   err,
+			}).Debug("Failed to serialize block header.")
+			return nil, err
+		}
+		headers = append(headers, header.(*corepb.CompactBlock))
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"size": len(headers),
+	}).Debug("Succeed to generate block headers.")
+
+	return &syncpb.BlockHeaders{Headers: headers, Root: chunkHeader.Root}, nil
+}
+
+func (c *Chunk) generateBlockBodies(chunkHeader *syncpb.ChunkHeader) (*syncpb.BlockBodies, error) {
+	blocks, err := c.chunkBlocks(chunkHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	bodies := []*syncpb.BlockBody{}
+	for _, block := range blocks {
+		txs := []*corepb.Transaction{}
+		for _, v := range block.Transactions() {
+			tx, err := v.ToProto()
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": block,
+					"err":   err,
+				}).Debug("Failed to serialize transaction.")
+				return nil, err
+			}
+			txs = append(txs, tx.(*corepb.Transaction))
+		}
+		bodies = append(bodies, &syncpb.BlockBody{Transactions: txs})
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"size": len(bodies),
+	}).Debug("Succeed to generate block bodies.")
+
+	return &syncpb.BlockBodies{Bodies: bodies, Root: chunkHeader.Root}, nil
 }
 
 func verifyChunkData(chunkHeader *syncpb.ChunkHeader, chunkData *syncpb.ChunkData) (bool, error) {
@@ -318,3 +453,242 @@ func (c *Chunk) processChunkData(chunk *syncpb.ChunkData) (*core.Block, error) {
 	}).Debug("Succeed to process chunk.")
 	return last, nil
 }
+
+// randomInputs is the inputs to verify the vrf of the headers ahead of their bodies,
+// see core.BlockChain.GetInputForVRFSigner.
+type randomInputs struct {
+	genesisHash     byteutils.Hash
+	blocksInDynasty uint64
+	parentSeed      []byte                             // the seed for the first header, nil if unknown yet
+	blockHash       func(height uint64) byteutils.Hash // the hash of a block on the synced chain, nil if unknown
+}
+
+// verify the vrf of the header, it is skipped if an input is unknown yet,
+// the full block is verified again when pushed.
+func (inputs *randomInputs) verify(header *core.CompactBlock, parentSeed []byte) error {
+	if !core.RandomAvailableAtHeight(header.Height()) || parentSeed == nil {
+		return nil
+	}
+	ancestorHash := inputs.genesisHash
+	if header.Height() > 2*inputs.blocksInDynasty {
+		if ancestorHash = inputs.blockHash(header.Height() - 2*inputs.blocksInDynasty); ancestorHash == nil {
+			return nil
+		}
+	}
+	return header.VerifyRandom(ancestorHash, parentSeed)
+}
+
+// childSeed return the parent seed in the vrf inputs of the child of the header.
+func (inputs *randomInputs) childSeed(header *core.CompactBlock) []byte {
+	if core.RandomAvailableAtHeight(header.Height()) {
+		return header.VrfSeed()
+	}
+	return inputs.genesisHash
+}
+
+// verifyBlockHeaders verify the headers of a chunk one by one and their links,
+// parentHash is the expected parent of the first block, nil if unknown,
+// the vrf of the headers is verified if inputs is not nil.
+func verifyBlockHeaders(chainID uint32, consensus core.Consensus, chunkHeader *syncpb.ChunkHeader, parentHash byteutils.Hash, inputs *randomInputs, blockHeaders *syncpb.BlockHeaders) ([]*core.CompactBlock, error) {
+	if len(chunkHeader.Headers) != len(blockHeaders.Headers) {
+		logging.VLog().WithFields(logrus.Fields{
+			"blockHeaders.size": len(blockHeaders.Headers),
+			"chunkHeader.size":  len(chunkHeader.Headers),
+			"err":               ErrWrongBlockHeadersSize,
+		}).Debug("Wrong block headers size.")
+		return nil, ErrWrongBlockHeadersSize
+	}
+
+	var parentSeed []byte
+	if inputs != nil {
+		parentSeed = inputs.parentSeed
+	}
+
+	headers := make([]*core.CompactBlock, len(blockHeaders.Headers))
+	for k, v := range blockHeaders.Headers {
+		header := new(core.CompactBlock)
+		if err := header.FromProto(v); err != nil {
+			return nil, err
+		}
+		if !header.Hash().Equals(chunkHeader.Headers[k]) {
+			logging.VLog().WithFields(logrus.Fields{
+				"index":       k,
+				"data.hash":   header.Hash(),
+				"header.hash": byteutils.Hex(chunkHeader.Headers[k]),
+				"err":         ErrWrongBlockHashInChunk,
+			}).Debug("Wrong block hash.")
+			return nil, ErrWrongBlockHashInChunk
+		}
+		if parentHash != nil && !header.ParentHash().Equals(parentHash) {
+			logging.VLog().WithFields(logrus.Fields{
+				"index":  k,
+				"expect": parentHash,
+				"actual": header.ParentHash(),
+				"err":    ErrWrongParentHashInChunk,
+			}).Debug("Wrong parent hash.")
+			return nil, ErrWrongParentHashInChunk
+		}
+		if err := header.VerifyHeader(chainID, consensus); err != nil {
+			if err == core.ErrInvalidBlockHash {
+				return nil, ErrInvalidBlockHashInChunk
+			}
+			return nil, err
+		}
+		if inputs != nil {
+			if err := inputs.verify(header, parentSeed); err != nil {
+				return nil, err
+			}
+			parentSeed = inputs.childSeed(header)
+		}
+		headers[k] = header
+		parentHash = header.Hash()
+	}
+	return headers, nil
+}
+
+// assembleChunkData build the chunk data from the verified headers and the bodies.
+func assembleChunkData(chunkHeader *syncpb.ChunkHeader, headers []*core.CompactBlock, blockBodies *syncpb.BlockBodies) (*syncpb.ChunkData, error) {
+	if len(headers) != len(blockBodies.Bodies) {
+		logging.VLog().WithFields(logrus.Fields{
+			"blockBodies.size": len(blockBodies.Bodies),
+			"headers.size":     len(headers),
+			"err":              ErrWrongBlockBodiesSize,
+		}).Debug("Wrong block bodies size.")
+		return nil, ErrWrongBlockBodiesSize
+	}
+
+	blocks := make([]*corepb.Block, len(headers))
+	for k, header := range headers {
+		block, err := header.ToPbBlock(blockBodies.Bodies[k].Transactions)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"index": k,
+				"hash":  header.Hash(),
+				"err":   err,
+			}).Debug("Wrong block body.")
+			return nil, err
+		}
+		blocks[k] = block
+	}
+	return &syncpb.ChunkData{Blocks: blocks, Root: chunkHeader.Root}, nil
+}
+
+// stateSyncAncestorsStart return the height of the first ancestor kept with a state pivot,
+// the blocks in two dynasties before the pivot are needed to verify the following blocks.
+func stateSyncAncestorsStart(height uint64, blocksInDynasty uint64) uint64 {
+	start := uint64(2)
+	if height > 2*blocksInDynasty+start {
+		start = height - 2*blocksInDynasty
+	}
+	return start
+}
+
+// statePivotAncestorsStart return the height of the first ancestor sent with a state pivot,
+// the ones from the last checkpoint of the client, or the genesis, are needed to verify the pivot.
+func statePivotAncestorsStart(height uint64, blocksInDynasty uint64, from uint64) uint64 {
+	start := stateSyncAncestorsStart(height, blocksInDynasty)
+	if from < start {
+		start = from
+		if start < 2 {
+			start = 2
+		}
+	}
+	return start
+}
+
+// statePivotMaxHeight return the highest pivot verifiable from the last checkpoint of the client.
+func statePivotMaxHeight(blocksInDynasty uint64, from uint64) uint64 {
+	if from < 1 {
+		from = 1
+	}
+	return from + MaxStatePivotDynasties*blocksInDynasty
+}
+
+// generateStatePivot return the LIB, or the highest block verifiable from the client's checkpoint if lower.
+func (c *Chunk) generateStatePivot(request *syncpb.GetStatePivot) (*syncpb.StatePivot, error) {
+	blocksInDynasty := c.blockChain.ConsensusHandler().NumberOfBlocksInDynasty()
+	pivot := c.blockChain.LIB()
+	if max := statePivotMaxHeight(blocksInDynasty, request.From); pivot.Height() > max {
+		if pivot = c.blockChain.GetBlockOnCanonicalChainByHeight(max); pivot == nil {
+			return nil, ErrCannotFindBlockByHeight
+		}
+	}
+	pbBlock, err := pivot.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	statePivot := &syncpb.StatePivot{Block: pbBlock.(*corepb.Block)}
+
+	if second := c.blockChain.GetBlockOnCanonicalChainByHeight(2); second != nil {
+		pbSecond, err := core.NewCompactBlock(second).ToProto()
+		if err != nil {
+			return nil, err
+		}
+		statePivot.Second = pbSecond.(*corepb.CompactBlock)
+	}
+
+	start := statePivotAncestorsStart(pivot.Height(), blocksInDynasty, request.From)
+	for height := start; height < pivot.Height(); height++ {
+		block := c.blockChain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"height": height,
+			}).Debug("Failed to find the block on canonical chain.")
+			return nil, ErrCannotFindBlockByHeight
+		}
+		statePivot.Ancestors = append(statePivot.Ancestors, block.Hash())
+	}
+	return statePivot, nil
+}
+
+func (c *Chunk) generateTrieNodes(request *syncpb.GetTrieNodes) *syncpb.TrieNodes {
+	hashes := request.Hashes
+	if len(hashes) > trie.MaxNodesPerFetch {
+		hashes = hashes[:trie.MaxNodesPerFetch]
+	}
+
+	// only the values addressed by their hashes are served.
+	nodes := make([][]byte, len(hashes))
+	for idx, h := range hashes {
+		value, err := c.blockChain.Storage().Get(h)
+		if err != nil || !bytes.Equal(hash.Sha3256(value), h) {
+			continue
+		}
+		nodes[idx] = value
+	}
+	return &syncpb.TrieNodes{Hashes: hashes, Nodes: nodes}
+}
+
+// verifyStatePivot verify the pivot block's hash, its height and the count of its ancestors,
+// from is the height of the last checkpoint of the client.
+func verifyStatePivot(chainID uint32, blocksInDynasty uint64, from uint64, pivot *syncpb.StatePivot) error {
+	if pivot.Block == nil || pivot.Block.Header == nil {
+		return ErrInvalidStatePivot
+	}
+	calculated, err := core.HashPbBlock(pivot.Block)
+	if err != nil {
+		return err
+	}
+	if !calculated.Equals(pivot.Block.Header.Hash) || pivot.Block.Header.ChainId != chainID {
+		return ErrInvalidStatePivot
+	}
+	if pivot.Block.Height < from {
+		return ErrStatePivotBelowCheckpoint
+	}
+	if pivot.Block.Height > statePivotMaxHeight(blocksInDynasty, from) {
+		return ErrInvalidStatePivot
+	}
+	if pivot.Block.Height > 1 && (pivot.Second == nil || pivot.Second.Height != 2) {
+		return ErrInvalidStatePivot
+	}
+
+	count := 0
+	start := statePivotAncestorsStart(pivot.Block.Height, blocksInDynasty, from)
+	if pivot.Block.Height > start {
+		count = int(pivot.Block.Height - start)
+	}
+	if len(pivot.Ancestors) != count {
+		return ErrInvalidStatePivot
+	}
+	return nil
+}
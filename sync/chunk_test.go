@@ -29,6 +29,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
 	"github.com/stretchr/testify/assert"
 
 	"testing"
@@ -79,7 +80,7 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 		blocks = append(blocks, block)
 	}
 
-	meta, err := ck.generateChunkHeaders(blocks[1].Hash())
+	meta, err := ck.generateChunkHeaders(blocks[1].Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	assert.Equal(t, len(meta.ChunkHeaders), 3)
 	chunks, err := ck.generateChunkData(meta.ChunkHeaders[1])
@@ -90,27 +91,27 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 		assert.Equal(t, int(chunks.Blocks[i].Height), index)
 	}
 
-	meta, err = ck.generateChunkHeaders(chain.GenesisBlock().Hash())
+	meta, err = ck.generateChunkHeaders(chain.GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	assert.Equal(t, len(meta.ChunkHeaders), 3)
 
-	meta, err = ck.generateChunkHeaders(blocks[0].Hash())
+	meta, err = ck.generateChunkHeaders(blocks[0].Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	assert.Equal(t, len(meta.ChunkHeaders), 3)
 
-	meta, err = ck.generateChunkHeaders(blocks[31].Hash())
+	meta, err = ck.generateChunkHeaders(blocks[31].Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	assert.Equal(t, int(blocks[31].Height()), 33)
 	assert.Equal(t, len(meta.ChunkHeaders), 2)
 
-	meta, err = ck.generateChunkHeaders(blocks[62].Hash())
+	meta, err = ck.generateChunkHeaders(blocks[62].Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	assert.Equal(t, int(blocks[62].Height()), 64)
 	assert.Equal(t, len(meta.ChunkHeaders), 2)
 
 	neb2 := core.NewMockNeb(am, consensus, nil)
 	chain2 := neb2.BlockChain()
-	meta, err = ck.generateChunkHeaders(blocks[0].Hash())
+	meta, err = ck.generateChunkHeaders(blocks[0].Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
 	assert.Nil(t, err)
 	for _, header := range meta.ChunkHeaders {
 		chunk, err := ck.generateChunkData(header)
@@ -133,3 +134,27 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 	assert.Nil(t, checkBlock.FromProto(pbBlock))
 	assert.Equal(t, checkBlock.Hash(), tail.Hash())
 }
+
+func TestChunk_acceptedChunkHeadersSize(t *testing.T) {
+	chunkHeaders := func(count int, size int) *syncpb.ChunkHeaders {
+		headers := &syncpb.ChunkHeaders{}
+		for i := 0; i < count; i++ {
+			headers.ChunkHeaders = append(headers.ChunkHeaders, &syncpb.ChunkHeader{Headers: make([][]byte, size)})
+		}
+		return headers
+	}
+
+	size, ok := acceptedChunkHeadersSize(chunkHeaders(2, 16), 16, 2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(16), size)
+
+	// the old peers send the default sizes.
+	size, ok = acceptedChunkHeadersSize(chunkHeaders(MaxChunkPerSyncRequest, core.ChunkSize), 16, 2)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(core.ChunkSize), size)
+
+	_, ok = acceptedChunkHeadersSize(chunkHeaders(3, 16), 16, 2)
+	assert.False(t, ok)
+	_, ok = acceptedChunkHeadersSize(chunkHeaders(2, 8), 16, 2)
+	assert.False(t, ok)
+}
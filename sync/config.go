@@ -0,0 +1,142 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// Errors
+var (
+	ErrInvalidChunkSize           = errors.New("invalid sync chunk size")
+	ErrInvalidMaxChunksPerRequest = errors.New("invalid sync max chunks per request")
+	ErrInvalidChunkDataTimeout    = errors.New("invalid sync chunk data timeout")
+	ErrInvalidSyncInterval        = errors.New("invalid sync interval")
+	ErrInvalidPeerWindow          = errors.New("invalid sync peer window, min <= initial <= max is required")
+)
+
+// the limits of the sync config, the requests of the peers are bound by them as well.
+const (
+	MinChunkSize             = 8
+	MaxChunkSize             = 256
+	MaxChunksPerRequestLimit = 64
+	MaxBlocksPerRequest      = 2048
+	MaxChunkDataTimeout      = 300 // 300s.
+	MaxPeerWindowLimit       = 64
+)
+
+// Config is the parameters of the sync.
+type Config struct {
+	ChunkSize           uint64
+	MaxChunksPerRequest int
+	ChunkDataTimeout    time.Duration
+	SyncInterval        time.Duration
+	MinPeerWindow       int
+	InitialPeerWindow   int
+	MaxPeerWindow       int
+}
+
+// DefaultConfig return the config with the default values.
+func DefaultConfig() *Config {
+	return &Config{
+		ChunkSize:           core.ChunkSize,
+		MaxChunksPerRequest: MaxChunkPerSyncRequest,
+		ChunkDataTimeout:    GetChunkDataTimeout * time.Second,
+		SyncInterval:        SyncInterval * time.Second,
+		MinPeerWindow:       MinPeerWindowSize,
+		InitialPeerWindow:   InitialPeerWindowSize,
+		MaxPeerWindow:       MaxPeerWindowSize,
+	}
+}
+
+// NewConfig return the config of the sync section in neblet config, the missing values are the defaults.
+func NewConfig(conf *nebletpb.SyncConfig) (*Config, error) {
+	config := DefaultConfig()
+	if conf == nil {
+		return config, nil
+	}
+
+	if conf.ChunkSize > 0 {
+		config.ChunkSize = uint64(conf.ChunkSize)
+	}
+	if conf.MaxChunksPerRequest > 0 {
+		config.MaxChunksPerRequest = int(conf.MaxChunksPerRequest)
+	}
+	if conf.ChunkDataTimeout > 0 {
+		config.ChunkDataTimeout = time.Duration(conf.ChunkDataTimeout) * time.Second
+	}
+	if conf.SyncInterval > 0 {
+		config.SyncInterval = time.Duration(conf.SyncInterval) * time.Second
+	}
+	if conf.MinPeerWindow > 0 {
+		config.MinPeerWindow = int(conf.MinPeerWindow)
+	}
+	if conf.InitialPeerWindow > 0 {
+		config.InitialPeerWindow = int(conf.InitialPeerWindow)
+	}
+	if conf.MaxPeerWindow > 0 {
+		config.MaxPeerWindow = int(conf.MaxPeerWindow)
+	}
+
+	if err := config.Verify(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Verify check the values are in the limits.
+func (c *Config) Verify() error {
+	if !validChunkSize(c.ChunkSize) {
+		return ErrInvalidChunkSize
+	}
+	if !validMaxChunks(c.MaxChunksPerRequest) {
+		return ErrInvalidMaxChunksPerRequest
+	}
+	if c.ChunkDataTimeout < time.Second || c.ChunkDataTimeout > MaxChunkDataTimeout*time.Second {
+		return ErrInvalidChunkDataTimeout
+	}
+	if c.SyncInterval < time.Second {
+		return ErrInvalidSyncInterval
+	}
+	if c.MinPeerWindow < 1 || c.MinPeerWindow > c.InitialPeerWindow ||
+		c.InitialPeerWindow > c.MaxPeerWindow || c.MaxPeerWindow > MaxPeerWindowLimit {
+		return ErrInvalidPeerWindow
+	}
+	return nil
+}
+
+func validChunkSize(size uint64) bool {
+	return size >= MinChunkSize && size <= MaxChunkSize
+}
+
+func validMaxChunks(count int) bool {
+	return count >= 1 && count <= MaxChunksPerRequestLimit
+}
+
+// servedMaxChunks return the count of chunks served for a request, bound by MaxBlocksPerRequest.
+func servedMaxChunks(chunkSize uint64, maxChunks int) int {
+	if limit := int(MaxBlocksPerRequest / chunkSize); maxChunks > limit {
+		return limit
+	}
+	return maxChunks
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfig(t *testing.T) {
+	config, err := NewConfig(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultConfig(), config)
+	assert.Equal(t, uint64(core.ChunkSize), config.ChunkSize)
+
+	config, err = NewConfig(&nebletpb.SyncConfig{ChunkSize: 64, ChunkDataTimeout: 20, MaxPeerWindow: 16})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(64), config.ChunkSize)
+	assert.Equal(t, MaxChunkPerSyncRequest, config.MaxChunksPerRequest)
+	assert.Equal(t, 20*time.Second, config.ChunkDataTimeout)
+	assert.Equal(t, SyncInterval*time.Second, config.SyncInterval)
+	assert.Equal(t, 16, config.MaxPeerWindow)
+
+	tests := []struct {
+		conf *nebletpb.SyncConfig
+		err  error
+	}{
+		{&nebletpb.SyncConfig{ChunkSize: MinChunkSize - 1}, ErrInvalidChunkSize},
+		{&nebletpb.SyncConfig{ChunkSize: MaxChunkSize + 1}, ErrInvalidChunkSize},
+		{&nebletpb.SyncConfig{MaxChunksPerRequest: MaxChunksPerRequestLimit + 1}, ErrInvalidMaxChunksPerRequest},
+		{&nebletpb.SyncConfig{ChunkDataTimeout: MaxChunkDataTimeout + 1}, ErrInvalidChunkDataTimeout},
+		{&nebletpb.SyncConfig{MinPeerWindow: 4, InitialPeerWindow: 3}, ErrInvalidPeerWindow},
+		{&nebletpb.SyncConfig{InitialPeerWindow: 9}, ErrInvalidPeerWindow},
+		{&nebletpb.SyncConfig{MaxPeerWindow: MaxPeerWindowLimit + 1}, ErrInvalidPeerWindow},
+	}
+	for _, tt := range tests {
+		_, err := NewConfig(tt.conf)
+		assert.Equal(t, tt.err, err)
+	}
+}
+
+func TestServedMaxChunks(t *testing.T) {
+	assert.Equal(t, MaxChunkPerSyncRequest, servedMaxChunks(core.ChunkSize, MaxChunkPerSyncRequest))
+	assert.Equal(t, MaxBlocksPerRequest/MaxChunkSize, servedMaxChunks(MaxChunkSize, MaxChunksPerRequestLimit))
+	assert.Equal(t, MaxChunksPerRequestLimit, servedMaxChunks(MinChunkSize, MaxChunksPerRequestLimit))
+}
@@ -12,6 +12,14 @@ It has these top-level messages:
 	ChunkHeader
 	ChunkHeaders
 	ChunkData
+	BlockHeaders
+	BlockBody
+	BlockBodies
+	GetStatePivot
+	StatePivot
+	GetTrieNodes
+	TrieNodes
+	PendingSync
 */
 package syncpb
 
@@ -38,6 +46,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type Sync struct {
 	TailBlockHash []byte `protobuf:"bytes,1,opt,name=tail_block_hash,json=tailBlockHash,proto3" json:"tail_block_hash,omitempty"`
+	// zero for the defaults of the old peers.
+	ChunkSize uint32 `protobuf:"varint,2,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	MaxChunks uint32 `protobuf:"varint,3,opt,name=max_chunks,json=maxChunks,proto3" json:"max_chunks,omitempty"`
 }
 
 func (m *Sync) Reset()                    { *m = Sync{} }
@@ -52,6 +63,20 @@ func (m *Sync) GetTailBlockHash() []byte {
 	return nil
 }
 
+func (m *Sync) GetChunkSize() uint32 {
+	if m != nil {
+		return m.ChunkSize
+	}
+	return 0
+}
+
+func (m *Sync) GetMaxChunks() uint32 {
+	if m != nil {
+		return m.MaxChunks
+	}
+	return 0
+}
+
 type ChunkHeader struct {
 	Headers [][]byte `protobuf:"bytes,1,rep,name=headers" json:"headers,omitempty"`
 	Root    []byte   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
@@ -79,6 +104,8 @@ func (m *ChunkHeader) GetRoot() []byte {
 type ChunkHeaders struct {
 	ChunkHeaders []*ChunkHeader `protobuf:"bytes,1,rep,name=chunkHeaders" json:"chunkHeaders,omitempty"`
 	Root         []byte         `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// zero for the old peers.
+	TailHeight uint64 `protobuf:"varint,3,opt,name=tail_height,json=tailHeight,proto3" json:"tail_height,omitempty"`
 }
 
 func (m *ChunkHeaders) Reset()                    { *m = ChunkHeaders{} }
@@ -100,6 +127,13 @@ func (m *ChunkHeaders) GetRoot() []byte {
 	return nil
 }
 
+func (m *ChunkHeaders) GetTailHeight() uint64 {
+	if m != nil {
+		return m.TailHeight
+	}
+	return 0
+}
+
 type ChunkData struct {
 	Blocks []*corepb.Block `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
 	Root   []byte          `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
@@ -124,30 +158,231 @@ func (m *ChunkData) GetRoot() []byte {
 	return nil
 }
 
+type BlockHeaders struct {
+	Headers []*corepb.CompactBlock `protobuf:"bytes,1,rep,name=headers" json:"headers,omitempty"`
+	Root    []byte                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *BlockHeaders) Reset()                    { *m = BlockHeaders{} }
+func (m *BlockHeaders) String() string            { return proto.CompactTextString(m) }
+func (*BlockHeaders) ProtoMessage()               {}
+func (*BlockHeaders) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{4} }
+
+func (m *BlockHeaders) GetHeaders() []*corepb.CompactBlock {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *BlockHeaders) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type BlockBody struct {
+	Transactions []*corepb.Transaction `protobuf:"bytes,1,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *BlockBody) Reset()                    { *m = BlockBody{} }
+func (m *BlockBody) String() string            { return proto.CompactTextString(m) }
+func (*BlockBody) ProtoMessage()               {}
+func (*BlockBody) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{5} }
+
+func (m *BlockBody) GetTransactions() []*corepb.Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type BlockBodies struct {
+	Bodies []*BlockBody `protobuf:"bytes,1,rep,name=bodies" json:"bodies,omitempty"`
+	Root   []byte       `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *BlockBodies) Reset()                    { *m = BlockBodies{} }
+func (m *BlockBodies) String() string            { return proto.CompactTextString(m) }
+func (*BlockBodies) ProtoMessage()               {}
+func (*BlockBodies) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{6} }
+
+func (m *BlockBodies) GetBodies() []*BlockBody {
+	if m != nil {
+		return m.Bodies
+	}
+	return nil
+}
+
+func (m *BlockBodies) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type GetStatePivot struct {
+	From uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *GetStatePivot) Reset()                    { *m = GetStatePivot{} }
+func (m *GetStatePivot) String() string            { return proto.CompactTextString(m) }
+func (*GetStatePivot) ProtoMessage()               {}
+func (*GetStatePivot) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{7} }
+
+func (m *GetStatePivot) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+type StatePivot struct {
+	Block     *corepb.Block        `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	Ancestors [][]byte             `protobuf:"bytes,2,rep,name=ancestors" json:"ancestors,omitempty"`
+	Second    *corepb.CompactBlock `protobuf:"bytes,3,opt,name=second" json:"second,omitempty"`
+}
+
+func (m *StatePivot) Reset()                    { *m = StatePivot{} }
+func (m *StatePivot) String() string            { return proto.CompactTextString(m) }
+func (*StatePivot) ProtoMessage()               {}
+func (*StatePivot) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{8} }
+
+func (m *StatePivot) GetBlock() *corepb.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *StatePivot) GetAncestors() [][]byte {
+	if m != nil {
+		return m.Ancestors
+	}
+	return nil
+}
+
+func (m *StatePivot) GetSecond() *corepb.CompactBlock {
+	if m != nil {
+		return m.Second
+	}
+	return nil
+}
+
+type GetTrieNodes struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+}
+
+func (m *GetTrieNodes) Reset()                    { *m = GetTrieNodes{} }
+func (m *GetTrieNodes) String() string            { return proto.CompactTextString(m) }
+func (*GetTrieNodes) ProtoMessage()               {}
+func (*GetTrieNodes) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{9} }
+
+func (m *GetTrieNodes) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+type TrieNodes struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes" json:"hashes,omitempty"`
+	Nodes  [][]byte `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (m *TrieNodes) Reset()                    { *m = TrieNodes{} }
+func (m *TrieNodes) String() string            { return proto.CompactTextString(m) }
+func (*TrieNodes) ProtoMessage()               {}
+func (*TrieNodes) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{10} }
+
+func (m *TrieNodes) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func (m *TrieNodes) GetNodes() [][]byte {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type PendingSync struct {
+	SyncPoint    []byte        `protobuf:"bytes,1,opt,name=sync_point,json=syncPoint,proto3" json:"sync_point,omitempty"`
+	ChunkHeaders *ChunkHeaders `protobuf:"bytes,2,opt,name=chunk_headers,json=chunkHeaders" json:"chunk_headers,omitempty"`
+}
+
+func (m *PendingSync) Reset()                    { *m = PendingSync{} }
+func (m *PendingSync) String() string            { return proto.CompactTextString(m) }
+func (*PendingSync) ProtoMessage()               {}
+func (*PendingSync) Descriptor() ([]byte, []int) { return fileDescriptorSync, []int{11} }
+
+func (m *PendingSync) GetSyncPoint() []byte {
+	if m != nil {
+		return m.SyncPoint
+	}
+	return nil
+}
+
+func (m *PendingSync) GetChunkHeaders() *ChunkHeaders {
+	if m != nil {
+		return m.ChunkHeaders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Sync)(nil), "syncpb.Sync")
 	proto.RegisterType((*ChunkHeader)(nil), "syncpb.ChunkHeader")
 	proto.RegisterType((*ChunkHeaders)(nil), "syncpb.ChunkHeaders")
 	proto.RegisterType((*ChunkData)(nil), "syncpb.ChunkData")
+	proto.RegisterType((*BlockHeaders)(nil), "syncpb.BlockHeaders")
+	proto.RegisterType((*BlockBody)(nil), "syncpb.BlockBody")
+	proto.RegisterType((*BlockBodies)(nil), "syncpb.BlockBodies")
+	proto.RegisterType((*GetStatePivot)(nil), "syncpb.GetStatePivot")
+	proto.RegisterType((*StatePivot)(nil), "syncpb.StatePivot")
+	proto.RegisterType((*GetTrieNodes)(nil), "syncpb.GetTrieNodes")
+	proto.RegisterType((*TrieNodes)(nil), "syncpb.TrieNodes")
+	proto.RegisterType((*PendingSync)(nil), "syncpb.PendingSync")
 }
 
 func init() { proto.RegisterFile("sync.proto", fileDescriptorSync) }
 
 var fileDescriptorSync = []byte{
-	// 236 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x41, 0x4b, 0x03, 0x31,
-	0x10, 0x85, 0x59, 0x2d, 0x2b, 0x4e, 0xb7, 0x08, 0xf1, 0xb2, 0x78, 0x2a, 0x0b, 0x4a, 0x2f, 0x26,
-	0x60, 0x0f, 0x1e, 0xbc, 0xa9, 0x48, 0xcf, 0xeb, 0xd1, 0x43, 0x49, 0x62, 0x68, 0x96, 0xae, 0x99,
-	0x90, 0x64, 0x0f, 0xfd, 0xf7, 0x92, 0x69, 0x0b, 0x5b, 0xd8, 0xdb, 0x7b, 0x33, 0xf3, 0x3e, 0x78,
-	0x03, 0x10, 0x0f, 0x4e, 0x73, 0x1f, 0x30, 0x21, 0x2b, 0xb3, 0xf6, 0xea, 0x61, 0xbd, 0xeb, 0x92,
-	0x1d, 0x14, 0xd7, 0xf8, 0x27, 0x9c, 0x51, 0x43, 0x2f, 0x63, 0x87, 0x62, 0x87, 0xcf, 0x27, 0x23,
-	0x34, 0x06, 0x23, 0xbc, 0x12, 0xaa, 0x47, 0xbd, 0x3f, 0x86, 0x1b, 0x0e, 0xb3, 0xef, 0x83, 0xd3,
-	0xec, 0x09, 0xee, 0x92, 0xec, 0xfa, 0x2d, 0xed, 0xb6, 0x56, 0x46, 0x5b, 0x17, 0xcb, 0x62, 0x55,
-	0xb5, 0x8b, 0x3c, 0x7e, 0xcf, 0xd3, 0x8d, 0x8c, 0xb6, 0x79, 0x83, 0xf9, 0x87, 0x1d, 0xdc, 0x7e,
-	0x63, 0xe4, 0xaf, 0x09, 0xac, 0x86, 0x1b, 0x4b, 0x2a, 0xd6, 0xc5, 0xf2, 0x7a, 0x55, 0xb5, 0x67,
-	0xcb, 0x18, 0xcc, 0x02, 0x62, 0xaa, 0xaf, 0x88, 0x42, 0xba, 0xf9, 0x81, 0x6a, 0x14, 0x8e, 0xec,
-	0x15, 0x2a, 0x3d, 0xf2, 0x84, 0x98, 0xbf, 0xdc, 0xf3, 0x63, 0x21, 0x3e, 0xba, 0x6d, 0x2f, 0x0e,
-	0x27, 0xe1, 0x5f, 0x70, 0x4b, 0x81, 0x4f, 0x99, 0x24, 0x7b, 0x84, 0x92, 0x9a, 0x9c, 0x99, 0x0b,
-	0x9e, 0xcb, 0x7b, 0xc5, 0xa9, 0x49, 0x7b, 0x5a, 0x4e, 0x71, 0x54, 0x49, 0x8f, 0x59, 0xff, 0x07,
-	0x00, 0x00, 0xff, 0xff, 0x73, 0x84, 0xc9, 0xac, 0x63, 0x01, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x51, 0x6b, 0xdb, 0x30,
+	0x10, 0x26, 0x6d, 0xea, 0xe1, 0xb3, 0xc3, 0x98, 0x56, 0x86, 0x19, 0x2b, 0x0b, 0x2e, 0x2b, 0x19,
+	0x6c, 0x0e, 0xb4, 0x0f, 0xa3, 0xec, 0xad, 0x2d, 0x6b, 0x1e, 0xc6, 0x08, 0x4a, 0xdf, 0x83, 0xac,
+	0x68, 0xb6, 0x68, 0x2c, 0x19, 0x4b, 0x19, 0x4d, 0xd9, 0xfe, 0xfb, 0xd0, 0x59, 0x5e, 0x12, 0x9a,
+	0x6d, 0x6f, 0x77, 0xdf, 0x7d, 0xfe, 0x24, 0x7d, 0xfe, 0x0e, 0xc0, 0xac, 0x15, 0xcf, 0xea, 0x46,
+	0x5b, 0x4d, 0x02, 0x57, 0xd7, 0xf9, 0xeb, 0x8b, 0x42, 0xda, 0x72, 0x95, 0x67, 0x5c, 0x57, 0x63,
+	0x25, 0xf2, 0xd5, 0x92, 0x19, 0xa9, 0xc7, 0x85, 0xfe, 0xe8, 0x9b, 0x31, 0xd7, 0x8d, 0x18, 0xd7,
+	0xf9, 0x38, 0x5f, 0x6a, 0x7e, 0xdf, 0x7e, 0x9c, 0x2e, 0xa1, 0x3f, 0x5b, 0x2b, 0x4e, 0xce, 0xe0,
+	0xb9, 0x65, 0x72, 0x39, 0xc7, 0xd9, 0xbc, 0x64, 0xa6, 0x4c, 0x7a, 0xc3, 0xde, 0x28, 0xa6, 0x03,
+	0x07, 0x5f, 0x39, 0x74, 0xc2, 0x4c, 0x49, 0x4e, 0x00, 0x78, 0xb9, 0x52, 0xf7, 0x73, 0x23, 0x1f,
+	0x45, 0x72, 0x30, 0xec, 0x8d, 0x06, 0x34, 0x44, 0x64, 0x26, 0x1f, 0x85, 0x1b, 0x57, 0xec, 0x61,
+	0x8e, 0x80, 0x49, 0x0e, 0xdb, 0x71, 0xc5, 0x1e, 0xae, 0x11, 0x48, 0x3f, 0x43, 0x84, 0xd5, 0x44,
+	0xb0, 0x85, 0x68, 0x48, 0x02, 0xcf, 0x4a, 0xac, 0x4c, 0xd2, 0x1b, 0x1e, 0x8e, 0x62, 0xda, 0xb5,
+	0x84, 0x40, 0xbf, 0xd1, 0xda, 0xe2, 0x01, 0x31, 0xc5, 0x3a, 0xfd, 0x09, 0xf1, 0xd6, 0xc7, 0x86,
+	0x7c, 0x82, 0x98, 0x6f, 0xf5, 0x28, 0x11, 0x9d, 0xbf, 0xcc, 0x5a, 0x3b, 0xb2, 0x2d, 0x2e, 0xdd,
+	0x21, 0xee, 0x13, 0x27, 0x6f, 0x21, 0xc2, 0xf7, 0x97, 0x42, 0x16, 0xa5, 0xc5, 0x9b, 0xf7, 0x29,
+	0x38, 0x68, 0x82, 0x48, 0xfa, 0x05, 0x42, 0x54, 0xbc, 0x61, 0x96, 0x91, 0x77, 0x10, 0xa0, 0x51,
+	0xdd, 0xa1, 0x83, 0xcc, 0x79, 0x5b, 0xe7, 0x19, 0x1a, 0x45, 0xfd, 0x70, 0xef, 0x2b, 0x28, 0xc4,
+	0xad, 0x9b, 0xfe, 0x32, 0xd9, 0xae, 0x07, 0xd1, 0xf9, 0x71, 0xa7, 0x75, 0xad, 0xab, 0x9a, 0x71,
+	0xdb, 0x4a, 0xfe, 0xd3, 0x99, 0x1b, 0x08, 0x91, 0x75, 0xa5, 0x17, 0x6b, 0x67, 0x8b, 0x6d, 0x98,
+	0x32, 0x8c, 0x5b, 0xa9, 0xd5, 0xc6, 0x16, 0xaf, 0x7a, 0xb7, 0x99, 0xd1, 0x1d, 0x62, 0xfa, 0x15,
+	0xa2, 0x4e, 0x45, 0x0a, 0x43, 0xde, 0x43, 0x90, 0x63, 0xe5, 0x15, 0x5e, 0x74, 0xc6, 0xfe, 0x39,
+	0x8a, 0x7a, 0xc2, 0xde, 0x3b, 0x9d, 0xc2, 0xe0, 0x56, 0xd8, 0x99, 0x65, 0x56, 0x4c, 0xe5, 0x0f,
+	0x6d, 0x1d, 0xe9, 0x7b, 0xa3, 0x2b, 0x8c, 0x55, 0x9f, 0x62, 0x9d, 0xfe, 0x02, 0xd8, 0x62, 0x9c,
+	0xc2, 0x11, 0x1a, 0x87, 0x94, 0x27, 0xa6, 0xb6, 0x33, 0xf2, 0x06, 0x42, 0xa6, 0xb8, 0x30, 0x56,
+	0x37, 0x26, 0x39, 0xc0, 0xd4, 0x6c, 0x00, 0xf2, 0x01, 0x02, 0x23, 0xb8, 0x56, 0x0b, 0xfc, 0x83,
+	0x7f, 0x33, 0xd3, 0x73, 0xd2, 0x33, 0x88, 0x6f, 0x85, 0xbd, 0x6b, 0xa4, 0xf8, 0xa6, 0x17, 0xc2,
+	0x90, 0x57, 0x10, 0xb8, 0xe4, 0x8b, 0x2e, 0x8e, 0xbe, 0x4b, 0x2f, 0x21, 0xfc, 0x2f, 0x89, 0x1c,
+	0xc3, 0x91, 0x72, 0x04, 0x7f, 0xa9, 0xb6, 0x49, 0x0b, 0x88, 0xa6, 0x42, 0x2d, 0xa4, 0x2a, 0x70,
+	0xcd, 0x4e, 0xda, 0xcd, 0x9d, 0xd7, 0x5a, 0x2a, 0xeb, 0x37, 0x2c, 0x74, 0xc8, 0xd4, 0x01, 0xe4,
+	0x12, 0x06, 0xed, 0x76, 0x75, 0x91, 0x38, 0xf0, 0xaf, 0x78, 0x9a, 0x69, 0xb3, 0x1b, 0xea, 0x3c,
+	0xc0, 0x7d, 0xbe, 0xf8, 0x3d, 0x00, 0xf2, 0x64, 0x04, 0x92, 0x1a, 0x04, 0x00, 0x00,
 }
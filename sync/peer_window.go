@@ -0,0 +1,106 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"math"
+	"time"
+)
+
+// the weight of a new sample in the moving average of the latency.
+const latencyWeight = 0.2
+
+// peerWindow limits the sync requests in flight to a peer. The window is sized from
+// the measured throughput of the peer, to keep the latency of a request at a target:
+// it grows when the peer answers faster, shrinks when slower and halves when it times out.
+type peerWindow struct {
+	size     int
+	inflight int
+
+	min, max      int
+	targetLatency float64
+	latency       float64 // moving average in seconds.
+	throughput    float64 // chunks per second.
+}
+
+func newPeerWindow(config *Config) *peerWindow {
+	return &peerWindow{
+		size: config.InitialPeerWindow,
+		min:  config.MinPeerWindow,
+		max:  config.MaxPeerWindow,
+		// half of the timeout leaves room for the slow responses.
+		targetLatency: config.ChunkDataTimeout.Seconds() / 2,
+	}
+}
+
+// available return how many more requests could be sent to the peer.
+func (w *peerWindow) available() int {
+	return w.size - w.inflight
+}
+
+func (w *peerWindow) onRequest() {
+	w.inflight++
+}
+
+func (w *peerWindow) onSuccess(elapsed time.Duration) {
+	if w.inflight > 0 {
+		w.inflight--
+	}
+
+	sample := elapsed.Seconds()
+	if w.latency == 0 {
+		w.latency = sample
+	} else {
+		w.latency = w.latency*(1-latencyWeight) + sample*latencyWeight
+	}
+	if w.latency <= 0 {
+		// too fast to measure.
+		w.grow()
+		return
+	}
+
+	// the requests in flight answered in latency on average.
+	w.throughput = float64(w.size) / w.latency
+	target := int(math.Ceil(w.throughput * w.targetLatency))
+	if target > w.size {
+		// grow step by step, the throughput may not scale.
+		w.grow()
+	} else if target < w.size {
+		w.size = target
+		if w.size < w.min {
+			w.size = w.min
+		}
+	}
+}
+
+func (w *peerWindow) onFailure() {
+	if w.inflight > 0 {
+		w.inflight--
+	}
+	w.size /= 2
+	if w.size < w.min {
+		w.size = w.min
+	}
+}
+
+func (w *peerWindow) grow() {
+	if w.size < w.max {
+		w.size++
+	}
+}
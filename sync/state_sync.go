@@ -0,0 +1,471 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core"
+	corepb "github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/net"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// stateSync downloads the state at a LIB agreed by the peers instead of executing the history blocks.
+// The headers from the last checkpoint, or the genesis, to the pivot are verified by the consensus,
+// so the pivot is bounded to a few dynasties after the checkpoint. The trie nodes are addressed by
+// their hashes, so the state synced from the roots in the pivot header is exactly the state of the pivot.
+// The ancestors in two dynasties before the pivot are stored without their states,
+// they are only needed to verify the following blocks.
+type stateSync struct {
+	blockChain  *core.BlockChain
+	netService  net.Service
+	config      *Config
+	quitCh      chan bool
+	pivotCh     chan net.Message
+	nodesCh     chan net.Message
+	chunkDataCh chan net.Message
+
+	peers []string
+	next  int
+}
+
+func newStateSync(blockChain *core.BlockChain, netService net.Service, quitCh chan bool, config *Config) *stateSync {
+	return &stateSync{
+		blockChain:  blockChain,
+		config:      config,
+		netService:  netService,
+		quitCh:      quitCh,
+		pivotCh:     make(chan net.Message, 128),
+		nodesCh:     make(chan net.Message, 128),
+		chunkDataCh: make(chan net.Message, 128),
+	}
+}
+
+// put forwards a response to the state sync without blocking.
+func (ss *stateSync) put(ch chan net.Message, message net.Message) {
+	select {
+	case ch <- message:
+	default:
+	}
+}
+
+// run syncs the state at the pivot and resets the chain to it,
+// return nil without syncing if the local chain is close enough to the pivot.
+func (ss *stateSync) run() error {
+	verifier, ok := ss.blockChain.ConsensusHandler().(core.HeaderVerifier)
+	if !ok {
+		return ErrStateSyncUnsupported
+	}
+
+	pivot, second, ancestorHashes, err := ss.syncPivot()
+	if err != nil {
+		return err
+	}
+	if pivot.Height() < ss.blockChain.TailBlock().Height()+StateSyncMinGap {
+		logging.VLog().WithFields(logrus.Fields{
+			"pivot": pivot,
+			"tail":  ss.blockChain.TailBlock(),
+		}).Info("The pivot is close to tail, no need to sync state.")
+		return nil
+	}
+
+	ancestors, err := ss.syncAncestors(ancestorHashes)
+	if err != nil {
+		return err
+	}
+	if err := ss.verifyPivotChain(verifier, second, ancestors, pivot); err != nil {
+		return err
+	}
+
+	// only the ancestors needed to verify the following blocks are kept.
+	start := stateSyncAncestorsStart(pivot.Height(), ss.blockChain.ConsensusHandler().NumberOfBlocksInDynasty())
+	kept := []*core.Block{}
+	for _, block := range ancestors {
+		if block.Height() >= start {
+			kept = append(kept, block)
+		}
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"pivot":     pivot,
+		"ancestors": len(kept),
+		"peers":     ss.peers,
+	}).Info("Starting to sync state.")
+
+	for _, block := range kept {
+		if err := ss.syncBlockState(block, false); err != nil {
+			return err
+		}
+	}
+	if err := ss.syncBlockState(pivot, true); err != nil {
+		return err
+	}
+
+	return ss.blockChain.ResetToSyncedBlock(pivot, kept)
+}
+
+// syncPivot asks the peers for their LIB until the most of them agree on one,
+// return the pivot, the header at height 2 and the hashes of the ancestors.
+func (ss *stateSync) syncPivot() (*core.Block, *core.CompactBlock, [][]byte, error) {
+	from := ss.blockChain.LastCheckpoint()
+	data, err := proto.Marshal(&syncpb.GetStatePivot{From: from})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	chainID := ss.blockChain.ChainID()
+	blocksInDynasty := ss.blockChain.ConsensusHandler().NumberOfBlocksInDynasty()
+	for retry := 0; retry < MaxStateSyncRetries; retry++ {
+		peers := ss.netService.SendMessageToPeers(net.StatePivotRequest, data,
+			net.MessagePriorityLow, new(net.ChainSyncPeersFilter))
+
+		requested := make(map[string]bool)
+		for _, peer := range peers {
+			requested[peer] = true
+		}
+		agreed := make(map[string][]string)
+		timeout := time.NewTimer(ss.config.ChunkDataTimeout)
+
+	WAIT:
+		for len(peers) > 0 {
+			select {
+			case <-ss.quitCh:
+				timeout.Stop()
+				return nil, nil, nil, ErrStateSyncStopped
+			case <-timeout.C:
+				break WAIT
+			case message := <-ss.pivotCh:
+				if !requested[message.MessageFrom()] {
+					continue
+				}
+				delete(requested, message.MessageFrom())
+
+				pivot := new(syncpb.StatePivot)
+				if err := proto.Unmarshal(message.Data(), pivot); err != nil {
+					ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidStatePivot)
+					continue
+				}
+				if err := ss.verifyStatePivot(chainID, blocksInDynasty, from, pivot); err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"err": err,
+						"pid": message.MessageFrom(),
+					}).Debug("Wrong StatePivot message data.")
+					// the peer may be behind the checkpoint.
+					if err != ErrStatePivotBelowCheckpoint {
+						ss.netService.ClosePeer(message.MessageFrom(), err)
+					}
+					continue
+				}
+
+				hashes := append([][]byte{pivot.Block.Header.Hash}, pivot.Ancestors...)
+				if pivot.Second != nil {
+					hashes = append(hashes, pivot.Second.Header.Hash)
+				}
+				key := byteutils.Hex(hash.Sha3256(hashes...))
+				agreed[key] = append(agreed[key], message.MessageFrom())
+				if len(agreed[key]) < len(peers)/2+1 {
+					continue
+				}
+
+				timeout.Stop()
+				// the pivot chain is verified from the header at height 2.
+				if pivot.Second == nil {
+					return nil, nil, nil, ErrInvalidStatePivot
+				}
+				block := new(core.Block)
+				if err := block.FromProto(pivot.Block); err != nil {
+					return nil, nil, nil, err
+				}
+				second := new(core.CompactBlock)
+				if err := second.FromProto(pivot.Second); err != nil {
+					return nil, nil, nil, err
+				}
+				ss.peers = agreed[key]
+				return block, second, pivot.Ancestors, nil
+			}
+		}
+		timeout.Stop()
+
+		logging.VLog().WithFields(logrus.Fields{
+			"peers": peers,
+			"retry": retry,
+		}).Debug("Peers do not agree on a state pivot, retry.")
+		if len(peers) == 0 {
+			select {
+			case <-ss.quitCh:
+				return nil, nil, nil, ErrStateSyncStopped
+			case <-time.After(ss.config.ChunkDataTimeout):
+			}
+		}
+	}
+	return nil, nil, nil, ErrStateSyncTimeout
+}
+
+// verifyStatePivot verify the pivot and its ancestors against the checkpoints as well.
+func (ss *stateSync) verifyStatePivot(chainID uint32, blocksInDynasty uint64, from uint64, pivot *syncpb.StatePivot) error {
+	if err := verifyStatePivot(chainID, blocksInDynasty, from, pivot); err != nil {
+		return err
+	}
+	if err := ss.blockChain.VerifyCheckpoint(pivot.Block.Height, pivot.Block.Header.Hash); err != nil {
+		return err
+	}
+	start := statePivotAncestorsStart(pivot.Block.Height, blocksInDynasty, from)
+	for idx, ancestor := range pivot.Ancestors {
+		if err := ss.blockChain.VerifyCheckpoint(start+uint64(idx), ancestor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyPivotChain verifies the headers of the ancestors and the pivot by the consensus,
+// from the last checkpoint or the genesis. The header at height 2 is verified from the genesis.
+func (ss *stateSync) verifyPivotChain(verifier core.HeaderVerifier, second *core.CompactBlock, ancestors []*core.Block, pivot *core.Block) error {
+	headers := make([]*core.CompactBlock, 0, len(ancestors)+1)
+	for _, block := range ancestors {
+		headers = append(headers, core.NewCompactBlock(block))
+	}
+	headers = append(headers, core.NewCompactBlock(pivot))
+	if headers[0].Height() == second.Height() && !headers[0].Hash().Equals(second.Hash()) {
+		return ErrInvalidStatePivot
+	}
+
+	genesis := core.NewCompactBlock(ss.blockChain.GenesisBlock())
+	if trusted, err := verifier.VerifyHeaders(nil, genesis, []*core.CompactBlock{second}, ss); err != nil || trusted != 1 {
+		logging.VLog().WithFields(logrus.Fields{
+			"second": second.Hash(),
+			"err":    err,
+		}).Debug("Failed to verify the second header.")
+		return ErrInvalidStatePivot
+	}
+
+	// the ancestors before the checkpoint are linked to it by their hashes.
+	parent := genesis
+	if from := ss.blockChain.LastCheckpoint(); from > 1 {
+		idx := int(from - headers[0].Height())
+		if idx < 0 || idx >= len(headers) || !ss.blockChain.Checkpoints()[from].Equals(headers[idx].Hash()) {
+			return core.ErrCheckpointMismatch
+		}
+		parent, headers = headers[idx], headers[idx+1:]
+	}
+
+	trusted, err := verifier.VerifyHeaders(second, parent, headers, ss)
+	if err != nil {
+		return err
+	}
+	if trusted < len(headers) {
+		logging.VLog().WithFields(logrus.Fields{
+			"trusted": trusted,
+			"headers": len(headers),
+			"pivot":   pivot,
+		}).Debug("The dynasty of the pivot is not endorsed.")
+		return ErrStatePivotNotEndorsed
+	}
+	return nil
+}
+
+// syncAncestors downloads the ancestors of the pivot chunk by chunk.
+func (ss *stateSync) syncAncestors(hashes [][]byte) ([]*core.Block, error) {
+	ancestors := []*core.Block{}
+	chunkSize := int(ss.config.ChunkSize)
+	for start := 0; start < len(hashes); start += chunkSize {
+		end := start + chunkSize
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		chunkHeader, err := newChunkHeader(hashes[start:end])
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(chunkHeader)
+		if err != nil {
+			return nil, err
+		}
+
+		var chunkData *syncpb.ChunkData
+		err = ss.request(net.ChunkDataRequest, data, ss.chunkDataCh, func(message net.Message) (bool, error) {
+			resp := new(syncpb.ChunkData)
+			if err := proto.Unmarshal(message.Data(), resp); err != nil {
+				return true, ErrInvalidChainChunkDataMessageData
+			}
+			if !bytes.Equal(resp.Root, chunkHeader.Root) {
+				return false, nil
+			}
+			if ok, err := verifyChunkData(chunkHeader, resp); !ok {
+				return true, err
+			}
+			chunkData = resp
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range chunkData.Blocks {
+			block := new(core.Block)
+			if err := block.FromProto(v); err != nil {
+				return nil, err
+			}
+			ancestors = append(ancestors, block)
+		}
+	}
+	return ancestors, nil
+}
+
+// syncBlockState downloads the whole states of the block, or only the roots of them.
+func (ss *stateSync) syncBlockState(block *core.Block, full bool) error {
+	roots := [][]byte{block.StateRoot(), block.TxsRoot(), block.EventsRoot(), block.ConsensusRoot().DynastyRoot}
+	for _, root := range roots {
+		if err := ss.syncTrie(root, full); err != nil {
+			return err
+		}
+	}
+	if !full {
+		return nil
+	}
+
+	// the storage of contracts are tries as well.
+	accounts, err := trie.NewTrie(block.StateRoot(), ss.blockChain.Storage(), false)
+	if err != nil {
+		return err
+	}
+	iter, err := accounts.Iterator(nil)
+	if err != nil {
+		return err
+	}
+	exist, err := iter.Next()
+	for exist {
+		account := new(corepb.Account)
+		if err := proto.Unmarshal(iter.Value(), account); err != nil {
+			return err
+		}
+		if err := ss.syncTrie(account.VarsHash, true); err != nil {
+			return err
+		}
+		exist, err = iter.Next()
+	}
+	return err
+}
+
+func (ss *stateSync) syncTrie(root []byte, full bool) error {
+	if len(root) == 0 {
+		return nil
+	}
+	t, err := trie.NewTrie(nil, ss.blockChain.Storage(), false)
+	if err != nil {
+		return err
+	}
+	t.SetNodeFetcher(ss)
+	if full {
+		return t.SyncTrie(root)
+	}
+	return t.SyncPath(root, nil)
+}
+
+// FetchNodes implements trie.NodeFetcher interface
+func (ss *stateSync) FetchNodes(hashes [][]byte) ([][]byte, error) {
+	data, err := proto.Marshal(&syncpb.GetTrieNodes{Hashes: hashes})
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes [][]byte
+	err = ss.request(net.TrieNodesRequest, data, ss.nodesCh, func(message net.Message) (bool, error) {
+		resp := new(syncpb.TrieNodes)
+		if err := proto.Unmarshal(message.Data(), resp); err != nil {
+			return true, ErrWrongTrieNodes
+		}
+		if len(resp.Hashes) != len(hashes) || len(hashes) == 0 || !bytes.Equal(resp.Hashes[0], hashes[0]) {
+			return false, nil
+		}
+		if len(resp.Nodes) != len(hashes) {
+			return true, ErrWrongTrieNodes
+		}
+		for idx, node := range resp.Nodes {
+			if len(node) == 0 {
+				return true, trie.ErrSyncNodeNotFound
+			}
+			if !bytes.Equal(hash.Sha3256(node), hashes[idx]) {
+				return true, ErrWrongTrieNodes
+			}
+		}
+		nodes = resp.Nodes
+		return true, nil
+	})
+	return nodes, err
+}
+
+// request sends the message to the agreeing peers in turn, until one of them answers.
+// accept return whether the response matches the request, and the error if it's wrong.
+func (ss *stateSync) request(messageName string, data []byte, respCh chan net.Message, accept func(net.Message) (bool, error)) error {
+	for retry := 0; retry < MaxStateSyncRetries*len(ss.peers); retry++ {
+		peer := ss.peers[ss.next%len(ss.peers)]
+		ss.next++
+		if err := ss.netService.SendMessageToPeer(messageName, data, net.MessagePriorityLow, peer); err != nil {
+			continue
+		}
+
+		timeout := time.NewTimer(ss.config.ChunkDataTimeout)
+	WAIT:
+		for {
+			select {
+			case <-ss.quitCh:
+				timeout.Stop()
+				return ErrStateSyncStopped
+			case <-timeout.C:
+				logging.VLog().WithFields(logrus.Fields{
+					"pid":  peer,
+					"type": messageName,
+				}).Debug("State sync request timeout, retry.")
+				break WAIT
+			case message := <-respCh:
+				if message.MessageFrom() != peer {
+					continue
+				}
+				matched, err := accept(message)
+				if !matched {
+					continue
+				}
+				timeout.Stop()
+				if err != nil {
+					logging.VLog().WithFields(logrus.Fields{
+						"err":  err,
+						"pid":  peer,
+						"type": messageName,
+					}).Debug("Wrong state sync response, retry.")
+					// the peer may have pruned the data, try others.
+					if err != trie.ErrSyncNodeNotFound {
+						ss.netService.ClosePeer(peer, err)
+					}
+					break WAIT
+				}
+				return nil
+			}
+		}
+	}
+	return ErrStateSyncTimeout
+}
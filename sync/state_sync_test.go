@@ -0,0 +1,205 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/pod"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+// newPodNeb return a mock neb on pod, whose headers are verifiable for state sync.
+func newPodNeb(t *testing.T, am *account.Manager, ns net.Service) *core.MockNeb {
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	consensus := pod.NewPoD()
+	consensus.SetSlashingStorage(stor)
+	if ns == nil {
+		return core.NewMockNeb(am, consensus, nil)
+	}
+	return core.NewMockNebWithNetService(am, consensus, nil, ns)
+}
+
+func TestChunk_statePivotAncestorsStart(t *testing.T) {
+	// from the genesis, or the checkpoint before the two dynasties before the pivot.
+	assert.Equal(t, uint64(2), statePivotAncestorsStart(1000, 10, 0))
+	assert.Equal(t, uint64(2), statePivotAncestorsStart(1000, 10, 1))
+	assert.Equal(t, uint64(500), statePivotAncestorsStart(1000, 10, 500))
+	assert.Equal(t, uint64(980), statePivotAncestorsStart(1000, 10, 990))
+	assert.Equal(t, uint64(2), statePivotAncestorsStart(10, 10, 0))
+	assert.Equal(t, uint64(1+MaxStatePivotDynasties*10), statePivotMaxHeight(10, 0))
+	assert.Equal(t, uint64(500+MaxStatePivotDynasties*10), statePivotMaxHeight(10, 500))
+}
+
+func TestChunk_statePivot(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := newPodNeb(t, am, nil)
+	mintBlocks(t, neb, 3*core.ChunkSize)
+	chain := neb.BlockChain()
+	chain.ConsensusHandler().UpdateLIB(nil)
+
+	ck := NewChunk(chain)
+	pivot, err := ck.generateStatePivot(new(syncpb.GetStatePivot))
+	assert.Nil(t, err)
+	assert.Equal(t, chain.LIB().Height(), pivot.Block.Height)
+	assert.Equal(t, int(chain.LIB().Height()-2), len(pivot.Ancestors))
+	assert.Equal(t, []byte(chain.GetBlockOnCanonicalChainByHeight(2).Hash()), pivot.Second.Header.Hash)
+
+	nob := chain.ConsensusHandler().NumberOfBlocksInDynasty()
+	assert.Nil(t, verifyStatePivot(chain.ChainID(), nob, 0, pivot))
+	assert.Equal(t, ErrInvalidStatePivot, verifyStatePivot(chain.ChainID()+1, nob, 0, pivot))
+	assert.Equal(t, ErrStatePivotBelowCheckpoint, verifyStatePivot(chain.ChainID(), nob, pivot.Block.Height+1, pivot))
+	assert.Equal(t, ErrInvalidStatePivot, verifyStatePivot(chain.ChainID(), 1, 0, pivot))
+	pivot.Ancestors = pivot.Ancestors[1:]
+	assert.Equal(t, ErrInvalidStatePivot, verifyStatePivot(chain.ChainID(), nob, 0, pivot))
+
+	// the checkpoint of the client is among the ancestors in two dynasties.
+	pivot, err = ck.generateStatePivot(&syncpb.GetStatePivot{From: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, chain.LIB().Height(), pivot.Block.Height)
+	assert.Equal(t, int(chain.LIB().Height()-2), len(pivot.Ancestors))
+	assert.Nil(t, verifyStatePivot(chain.ChainID(), nob, 10, pivot))
+
+	// only the nodes matching the hashes are served.
+	root := chain.LIB().StateRoot()
+	nodes := ck.generateTrieNodes(&syncpb.GetTrieNodes{Hashes: [][]byte{root, []byte("missing")}})
+	assert.Equal(t, 2, len(nodes.Nodes))
+	assert.Equal(t, 0, len(nodes.Nodes[1]))
+	assert.Equal(t, hash.Sha3256(nodes.Nodes[0]), []byte(root))
+
+	// the pivot not containing the checkpoints.
+	ss := newStateSync(chain, nil, nil, DefaultConfig())
+	pivot, err = ck.generateStatePivot(new(syncpb.GetStatePivot))
+	assert.Nil(t, err)
+	assert.Nil(t, ss.verifyStatePivot(chain.ChainID(), nob, 0, pivot))
+	ancestor := chain.GetBlockOnCanonicalChainByHeight(3)
+	assert.Nil(t, chain.SetCheckpoints(map[uint64]string{3: ancestor.ParentHash().String()}))
+	assert.Equal(t, core.ErrCheckpointMismatch, ss.verifyStatePivot(chain.ChainID(), nob, 0, pivot))
+}
+
+func TestStateSync_verifyPivotChain(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := newPodNeb(t, am, nil)
+	mintBlocks(t, neb, core.ChunkSize)
+	chain := neb.BlockChain()
+	verifier := chain.ConsensusHandler().(core.HeaderVerifier)
+
+	pivot := chain.TailBlock()
+	second := core.NewCompactBlock(chain.GetBlockOnCanonicalChainByHeight(2))
+	ancestors := []*core.Block{}
+	for height := uint64(2); height < pivot.Height(); height++ {
+		ancestors = append(ancestors, chain.GetBlockOnCanonicalChainByHeight(height))
+	}
+
+	// verified from the genesis, or from the checkpoint.
+	ss := newStateSync(chain, nil, nil, DefaultConfig())
+	assert.Nil(t, ss.verifyPivotChain(verifier, second, ancestors, pivot))
+	assert.Nil(t, chain.SetCheckpoints(map[uint64]string{5: ancestors[3].Hash().String()}))
+	assert.Nil(t, ss.verifyPivotChain(verifier, second, ancestors[3:], pivot))
+	assert.Equal(t, core.ErrCheckpointMismatch, ss.verifyPivotChain(verifier, second, ancestors[4:], pivot))
+
+	// the ancestors must link to the pivot.
+	assert.Equal(t, pod.ErrInvalidHeaderParent, ss.verifyPivotChain(verifier, second, ancestors[3:len(ancestors)-1], pivot))
+
+	// the second header must be the one of the ancestors.
+	assert.Nil(t, chain.SetCheckpoints(nil))
+	third := core.NewCompactBlock(ancestors[1])
+	assert.Equal(t, ErrInvalidStatePivot, ss.verifyPivotChain(verifier, third, ancestors, pivot))
+}
+
+func TestTask_stateSync(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+
+	network := net.NewSimNetwork(1)
+	defer network.Close()
+
+	var nebs []*core.MockNeb
+	var services []*Service
+	for _, id := range []string{"a", "b", "c"} {
+		ns := network.NewService(id)
+		assert.Nil(t, ns.Start())
+		defer ns.Stop()
+		neb := newPodNeb(t, am, ns)
+		service := NewService(neb.BlockChain(), ns)
+		service.Start()
+		defer service.Stop()
+		nebs = append(nebs, neb)
+		services = append(services, service)
+	}
+
+	// a and b have the same chain, c syncs the state at their LIB.
+	mintBlocks(t, nebs[0], 3*core.ChunkSize+8)
+	ck := NewChunk(nebs[0].BlockChain())
+	meta, err := ck.generateChunkHeaders(nebs[0].BlockChain().GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	for _, header := range meta.ChunkHeaders {
+		chunkData, err := ck.generateChunkData(header)
+		assert.Nil(t, err)
+		_, err = NewChunk(nebs[1].BlockChain()).processChunkData(chunkData)
+		assert.Nil(t, err)
+	}
+	for _, neb := range nebs[:2] {
+		neb.BlockChain().ConsensusHandler().UpdateLIB(nil)
+	}
+	assert.True(t, nebs[1].BlockChain().LIB().Height() >= StateSyncMinGap)
+
+	services[2].SetStateSync(true)
+	assert.True(t, services[2].StartActiveSync())
+	done := make(chan bool)
+	go func() {
+		services[2].WaitingForFinish()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(60 * time.Second):
+		t.Fatal("sync timeout")
+	}
+
+	// the pivot is the LIB of a or b.
+	chain := nebs[2].BlockChain()
+	pivot := chain.LIB()
+	assert.True(t, pivot.Height() >= StateSyncMinGap)
+	assert.Equal(t, nebs[0].BlockChain().GetBlockOnCanonicalChainByHeight(pivot.Height()).Hash(), pivot.Hash())
+	assert.Equal(t, pivot.Hash(), chain.TailBlock().Hash())
+	assert.Nil(t, chain.GetBlockOnCanonicalChainByHeight(2).VerifyIntegrity(chain.ChainID(), chain.ConsensusHandler()))
+
+	// the blocks after the pivot are executed on the synced state.
+	expect := nebs[0].BlockChain().TailBlock()
+	for height := chain.TailBlock().Height() + 1; height <= expect.Height(); height++ {
+		block := nebs[0].BlockChain().GetBlockOnCanonicalChainByHeight(height)
+		pb, err := block.ToProto()
+		assert.Nil(t, err)
+		copied := new(core.Block)
+		assert.Nil(t, copied.FromProto(pb))
+		assert.Nil(t, chain.BlockPool().Push(copied))
+	}
+	assert.Equal(t, expect.Hash(), chain.TailBlock().Hash())
+}
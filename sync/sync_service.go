@@ -28,6 +28,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
 	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -37,6 +38,9 @@ import (
 var (
 	ErrInvalidChainSyncMessageData     = errors.New("invalid ChainSync message data")
 	ErrInvalidChainGetChunkMessageData = errors.New("invalid ChainGetChunk message data")
+	ErrInvalidGetBlocksMessageData     = errors.New("invalid ChainGetHeaders or ChainGetBodies message data")
+	ErrInvalidGetTrieNodesMessageData  = errors.New("invalid GetTrieNodes message data")
+	ErrInvalidGetStatePivotMessageData = errors.New("invalid GetStatePivot message data")
 )
 
 // Service manage sync tasks
@@ -44,11 +48,15 @@ type Service struct {
 	blockChain *core.BlockChain
 	netService net.Service
 	chunk      *Chunk
+	config     *Config
 	quitCh     chan bool
 	messageCh  chan net.Message
 
 	activeTask      *Task
 	activeTaskMutex sync.Mutex
+
+	stateSync bool
+	store     *syncStore
 }
 
 // NewService return new Service.
@@ -57,6 +65,7 @@ func NewService(blockChain *core.BlockChain, netService net.Service) *Service {
 		blockChain: blockChain,
 		netService: netService,
 		chunk:      NewChunk(blockChain),
+		config:     DefaultConfig(),
 		quitCh:     make(chan bool, 1),
 		activeTask: nil,
 		messageCh:  make(chan net.Message, 128),
@@ -73,6 +82,15 @@ func (ss *Service) Start() {
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkHeadersResponse, net.MessageWeightChainChunks))
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkDataRequest, net.MessageWeightZero))
 	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkDataResponse, net.MessageWeightChainChunkData))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.BlockHeadersRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.BlockHeadersResponse, net.MessageWeightChainChunkData))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.BlockBodiesRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.BlockBodiesResponse, net.MessageWeightChainChunkData))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.StatePivotRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.StatePivotResponse, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.TrieNodesRequest, net.MessageWeightZero))
+	netService.Register(net.NewSubscriber(ss, ss.messageCh, false, net.TrieNodesResponse, net.MessageWeightZero))
+	netService.RegisterPeerFilterPolicy(PeerFilterPolicySync, net.ChunkHeadersRequest, net.StatePivotRequest)
 
 	// start loop().
 	go ss.startLoop()
@@ -86,23 +104,50 @@ func (ss *Service) Stop() {
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkHeadersResponse, net.MessageWeightChainChunks))
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkDataRequest, net.MessageWeightZero))
 	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.ChunkDataResponse, net.MessageWeightChainChunkData))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.BlockHeadersRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.BlockHeadersResponse, net.MessageWeightChainChunkData))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.BlockBodiesRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.BlockBodiesResponse, net.MessageWeightChainChunkData))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.StatePivotRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.StatePivotResponse, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.TrieNodesRequest, net.MessageWeightZero))
+	netService.Deregister(net.NewSubscriber(ss, ss.messageCh, false, net.TrieNodesResponse, net.MessageWeightZero))
 
 	ss.StopActiveSync()
 
 	ss.quitCh <- true
 }
 
+// SetStateSync sets whether a fresh node syncs the state at a recent LIB instead of executing all the blocks.
+func (ss *Service) SetStateSync(enabled bool) {
+	ss.stateSync = enabled
+}
+
+// SetConfig sets the sizes and timeouts of the sync.
+func (ss *Service) SetConfig(config *Config) {
+	ss.config = config
+}
+
+// SetStore sets the storage to persist the sync progress, so that a restarted node resumes the sync.
+func (ss *Service) SetStore(stor storage.Storage) {
+	ss.store = newSyncStore(stor)
+}
+
 // StartActiveSync starts an active sync task
 func (ss *Service) StartActiveSync() bool {
 	// lock.
 	ss.activeTaskMutex.Lock()
 	defer ss.activeTaskMutex.Unlock()
 
-	if ss.IsActiveSyncing() {
+	if ss.activeTask != nil {
 		return false
 	}
 
-	ss.activeTask = NewTask(ss.blockChain, ss.netService, ss.chunk)
+	ss.activeTask = NewTask(ss.blockChain, ss.netService, ss.chunk, ss.config)
+	ss.activeTask.setStore(ss.store)
+	if ss.stateSync && ss.blockChain.TailBlock().Hash().Equals(ss.blockChain.GenesisBlock().Hash()) {
+		ss.activeTask.enableStateSync()
+	}
 	ss.activeTask.Start()
 
 	logging.CLog().WithFields(logrus.Fields{
@@ -113,6 +158,9 @@ func (ss *Service) StartActiveSync() bool {
 
 // StopActiveSync stops current sync task
 func (ss *Service) StopActiveSync() {
+	ss.activeTaskMutex.Lock()
+	defer ss.activeTaskMutex.Unlock()
+
 	if ss.activeTask == nil {
 		return
 	}
@@ -123,26 +171,44 @@ func (ss *Service) StopActiveSync() {
 
 // IsActiveSyncing return if there is active task now
 func (ss *Service) IsActiveSyncing() bool {
-	if ss.activeTask == nil {
-		return false
-	}
+	return ss.getActiveTask() != nil
+}
 
-	return true
+func (ss *Service) getActiveTask() *Task {
+	ss.activeTaskMutex.Lock()
+	defer ss.activeTaskMutex.Unlock()
+
+	return ss.activeTask
+}
+
+// SyncProgress return the progress of current sync task
+func (ss *Service) SyncProgress() *core.SyncProgress {
+	task := ss.getActiveTask()
+	if task == nil {
+		height := ss.blockChain.TailBlock().Height()
+		return &core.SyncProgress{StartingHeight: height, CurrentHeight: height, HighestHeight: height}
+	}
+	return task.Progress()
 }
 
 // WaitingForFinish wait for finishing current sync task
 func (ss *Service) WaitingForFinish() {
-	if ss.activeTask == nil {
+	task := ss.getActiveTask()
+	if task == nil {
 		return
 	}
 
-	<-ss.activeTask.statusCh
+	<-task.statusCh
 
 	logging.CLog().WithFields(logrus.Fields{
 		"tail": ss.blockChain.TailBlock(),
 	}).Info("Active Sync Task Finished.")
 
-	ss.activeTask = nil
+	ss.activeTaskMutex.Lock()
+	if ss.activeTask == task {
+		ss.activeTask = nil
+	}
+	ss.activeTaskMutex.Unlock()
 }
 
 func (ss *Service) startLoop() {
@@ -154,8 +220,8 @@ func (ss *Service) startLoop() {
 		case <-timerChan:
 			metricsCachedSync.Update(int64(len(ss.messageCh)))
 		case <-ss.quitCh:
-			if ss.activeTask != nil {
-				ss.activeTask.Stop()
+			if task := ss.getActiveTask(); task != nil {
+				task.Stop()
 			}
 			logging.CLog().Info("Stopped Sync Service.")
 			return
@@ -169,6 +235,22 @@ func (ss *Service) startLoop() {
 				ss.onChunkDataRequest(message)
 			case net.ChunkDataResponse:
 				ss.onChunkDataResponse(message)
+			case net.BlockHeadersRequest:
+				ss.onBlockHeadersRequest(message)
+			case net.BlockHeadersResponse:
+				ss.onBlockHeadersResponse(message)
+			case net.BlockBodiesRequest:
+				ss.onBlockBodiesRequest(message)
+			case net.BlockBodiesResponse:
+				ss.onBlockBodiesResponse(message)
+			case net.StatePivotRequest:
+				ss.onStatePivotRequest(message)
+			case net.StatePivotResponse:
+				ss.onStatePivotResponse(message)
+			case net.TrieNodesRequest:
+				ss.onTrieNodesRequest(message)
+			case net.TrieNodesResponse:
+				ss.onTrieNodesResponse(message)
 			default:
 				logging.VLog().WithFields(logrus.Fields{
 					"messageName": message.MessageType(),
@@ -195,8 +277,26 @@ func (ss *Service) onChunkHeadersRequest(message net.Message) {
 		return
 	}
 
+	// the old peers ask in the default sizes.
+	chunkSize, maxChunks := uint64(core.ChunkSize), MaxChunkPerSyncRequest
+	if chunkSync.ChunkSize > 0 {
+		chunkSize = uint64(chunkSync.ChunkSize)
+	}
+	if chunkSync.MaxChunks > 0 {
+		maxChunks = int(chunkSync.MaxChunks)
+	}
+	if !validChunkSize(chunkSize) || !validMaxChunks(maxChunks) {
+		logging.VLog().WithFields(logrus.Fields{
+			"chunkSize": chunkSize,
+			"maxChunks": maxChunks,
+			"pid":       message.MessageFrom(),
+		}).Debug("Invalid ChunkHeadersRequest size.")
+		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainSyncMessageData)
+		return
+	}
+
 	// generate ChunkHeaders message.
-	chunks, err := ss.chunk.generateChunkHeaders(chunkSync.TailBlockHash)
+	chunks, err := ss.chunk.generateChunkHeaders(chunkSync.TailBlockHash, chunkSize, servedMaxChunks(chunkSize, maxChunks))
 	if err != nil && err != ErrTooSmallGapToSync {
 		logging.VLog().WithFields(logrus.Fields{
 			"err":  err,
@@ -210,11 +310,12 @@ func (ss *Service) onChunkHeadersRequest(message net.Message) {
 }
 
 func (ss *Service) onChunkHeadersResponse(message net.Message) {
-	if ss.activeTask == nil {
+	task := ss.getActiveTask()
+	if task == nil {
 		return
 	}
 
-	ss.activeTask.processChunkHeaders(message)
+	task.processChunkHeaders(message)
 }
 
 func (ss *Service) onChunkDataRequest(message net.Message) {
@@ -246,11 +347,149 @@ func (ss *Service) onChunkDataRequest(message net.Message) {
 }
 
 func (ss *Service) onChunkDataResponse(message net.Message) {
-	if ss.activeTask == nil {
+	task := ss.getActiveTask()
+	if task == nil {
+		return
+	}
+
+	task.processChunkData(message)
+}
+
+func (ss *Service) onBlockHeadersRequest(message net.Message) {
+	if ss.IsActiveSyncing() {
+		return
+	}
+
+	chunkHeader, ok := ss.parseGetBlocksRequest(message)
+	if !ok {
+		return
+	}
+
+	blockHeaders, err := ss.chunk.generateBlockHeaders(chunkHeader)
+	if err != nil {
+		if err == ErrWrongChunkHeaderRootHash {
+			ss.netService.ClosePeer(message.MessageFrom(), err)
+		}
+		return
+	}
+
+	ss.sendResponse(message.MessageFrom(), net.BlockHeadersResponse, blockHeaders)
+}
+
+func (ss *Service) onBlockHeadersResponse(message net.Message) {
+	task := ss.getActiveTask()
+	if task == nil {
+		return
+	}
+
+	task.processBlockHeaders(message)
+}
+
+func (ss *Service) onBlockBodiesRequest(message net.Message) {
+	if ss.IsActiveSyncing() {
+		return
+	}
+
+	chunkHeader, ok := ss.parseGetBlocksRequest(message)
+	if !ok {
+		return
+	}
+
+	blockBodies, err := ss.chunk.generateBlockBodies(chunkHeader)
+	if err != nil {
+		if err == ErrWrongChunkHeaderRootHash {
+			ss.netService.ClosePeer(message.MessageFrom(), err)
+		}
+		return
+	}
+
+	ss.sendResponse(message.MessageFrom(), net.BlockBodiesResponse, blockBodies)
+}
+
+func (ss *Service) onBlockBodiesResponse(message net.Message) {
+	task := ss.getActiveTask()
+	if task == nil {
+		return
+	}
+
+	task.processBlockBodies(message)
+}
+
+func (ss *Service) onStatePivotRequest(message net.Message) {
+	if ss.IsActiveSyncing() {
 		return
 	}
 
-	ss.activeTask.processChunkData(message)
+	request := new(syncpb.GetStatePivot)
+	if err := proto.Unmarshal(message.Data(), request); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid GetStatePivot message data.")
+		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidGetStatePivotMessageData)
+		return
+	}
+
+	pivot, err := ss.chunk.generateStatePivot(request)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Failed to generate state pivot.")
+		return
+	}
+
+	ss.sendResponse(message.MessageFrom(), net.StatePivotResponse, pivot)
+}
+
+func (ss *Service) onStatePivotResponse(message net.Message) {
+	task := ss.getActiveTask()
+	if task == nil {
+		return
+	}
+
+	task.processStatePivot(message)
+}
+
+func (ss *Service) onTrieNodesRequest(message net.Message) {
+	if ss.IsActiveSyncing() {
+		return
+	}
+
+	request := new(syncpb.GetTrieNodes)
+	if err := proto.Unmarshal(message.Data(), request); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid GetTrieNodes message data.")
+		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidGetTrieNodesMessageData)
+		return
+	}
+
+	ss.sendResponse(message.MessageFrom(), net.TrieNodesResponse, ss.chunk.generateTrieNodes(request))
+}
+
+func (ss *Service) onTrieNodesResponse(message net.Message) {
+	task := ss.getActiveTask()
+	if task == nil {
+		return
+	}
+
+	task.processTrieNodes(message)
+}
+
+func (ss *Service) parseGetBlocksRequest(message net.Message) (*syncpb.ChunkHeader, bool) {
+	chunkHeader := new(syncpb.ChunkHeader)
+	if err := proto.Unmarshal(message.Data(), chunkHeader); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"pid":  message.MessageFrom(),
+			"type": message.MessageType(),
+		}).Debug("Invalid get blocks message data.")
+		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidGetBlocksMessageData)
+		return nil, false
+	}
+	return chunkHeader, true
 }
 
 func (ss *Service) chunkHeadersResponse(peerID string, chunks *syncpb.ChunkHeaders) {
@@ -276,3 +515,16 @@ func (ss *Service) chunkDataResponse(peerID string, chunkData *syncpb.ChunkData)
 
 	ss.netService.SendMessageToPeer(net.ChunkDataResponse, data, net.MessagePriorityLow, peerID)
 }
+
+func (ss *Service) sendResponse(peerID string, messageName string, msg proto.Message) {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"type": messageName,
+		}).Debug("Failed to marshal sync response.")
+		return
+	}
+
+	ss.netService.SendMessageToPeer(messageName, data, net.MessagePriorityLow, peerID)
+}
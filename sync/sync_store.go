@@ -0,0 +1,150 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/storage"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// keys in sync store.
+var (
+	pendingSyncKey     = []byte("pending_sync")
+	chunkDataKeyPrefix = []byte("chunk_data_")
+)
+
+// syncStore persists the agreed chunk headers and the downloaded chunk data,
+// so that a restarted node resumes the sync from where it stopped.
+// A nil store persists nothing.
+type syncStore struct {
+	storage storage.Storage
+}
+
+func newSyncStore(stor storage.Storage) *syncStore {
+	if stor == nil {
+		return nil
+	}
+	return &syncStore{storage: stor}
+}
+
+func chunkDataKey(root []byte) []byte {
+	return append(append([]byte{}, chunkDataKeyPrefix...), root...)
+}
+
+// loadPendingSync return the pending sync persisted, nil if there is none.
+func (s *syncStore) loadPendingSync() *syncpb.PendingSync {
+	if s == nil {
+		return nil
+	}
+	data, err := s.storage.Get(pendingSyncKey)
+	if err != nil {
+		return nil
+	}
+	pending := new(syncpb.PendingSync)
+	if err := proto.Unmarshal(data, pending); err != nil || pending.ChunkHeaders == nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Invalid pending sync in store, drop it.")
+		s.storage.Del(pendingSyncKey)
+		return nil
+	}
+	return pending
+}
+
+// savePendingSync replaces the pending sync, the chunk data not in the new chunk headers are dropped.
+func (s *syncStore) savePendingSync(syncPoint []byte, chunkHeaders *syncpb.ChunkHeaders) error {
+	if s == nil {
+		return nil
+	}
+	if old := s.loadPendingSync(); old != nil {
+		roots := make(map[string]bool)
+		for _, chunkHeader := range chunkHeaders.ChunkHeaders {
+			roots[string(chunkHeader.Root)] = true
+		}
+		for _, chunkHeader := range old.ChunkHeaders.ChunkHeaders {
+			if !roots[string(chunkHeader.Root)] {
+				s.deleteChunkData(chunkHeader.Root)
+			}
+		}
+	}
+
+	data, err := proto.Marshal(&syncpb.PendingSync{SyncPoint: syncPoint, ChunkHeaders: chunkHeaders})
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(pendingSyncKey, data)
+}
+
+func (s *syncStore) saveChunkData(chunkData *syncpb.ChunkData) error {
+	if s == nil {
+		return nil
+	}
+	data, err := proto.Marshal(chunkData)
+	if err != nil {
+		return err
+	}
+	return s.storage.Put(chunkDataKey(chunkData.Root), data)
+}
+
+// loadChunkData return the chunk data of the chunk header persisted, nil if there is none or it's broken.
+func (s *syncStore) loadChunkData(chunkHeader *syncpb.ChunkHeader) *syncpb.ChunkData {
+	if s == nil {
+		return nil
+	}
+	data, err := s.storage.Get(chunkDataKey(chunkHeader.Root))
+	if err != nil {
+		return nil
+	}
+	chunkData := new(syncpb.ChunkData)
+	if err := proto.Unmarshal(data, chunkData); err != nil {
+		s.deleteChunkData(chunkHeader.Root)
+		return nil
+	}
+	if ok, err := verifyChunkData(chunkHeader, chunkData); !ok {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Debug("Broken chunk data in store, drop it.")
+		s.deleteChunkData(chunkHeader.Root)
+		return nil
+	}
+	return chunkData
+}
+
+func (s *syncStore) deleteChunkData(root []byte) {
+	if s == nil {
+		return
+	}
+	s.storage.Del(chunkDataKey(root))
+}
+
+// clear drops the pending sync and its chunk data.
+func (s *syncStore) clear() {
+	if s == nil {
+		return
+	}
+	if pending := s.loadPendingSync(); pending != nil {
+		for _, chunkHeader := range pending.ChunkHeaders.ChunkHeaders {
+			s.deleteChunkData(chunkHeader.Root)
+		}
+	}
+	s.storage.Del(pendingSyncKey)
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/nebulasio/go-nebulas/storage"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncStore(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 2*core.ChunkSize+1)
+	chain := neb.BlockChain()
+
+	ck := NewChunk(chain)
+	meta, err := ck.generateChunkHeaders(chain.GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(meta.ChunkHeaders))
+
+	var nilStore *syncStore
+	assert.Nil(t, nilStore.savePendingSync(chain.GenesisBlock().Hash(), meta))
+	assert.Nil(t, nilStore.loadPendingSync())
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	store := newSyncStore(stor)
+	assert.Nil(t, store.loadPendingSync())
+	assert.Nil(t, store.savePendingSync(chain.GenesisBlock().Hash(), meta))
+	pending := store.loadPendingSync()
+	assert.Equal(t, []byte(chain.GenesisBlock().Hash()), pending.SyncPoint)
+	assert.Equal(t, meta.Root, pending.ChunkHeaders.Root)
+
+	for _, chunkHeader := range meta.ChunkHeaders {
+		chunkData, err := ck.generateChunkData(chunkHeader)
+		assert.Nil(t, err)
+		assert.Nil(t, store.saveChunkData(chunkData))
+		assert.Equal(t, chunkData.Root, store.loadChunkData(chunkHeader).Root)
+	}
+
+	// broken chunk data is dropped.
+	chunkData, err := ck.generateChunkData(meta.ChunkHeaders[1])
+	assert.Nil(t, err)
+	chunkData.Blocks = chunkData.Blocks[1:]
+	assert.Nil(t, store.saveChunkData(chunkData))
+	assert.Nil(t, store.loadChunkData(meta.ChunkHeaders[1]))
+	_, err = stor.Get(chunkDataKey(meta.ChunkHeaders[1].Root))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	// the chunk data out of new chunk headers are dropped.
+	assert.Nil(t, store.savePendingSync(chain.GenesisBlock().Hash(), &syncpb.ChunkHeaders{ChunkHeaders: meta.ChunkHeaders[1:]}))
+	assert.Nil(t, store.loadChunkData(meta.ChunkHeaders[0]))
+
+	store.clear()
+	assert.Nil(t, store.loadPendingSync())
+}
+
+func TestTask_resume(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+
+	network := net.NewSimNetwork(1)
+	defer network.Close()
+
+	var nebs []*core.MockNeb
+	var services []*Service
+	for _, id := range []string{"a", "b", "c"} {
+		ns := network.NewService(id)
+		assert.Nil(t, ns.Start())
+		defer ns.Stop()
+		neb := core.NewMockNebWithNetService(am, dpos.NewDpos(), nil, ns)
+		service := NewService(neb.BlockChain(), ns)
+		service.Start()
+		defer service.Stop()
+		nebs = append(nebs, neb)
+		services = append(services, service)
+	}
+
+	mintBlocks(t, nebs[0], 3*core.ChunkSize+8)
+	ck := NewChunk(nebs[0].BlockChain())
+	meta, err := ck.generateChunkHeaders(nebs[0].BlockChain().GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(meta.ChunkHeaders))
+	for _, header := range meta.ChunkHeaders {
+		chunkData, err := ck.generateChunkData(header)
+		assert.Nil(t, err)
+		_, err = NewChunk(nebs[1].BlockChain()).processChunkData(chunkData)
+		assert.Nil(t, err)
+	}
+
+	// c stopped after downloading two chunks, one of them is broken.
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	store := newSyncStore(stor)
+	assert.Nil(t, store.savePendingSync(nebs[2].BlockChain().GenesisBlock().Hash(), meta))
+	for i, header := range meta.ChunkHeaders[:2] {
+		chunkData, err := ck.generateChunkData(header)
+		assert.Nil(t, err)
+		if i == 1 {
+			chunkData.Blocks[0], chunkData.Blocks[1] = chunkData.Blocks[1], chunkData.Blocks[0]
+		}
+		assert.Nil(t, store.saveChunkData(chunkData))
+	}
+
+	services[2].SetStore(stor)
+	assert.True(t, services[2].StartActiveSync())
+	task := services[2].activeTask
+	done := make(chan bool)
+	go func() {
+		services[2].WaitingForFinish()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("sync timeout")
+	}
+
+	expect := nebs[1].BlockChain().TailBlock()
+	actual := nebs[2].BlockChain().TailBlock()
+	assert.Equal(t, expect.Hash(), actual.Hash())
+
+	// only the broken and the missing chunks are downloaded.
+	assert.Equal(t, uint64(2*core.ChunkSize), task.downloadedBlocks)
+	assert.Nil(t, store.loadPendingSync())
+	for _, header := range meta.ChunkHeaders {
+		_, err := stor.Get(chunkDataKey(header.Root))
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+	}
+}
+
+func TestTask_resumeChunkHeaders(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 3*core.ChunkSize+8)
+	chain := neb.BlockChain()
+	meta, err := NewChunk(chain).generateChunkHeaders(chain.GenesisBlock().Hash(), core.ChunkSize, 2)
+	assert.Nil(t, err)
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	store := newSyncStore(stor)
+	assert.Nil(t, store.savePendingSync(chain.GenesisBlock().Hash(), meta))
+	config := DefaultConfig()
+
+	// the persisted chunk headers are taken as agreed by the sync peers.
+	task := NewTask(chain, nil, NewChunk(chain), config)
+	task.setStore(store)
+	task.chainSyncPeers = []string{"a", "b"}
+	task.resumePendingSync()
+	assert.True(t, task.hasEnoughChunkHeaders())
+	assert.Equal(t, meta.Root, task.maxConsistentChunkHeaders.Root)
+	assert.Equal(t, task.chainSyncPeers, task.maxConsistentChunkHeadersChainSyncPeers[byteutils.Hex(meta.Root)])
+	assert.Equal(t, 1, len(task.chainSyncDoneCh))
+
+	// only once.
+	task.reset()
+	task.chainSyncPeers = []string{"a", "b"}
+	task.resumePendingSync()
+	assert.False(t, task.hasEnoughChunkHeaders())
+
+	// the chunk headers of other chunk size are dropped.
+	config.ChunkSize = core.ChunkSize / 2
+	task = NewTask(chain, nil, NewChunk(chain), config)
+	task.setStore(store)
+	task.chainSyncPeers = []string{"a", "b"}
+	task.resumePendingSync()
+	assert.False(t, task.hasEnoughChunkHeaders())
+}
+
+func TestTask_savePendingSyncPoint(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 3*core.ChunkSize+8)
+	chain := neb.BlockChain()
+	meta, err := NewChunk(chain).generateChunkHeaders(chain.GenesisBlock().Hash(), core.ChunkSize, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(meta.ChunkHeaders))
+
+	stor, err := storage.NewMemoryStorage()
+	assert.Nil(t, err)
+	store := newSyncStore(stor)
+	assert.Nil(t, store.savePendingSync(chain.GenesisBlock().Hash(), meta))
+
+	task := NewTask(chain, nil, NewChunk(chain), DefaultConfig())
+	task.setStore(store)
+	task.maxConsistentChunkHeaders = meta
+	for i := range meta.ChunkHeaders {
+		task.chainChunkTasks[i] = &chunkTask{stage: chunkStageReady}
+	}
+
+	// the sync point of the executed chunk is persisted with the chunks left.
+	last := chain.GetBlockOnCanonicalChainByHeight(core.ChunkSize + 1)
+	task.onChunkExecuted(&chunkExecuted{round: task.executingRound, index: 0, size: core.ChunkSize, last: last})
+	pending := store.loadPendingSync()
+	assert.Equal(t, []byte(last.Hash()), pending.SyncPoint)
+	assert.Equal(t, meta.ChunkHeaders[1:], pending.ChunkHeaders.ChunkHeaders)
+
+	resumed := NewTask(chain, nil, NewChunk(chain), DefaultConfig())
+	resumed.setStore(store)
+	assert.Equal(t, last.Hash(), resumed.syncPointBlock.Hash())
+	assert.NotNil(t, resumed.pendingSync)
+
+	// cleared once all are executed.
+	for i := 1; i < len(meta.ChunkHeaders); i++ {
+		last = chain.GetBlockOnCanonicalChainByHeight(uint64(i+1)*core.ChunkSize + 1)
+		task.onChunkExecuted(&chunkExecuted{round: task.executingRound, index: i, size: core.ChunkSize, last: last})
+	}
+	assert.Nil(t, store.loadPendingSync())
+}
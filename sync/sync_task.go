@@ -19,10 +19,8 @@
 package sync
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
-	"math/rand"
 	"sync"
 	"time"
 
@@ -31,14 +29,20 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
+// the stages of a chunk, the headers are downloaded and verified before the bodies,
+// a chunk falls back to download the whole chunk data if its headers keep failing.
 const (
-	chunkDataStatusFinished = int64(-1)
-	chunkDataStatusNotStart = int64(0)
+	chunkStageHeaders = iota
+	chunkStageBodies
+	chunkStageChunkData
+	chunkStageReady
+	chunkStageFinished
 )
 
 // Errors
@@ -48,8 +52,30 @@ var (
 	ErrInvalidChainChunkDataMessageData = errors.New("invalid ChainChunkData message data")
 	ErrWrongChainChunkDataMessageData   = errors.New("wrong ChainChunkData message data")
 	ErrInvalidChunkHeaderSourcePeer     = errors.New("invalid chunk headers source peer")
+	ErrInvalidBlockHeadersMessageData   = errors.New("invalid BlockHeaders message data")
+	ErrInvalidBlockBodiesMessageData    = errors.New("invalid BlockBodies message data")
 )
 
+// chunkTask tracks the download of a chunk.
+type chunkTask struct {
+	stage     int
+	peer      string
+	requestAt time.Time
+	source    string
+	failures  int
+	headers   []*core.CompactBlock
+	seed      []byte // the vrf parent seed of the block after the chunk, nil if unknown
+}
+
+// chunkExecuted is the result of a chunk executed in background.
+type chunkExecuted struct {
+	round int
+	index int
+	size  int
+	last  *core.Block
+	err   error
+}
+
 // Task is a sync task
 type Task struct {
 	quitCh                                  chan bool
@@ -58,6 +84,7 @@ type Task struct {
 	syncPointBlock                          *core.Block
 	netService                              net.Service
 	chunk                                   *Chunk
+	config                                  *Config
 	syncMutex                               sync.Mutex
 	chainSyncPeers                          []string
 	maxConsistentChunkHeadersCount          int
@@ -65,20 +92,38 @@ type Task struct {
 	maxConsistentChunkHeadersChainSyncPeers map[string][]string
 	chunkHeadersRootHashCounter             map[string]int
 	receivedChunkHeadersRootHashPeers       map[string]bool
+	peerTailHeights                         map[string]uint64
 
 	chainSyncDoneCh               chan bool
-	chainChunkDataSyncPosition    int
 	chainChunkDataProcessPosition int
 	chainChunkData                map[int]*syncpb.ChunkData
-	chainChunkDataStatus          map[int]int64
+	chainChunkTasks               map[int]*chunkTask
+	chainChunkIndexes             map[string]int
+	peerWindows                   map[string]*peerWindow
+	executing                     bool
+	executingRound                int
+	executionCancelCh             chan bool
+	executionDoneCh               chan bool
+	chunkExecutedCh               chan *chunkExecuted
 	chinGetChunkDataDoneCh        chan bool
+	stateSync                     *stateSync
+	store                         *syncStore
+	pendingSync                   *syncpb.PendingSync
+
+	// progress fields.
+	startAt          time.Time
+	startingHeight   uint64
+	highestHeight    uint64
+	downloadedBlocks uint64
+	executedBlocks   uint64
+	finished         bool
 
 	// debug fields.
 	chainSyncRetryCount int
 }
 
 // NewTask return a new sync task
-func NewTask(blockChain *core.BlockChain, netService net.Service, chunk *Chunk) *Task {
+func NewTask(blockChain *core.BlockChain, netService net.Service, chunk *Chunk, config *Config) *Task {
 	return &Task{
 		quitCh:                                  make(chan bool, 1),
 		statusCh:                                make(chan bool, 1),
@@ -86,18 +131,26 @@ func NewTask(blockChain *core.BlockChain, netService net.Service, chunk *Chunk)
 		syncPointBlock:                          blockChain.LIB(),
 		netService:                              netService,
 		chunk:                                   chunk,
+		config:                                  config,
 		chainSyncPeers:                          nil,
 		maxConsistentChunkHeadersCount:          0,
 		maxConsistentChunkHeaders:               nil,
 		maxConsistentChunkHeadersChainSyncPeers: make(map[string][]string),
 		chunkHeadersRootHashCounter:             make(map[string]int),
 		receivedChunkHeadersRootHashPeers:       make(map[string]bool),
+		peerTailHeights:                         make(map[string]uint64),
 		chainSyncDoneCh:                         make(chan bool, 1),
-		chainChunkDataSyncPosition:              0,
 		chainChunkDataProcessPosition:           0,
 		chainChunkData:                          make(map[int]*syncpb.ChunkData),
-		chainChunkDataStatus:                    make(map[int]int64),
+		chainChunkTasks:                         make(map[int]*chunkTask),
+		chainChunkIndexes:                       make(map[string]int),
+		peerWindows:                             make(map[string]*peerWindow),
+		executing:                               false,
+		chunkExecutedCh:                         make(chan *chunkExecuted, 1),
 		chinGetChunkDataDoneCh:                  make(chan bool, 1),
+		startAt:                                 time.Now(),
+		startingHeight:                          blockChain.TailBlock().Height(),
+		highestHeight:                           blockChain.TailBlock().Height(),
 		// debug fields.
 		chainSyncRetryCount: 0,
 	}
@@ -113,12 +166,69 @@ func (st *Task) Stop() {
 	st.quitCh <- true
 }
 
+// setStore makes the task persist its progress in store, and resume the pending sync in it.
+func (st *Task) setStore(store *syncStore) {
+	st.store = store
+
+	pending := store.loadPendingSync()
+	if pending == nil {
+		return
+	}
+	syncPoint := st.blockChain.GetBlockOnCanonicalChainByHash(pending.SyncPoint)
+	if syncPoint == nil {
+		store.clear()
+		return
+	}
+	// the chunk headers persisted with other chunk size are dropped.
+	if verifyChunkHeadersSize(pending.ChunkHeaders, st.config.ChunkSize, st.config.MaxChunksPerRequest) &&
+		verifyChunkHeadersCheckpoints(st.blockChain, syncPoint.Height(), st.config.ChunkSize, pending.ChunkHeaders) == nil {
+		st.pendingSync = pending
+	}
+	st.syncPointBlock = syncPoint
+	logging.CLog().WithFields(logrus.Fields{
+		"syncpoint": syncPoint,
+		"chunks":    len(pending.ChunkHeaders.ChunkHeaders),
+	}).Info("Resume the pending sync.")
+}
+
+// enableStateSync makes the task download the state at a LIB from peers before syncing blocks.
+func (st *Task) enableStateSync() {
+	st.stateSync = newStateSync(st.blockChain, st.netService, st.quitCh, st.config)
+}
+
 func (st *Task) startSyncLoop() {
+	if st.stateSync != nil {
+		if err := st.stateSync.run(); err != nil {
+			if err == ErrStateSyncStopped {
+				logging.VLog().Info("Stopped sync loop.")
+				return
+			}
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Warn("Failed to sync state, fall back to sync all blocks.")
+		}
+		st.syncMutex.Lock()
+		st.stateSync = nil
+		st.syncPointBlock = st.blockChain.LIB()
+		if st.syncPointBlock.Height() > st.highestHeight {
+			st.highestHeight = st.syncPointBlock.Height()
+		}
+		st.triggerProgress()
+		st.syncMutex.Unlock()
+	}
+
+	defer func() {
+		st.syncMutex.Lock()
+		st.stopExecution()
+		st.syncMutex.Unlock()
+	}()
+
 	for {
 		// start chain sync.
 		st.chunkHeadersRequest()
+		st.resumePendingSync()
 
-		syncTicker := time.NewTicker(10 * time.Second)
+		syncTicker := time.NewTicker(st.config.SyncInterval)
 
 	SYNC_STEP_1:
 		for {
@@ -150,9 +260,9 @@ func (st *Task) startSyncLoop() {
 		logging.VLog().Info("Starting GetChainData from peers.")
 
 		st.chainSyncRetryCount = 0
-		st.sendChunkDataRequest()
+		st.startGetChunkData()
 
-		getChunkTimeoutTicker := time.NewTicker(10 * time.Second)
+		getChunkTimeoutTicker := time.NewTicker(time.Second)
 
 	SYNC_STEP_2:
 		for {
@@ -161,7 +271,7 @@ func (st *Task) startSyncLoop() {
 				logging.VLog().Info("Stopped sync loop.")
 				return
 			case <-getChunkTimeoutTicker.C:
-				if st.chainSyncRetryCount > GetChunkDataTimeout {
+				if st.chainSyncRetryCount > MaxGetChunkDataRetries {
 					logging.CLog().WithFields(logrus.Fields{
 						"from":                st.syncPointBlock,
 						"chainSyncPeers":      st.chainSyncPeers,
@@ -173,10 +283,17 @@ func (st *Task) startSyncLoop() {
 				}
 				// for the timeout peer, send message again.
 				st.checkChainGetChunkTimeout()
+			case result := <-st.chunkExecutedCh:
+				st.onChunkExecuted(result)
 			case <-st.chinGetChunkDataDoneCh:
 				// finished.
 				logging.VLog().Info("GetChainData Finished.")
 				if len(st.maxConsistentChunkHeaders.ChunkHeaders) == 0 {
+					st.syncMutex.Lock()
+					st.finished = true
+					st.triggerProgress()
+					st.syncMutex.Unlock()
+					st.store.clear()
 					st.statusCh <- true
 					return
 				}
@@ -202,10 +319,25 @@ func (st *Task) reset() {
 	st.maxConsistentChunkHeadersChainSyncPeers = make(map[string][]string)
 	st.chunkHeadersRootHashCounter = make(map[string]int)
 	st.receivedChunkHeadersRootHashPeers = make(map[string]bool)
-	st.chainChunkDataStatus = make(map[int]int64)
-	st.chainChunkDataSyncPosition = 0
+	st.peerTailHeights = make(map[string]uint64)
 	st.chainChunkDataProcessPosition = 0
 	st.chainChunkData = make(map[int]*syncpb.ChunkData)
+	st.chainChunkTasks = make(map[int]*chunkTask)
+	st.chainChunkIndexes = make(map[string]int)
+	st.peerWindows = make(map[string]*peerWindow)
+	st.stopExecution()
+}
+
+// stopExecution waits for the chunk still executing and drops its result,
+// the caller should hold the lock.
+func (st *Task) stopExecution() {
+	if st.executing {
+		close(st.executionCancelCh)
+		<-st.executionDoneCh
+	}
+	// a result sent before reports an old round and is ignored.
+	st.executing = false
+	st.executingRound++
 }
 
 func (st *Task) setSyncPointToNewTail() {
@@ -224,13 +356,39 @@ func (st *Task) setSyncPointToLastChunk() {
 
 	// the first block height of chunk is 1.
 	lastChunkBlockHeight := uint64(1)
-	if st.syncPointBlock.Height()+1 > core.ChunkSize {
-		lastChunkBlockHeight = st.syncPointBlock.Height() - uint64(core.ChunkSize)
+	if st.syncPointBlock.Height()+1 > st.config.ChunkSize {
+		lastChunkBlockHeight = st.syncPointBlock.Height() - st.config.ChunkSize
 	}
 
 	st.syncPointBlock = st.blockChain.GetBlockOnCanonicalChainByHeight(lastChunkBlockHeight)
 }
 
+// resumePendingSync takes the chunk headers persisted as agreed by the sync peers,
+// the chunk data from the peers are still verified against them.
+func (st *Task) resumePendingSync() {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	pending := st.pendingSync
+	st.pendingSync = nil
+	if pending == nil || len(st.chainSyncPeers) == 0 || st.hasEnoughChunkHeaders() ||
+		!st.syncPointBlock.Hash().Equals(pending.SyncPoint) {
+		return
+	}
+
+	rootHash := byteutils.Hex(pending.ChunkHeaders.Root)
+	st.maxConsistentChunkHeaders = pending.ChunkHeaders
+	st.maxConsistentChunkHeadersCount = len(st.chainSyncPeers)
+	st.maxConsistentChunkHeadersChainSyncPeers[rootHash] = append([]string{}, st.chainSyncPeers...)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"syncpoint": st.syncPointBlock,
+		"rootHash":  rootHash,
+		"chunks":    len(pending.ChunkHeaders.ChunkHeaders),
+	}).Info("Resume the persisted chunk headers.")
+	st.chainSyncDoneCh <- true
+}
+
 func (st *Task) chunkHeadersRequest() {
 	logging.VLog().WithFields(logrus.Fields{
 		"syncPointBlockHeight": st.syncPointBlock.Height(),
@@ -241,6 +399,8 @@ func (st *Task) chunkHeadersRequest() {
 
 	chunkSync := &syncpb.Sync{
 		TailBlockHash: st.syncPointBlock.Hash(),
+		ChunkSize:     uint32(st.config.ChunkSize),
+		MaxChunks:     uint32(st.config.MaxChunksPerRequest),
 	}
 
 	data, err := proto.Marshal(chunkSync)
@@ -313,6 +473,26 @@ func (st *Task) processChunkHeaders(message net.Message) {
 		return
 	}
 
+	// the old peers ignore the requested chunk size and send the default one.
+	chunkSize, ok := acceptedChunkHeadersSize(chunkHeaders, st.config.ChunkSize, st.config.MaxChunksPerRequest)
+	if !ok {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": ErrWrongChunkDataSize,
+			"pid": message.MessageFrom(),
+		}).Debug("ChainChunkHeaders mismatch the requested size.")
+		return
+	}
+
+	// the chains not containing the checkpoints are rejected.
+	if err := verifyChunkHeadersCheckpoints(st.blockChain, st.syncPointBlock.Height(), chunkSize, chunkHeaders); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("ChainChunkHeaders mismatch the checkpoints.")
+		st.netService.ClosePeer(message.MessageFrom(), err)
+		return
+	}
+
 	rootHash := byteutils.Hex(chunkHeaders.Root)
 
 	hashPeerKey := fmt.Sprintf("%s-%s", rootHash, message.MessageFrom())
@@ -327,6 +507,7 @@ func (st *Task) processChunkHeaders(message net.Message) {
 	count := st.chunkHeadersRootHashCounter[rootHash] + 1
 	st.chunkHeadersRootHashCounter[rootHash] += count
 	st.receivedChunkHeadersRootHashPeers[hashPeerKey] = true
+	st.peerTailHeights[message.MessageFrom()] = chunkHeaders.TailHeight
 	st.maxConsistentChunkHeadersChainSyncPeers[rootHash] = append(st.maxConsistentChunkHeadersChainSyncPeers[rootHash], message.MessageFrom())
 
 	isMax := false
@@ -348,11 +529,18 @@ func (st *Task) processChunkHeaders(message net.Message) {
 	}
 }
 
-func (st *Task) sendChunkDataRequest() {
+func (st *Task) startGetChunkData() {
 	// lock.
 	st.syncMutex.Lock()
 	defer st.syncMutex.Unlock()
 
+	// the highest is the tail reported by the peers agreeing on the chunk headers.
+	for _, peer := range st.maxConsistentChunkHeadersChainSyncPeers[byteutils.Hex(st.maxConsistentChunkHeaders.Root)] {
+		if height := st.peerTailHeights[peer]; height > st.highestHeight {
+			st.highestHeight = height
+		}
+	}
+
 	if len(st.maxConsistentChunkHeaders.ChunkHeaders) == 0 {
 		logging.VLog().WithFields(logrus.Fields{
 			"maxConsistentChunkHeadersCount":    st.maxConsistentChunkHeadersCount,
@@ -365,17 +553,138 @@ func (st *Task) sendChunkDataRequest() {
 		return
 	}
 
-	currentSyncChunkDataCount := 0
-	chainChunkDataSyncPosition := 0
-	for i := 0; i < len(st.maxConsistentChunkHeaders.ChunkHeaders) && currentSyncChunkDataCount < ConcurrentSyncChunkDataCount; i++ {
-		if st.chainChunkDataStatus[i] == chunkDataStatusNotStart {
-			currentSyncChunkDataCount++
-			chainChunkDataSyncPosition = i
-			st.chunkDataRequest(i)
+	// the chunks start from the one containing the sync point, the old peers don't report the tail.
+	chunkSize := st.chunkSize()
+	startChunk := (st.syncPointBlock.Height() - 1) / chunkSize
+	highest := (startChunk+uint64(len(st.maxConsistentChunkHeaders.ChunkHeaders)))*chunkSize + 1
+	if highest > st.highestHeight {
+		st.highestHeight = highest
+	}
+
+	for i, chunkHeader := range st.maxConsistentChunkHeaders.ChunkHeaders {
+		st.chainChunkTasks[i] = &chunkTask{stage: chunkStageHeaders}
+		st.chainChunkIndexes[byteutils.Hex(chunkHeader.Root)] = i
+	}
+
+	// the bodies are downloaded from all the peers agreeing on the chunk headers.
+	for _, peer := range st.maxConsistentChunkHeadersChainSyncPeers[byteutils.Hex(st.maxConsistentChunkHeaders.Root)] {
+		st.peerWindows[peer] = newPeerWindow(st.config)
+	}
+
+	// the chunk data downloaded before restart are verified against the chunk roots and reused.
+	if err := st.store.savePendingSync(st.syncPointBlock.Hash(), st.maxConsistentChunkHeaders); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to persist the pending sync.")
+	}
+	for i, chunkHeader := range st.maxConsistentChunkHeaders.ChunkHeaders {
+		if chunkData := st.store.loadChunkData(chunkHeader); chunkData != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"root": byteutils.Hex(chunkHeader.Root),
+			}).Debugf("Reuse chain chunk %d from store.", i)
+			st.chainChunkTasks[i].stage = chunkStageReady
+			st.chainChunkData[i] = chunkData
 		}
 	}
+	st.executeNextChunk()
+
+	st.scheduleChunkRequests()
+}
 
-	st.chainChunkDataSyncPosition = chainChunkDataSyncPosition
+// scheduleChunkRequests sends the requests of the chunks in order, as long as the peer windows allow.
+func (st *Task) scheduleChunkRequests() {
+	for i := 0; i < len(st.maxConsistentChunkHeaders.ChunkHeaders); i++ {
+		task := st.chainChunkTasks[i]
+		if task.peer != "" || task.stage >= chunkStageReady {
+			continue
+		}
+
+		for {
+			peer := st.selectChunkPeer()
+			if peer == "" {
+				return
+			}
+			if st.chunkRequest(i, peer) {
+				break
+			}
+		}
+	}
+}
+
+// selectChunkPeer return the peer with the largest available window, "" if all are busy.
+func (st *Task) selectChunkPeer() string {
+	selected, available := "", 0
+	for peer, window := range st.peerWindows {
+		if window.available() > available {
+			selected, available = peer, window.available()
+		}
+	}
+	return selected
+}
+
+func (st *Task) chunkRequest(chunkHeaderIndex int, peer string) bool {
+	task := st.chainChunkTasks[chunkHeaderIndex]
+
+	chunkHeader := st.maxConsistentChunkHeaders.ChunkHeaders[chunkHeaderIndex]
+	data, err := proto.Marshal(chunkHeader)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to marshal ChunkHeader.")
+		return false
+	}
+
+	messageName := net.ChunkDataRequest
+	switch task.stage {
+	case chunkStageHeaders:
+		messageName = net.BlockHeadersRequest
+	case chunkStageBodies:
+		messageName = net.BlockBodiesRequest
+	}
+
+	if err := st.netService.SendMessageToPeer(messageName, data, net.MessagePriorityLow, peer); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"peer": peer,
+		}).Debug("Failed to send chunk request, drop the peer.")
+		delete(st.peerWindows, peer)
+		return false
+	}
+
+	st.peerWindows[peer].onRequest()
+	task.peer = peer
+	task.requestAt = time.Now()
+
+	logging.VLog().WithFields(logrus.Fields{
+		"peer":  peer,
+		"stage": task.stage,
+	}).Debugf("Send to get chain chunk %d.", chunkHeaderIndex)
+	return true
+}
+
+// releaseChunkRequest ends the request of a chunk and adapts the window of its peer.
+func (st *Task) releaseChunkRequest(task *chunkTask, peer string, success bool) {
+	if task.peer != peer {
+		// a late response, the request was released at timeout.
+		return
+	}
+	if window, ok := st.peerWindows[peer]; ok {
+		if success {
+			window.onSuccess(time.Since(task.requestAt))
+		} else {
+			window.onFailure()
+		}
+	}
+	task.peer = ""
+}
+
+// failChunkRequest counts a failure of the chunk, it falls back to download the whole chunk data after too many.
+func (st *Task) failChunkRequest(task *chunkTask) {
+	task.failures++
+	if task.stage != chunkStageChunkData && task.failures >= MaxHeaderFirstFailures {
+		task.stage = chunkStageChunkData
+		task.headers = nil
+	}
 }
 
 func (st *Task) checkChainGetChunkTimeout() {
@@ -383,49 +692,227 @@ func (st *Task) checkChainGetChunkTimeout() {
 	st.syncMutex.Lock()
 	defer st.syncMutex.Unlock()
 
-	logging.VLog().WithFields(logrus.Fields{
-		"syncPointBlockHeight": st.syncPointBlock.Height(),
-		"syncPointBlockHash":   st.syncPointBlock.Hash().String(),
-	}).Infof("Get Chunk at %d times.", st.chainSyncRetryCount)
-
-	st.chainSyncRetryCount++
-
-	for i := 0; i <= st.chainChunkDataSyncPosition; i++ {
-		t := st.chainChunkDataStatus[i]
+	if len(st.peerWindows) == 0 {
+		logging.VLog().Debug("No peer left to get chunk.")
+		st.chainSyncRetryCount = MaxGetChunkDataRetries + 1
+		return
+	}
 
-		if t == chunkDataStatusFinished || t == chunkDataStatusNotStart {
+	timeout := false
+	for i := 0; i < len(st.maxConsistentChunkHeaders.ChunkHeaders); i++ {
+		task := st.chainChunkTasks[i]
+		if task.peer == "" || time.Since(task.requestAt) < st.config.ChunkDataTimeout {
 			continue
 		}
 
 		logging.VLog().WithFields(logrus.Fields{
 			"rootHash": byteutils.Hex(st.maxConsistentChunkHeaders.Root),
-			"timout":   time.Now().Unix() - st.chainChunkDataStatus[i],
+			"peer":     task.peer,
+			"stage":    task.stage,
+			"timout":   time.Since(task.requestAt),
 		}).Debugf("Get Chunk %d Timout. Retry.", i)
 
-		st.chunkDataRequest(i)
+		timeout = true
+		st.releaseChunkRequest(task, task.peer, false)
+		st.failChunkRequest(task)
+	}
+
+	if timeout {
+		logging.VLog().WithFields(logrus.Fields{
+			"syncPointBlockHeight": st.syncPointBlock.Height(),
+			"syncPointBlockHash":   st.syncPointBlock.Hash().String(),
+		}).Infof("Get Chunk at %d times.", st.chainSyncRetryCount)
+		st.chainSyncRetryCount++
 	}
+
+	st.scheduleChunkRequests()
 }
 
-func (st *Task) chunkDataRequest(chunkHeaderIndex int) {
-	chunkHeader := st.maxConsistentChunkHeaders.ChunkHeaders[chunkHeaderIndex]
-	data, err := proto.Marshal(chunkHeader)
+// chunkIndex return the index of the chunk with the given root, -1 if not found.
+func (st *Task) chunkIndex(root []byte) int {
+	if st.maxConsistentChunkHeaders == nil {
+		return -1
+	}
+	if idx, ok := st.chainChunkIndexes[byteutils.Hex(root)]; ok {
+		return idx
+	}
+	return -1
+}
+
+// chunkSize return the size of the agreed chunks, the default one if they are from the old peers.
+func (st *Task) chunkSize() uint64 {
+	if headers := st.maxConsistentChunkHeaders; headers != nil && len(headers.ChunkHeaders) > 0 {
+		return uint64(len(headers.ChunkHeaders[0].Headers))
+	}
+	return st.config.ChunkSize
+}
+
+// chunksParentHeight return the height of the block before the first chunk,
+// the first chunk starts after the chunk containing the sync point, which is on local chain.
+func (st *Task) chunksParentHeight() uint64 {
+	chunkSize := st.chunkSize()
+	return (st.syncPointBlock.Height()-1)/chunkSize*chunkSize + 1
+}
+
+// chunkParentHash return the hash of the block before the chunk.
+func (st *Task) chunkParentHash(chunkHeaderIndex int) byteutils.Hash {
+	if chunkHeaderIndex > 0 {
+		headers := st.maxConsistentChunkHeaders.ChunkHeaders[chunkHeaderIndex-1].Headers
+		return headers[len(headers)-1]
+	}
+
+	parent := st.blockChain.GetBlockOnCanonicalChainByHeight(st.chunksParentHeight())
+	if parent == nil {
+		return nil
+	}
+	return parent.Hash()
+}
+
+// blockHashAtHeight return the hash of the block at the height on local chain or in the chunk headers,
+// nil if not found.
+func (st *Task) blockHashAtHeight(height uint64) byteutils.Hash {
+	parentHeight := st.chunksParentHeight()
+	if height <= parentHeight {
+		block := st.blockChain.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil
+		}
+		return block.Hash()
+	}
+
+	offset := height - parentHeight - 1
+	for _, v := range st.maxConsistentChunkHeaders.ChunkHeaders {
+		if offset < uint64(len(v.Headers)) {
+			return v.Headers[offset]
+		}
+		offset -= uint64(len(v.Headers))
+	}
+	return nil
+}
+
+// chunkRandomInputs return the inputs to verify the vrf of the chunk headers,
+// nil if the blocks have no vrf proof.
+func (st *Task) chunkRandomInputs(chunkHeaderIndex int) *randomInputs {
+	if _, ok := st.blockChain.ConsensusHandler().(core.InstantSealer); ok {
+		return nil
+	}
+
+	inputs := &randomInputs{
+		genesisHash:     st.blockChain.GenesisBlock().Hash(),
+		blocksInDynasty: st.blockChain.ConsensusHandler().NumberOfBlocksInDynasty(),
+		blockHash:       st.blockHashAtHeight,
+	}
+	if chunkHeaderIndex > 0 {
+		inputs.parentSeed = st.chainChunkTasks[chunkHeaderIndex-1].seed
+		return inputs
+	}
+
+	parentHeight := st.chunksParentHeight()
+	if !core.RandomAvailableAtHeight(parentHeight + 1) {
+		return inputs
+	}
+	if parent := st.blockChain.GetBlockOnCanonicalChainByHeight(parentHeight); parent != nil {
+		if _, parentSeed, err := st.blockChain.GetInputForVRFSigner(parent.Hash(), parentHeight+1); err == nil {
+			inputs.parentSeed = parentSeed
+		}
+	}
+	return inputs
+}
+
+func (st *Task) processBlockHeaders(message net.Message) {
+	// lock.
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	blockHeaders := new(syncpb.BlockHeaders)
+	if err := proto.Unmarshal(message.Data(), blockHeaders); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid BlockHeaders message data.")
+		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidBlockHeadersMessageData)
+		return
+	}
+
+	idx := st.chunkIndex(blockHeaders.Root)
+	if idx < 0 || st.chainChunkTasks[idx].stage != chunkStageHeaders {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": message.MessageFrom(),
+		}).Debug("Unexpected BlockHeaders message data.")
+		return
+	}
+	task := st.chainChunkTasks[idx]
+
+	chunkHeader := st.maxConsistentChunkHeaders.ChunkHeaders[idx]
+	inputs := st.chunkRandomInputs(idx)
+	headers, err := verifyBlockHeaders(st.blockChain.ChainID(), st.blockChain.ConsensusHandler(), chunkHeader, st.chunkParentHash(idx), inputs, blockHeaders)
 	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"pid":   message.MessageFrom(),
+			"index": idx,
+		}).Debug("Wrong BlockHeaders message data, retry.")
+		st.releaseChunkRequest(task, message.MessageFrom(), false)
+		st.failChunkRequest(task)
+		if isWrongChunkData(err) {
+			st.netService.ClosePeer(message.MessageFrom(), err)
+			delete(st.peerWindows, message.MessageFrom())
+		}
+		st.scheduleChunkRequests()
+		return
+	}
+
+	st.releaseChunkRequest(task, message.MessageFrom(), true)
+	task.headers = headers
+	if inputs != nil && len(headers) > 0 {
+		task.seed = inputs.childSeed(headers[len(headers)-1])
+	}
+	task.stage = chunkStageBodies
+	st.chainSyncRetryCount = 0
+
+	st.scheduleChunkRequests()
+}
+
+func (st *Task) processBlockBodies(message net.Message) {
+	// lock.
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	blockBodies := new(syncpb.BlockBodies)
+	if err := proto.Unmarshal(message.Data(), blockBodies); err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"err": err,
-		}).Warn("Failed to marshal ChunkHeader.")
+			"pid": message.MessageFrom(),
+		}).Debug("Invalid BlockBodies message data.")
+		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidBlockBodiesMessageData)
 		return
 	}
 
-	// random
-	peers := st.maxConsistentChunkHeadersChainSyncPeers[byteutils.Hex(st.maxConsistentChunkHeaders.Root)]
-	idx := rand.Intn(len(peers))
-	st.netService.SendMessageToPeer(net.ChunkDataRequest, data, net.MessagePriorityLow, peers[idx])
+	idx := st.chunkIndex(blockBodies.Root)
+	if idx < 0 || st.chainChunkTasks[idx].stage != chunkStageBodies {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid": message.MessageFrom(),
+		}).Debug("Unexpected BlockBodies message data.")
+		return
+	}
+	task := st.chainChunkTasks[idx]
 
-	st.chainChunkDataStatus[chunkHeaderIndex] = time.Now().Unix()
+	chunkData, err := assembleChunkData(st.maxConsistentChunkHeaders.ChunkHeaders[idx], task.headers, blockBodies)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"pid":   message.MessageFrom(),
+			"index": idx,
+		}).Debug("Wrong BlockBodies message data, retry.")
+		st.releaseChunkRequest(task, message.MessageFrom(), false)
+		st.netService.ClosePeer(message.MessageFrom(), err)
+		delete(st.peerWindows, message.MessageFrom())
+		st.scheduleChunkRequests()
+		return
+	}
 
-	logging.VLog().WithFields(logrus.Fields{
-		"peers": peers,
-	}).Debugf("Send to get chain chunk %d.", chunkHeaderIndex)
+	st.releaseChunkRequest(task, message.MessageFrom(), true)
+	st.chunkReady(idx, chunkData, message.MessageFrom())
 }
 
 func (st *Task) processChunkData(message net.Message) {
@@ -433,6 +920,12 @@ func (st *Task) processChunkData(message net.Message) {
 	st.syncMutex.Lock()
 	defer st.syncMutex.Unlock()
 
+	// chunk data of the pivot ancestors.
+	if st.stateSync != nil {
+		st.stateSync.put(st.stateSync.chunkDataCh, message)
+		return
+	}
+
 	// if maxConsistentChunkHeaders is nil, return
 	if st.maxConsistentChunkHeaders == nil || st.maxConsistentChunkHeaders.ChunkHeaders == nil {
 		logging.VLog().WithFields(logrus.Fields{
@@ -453,17 +946,7 @@ func (st *Task) processChunkData(message net.Message) {
 	}
 
 	// verify chunk data.
-	chunkDataIndex := -1
-	var chunkHeader *syncpb.ChunkHeader
-
-	for i := 0; i < len(st.maxConsistentChunkHeaders.ChunkHeaders); i++ { // TODO: why not map?
-		chunkHeader = st.maxConsistentChunkHeaders.ChunkHeaders[i]
-		if bytes.Compare(chunkHeader.Root, chunkData.Root) == 0 {
-			chunkDataIndex = i
-			break
-		}
-	}
-
+	chunkDataIndex := st.chunkIndex(chunkData.Root)
 	if chunkDataIndex < 0 {
 		logging.VLog().WithFields(logrus.Fields{
 			"pid": message.MessageFrom(),
@@ -472,89 +955,229 @@ func (st *Task) processChunkData(message net.Message) {
 		return
 	}
 
-	if st.chainChunkDataStatus[chunkDataIndex] == chunkDataStatusFinished {
+	task := st.chainChunkTasks[chunkDataIndex]
+	if task.stage >= chunkStageReady {
 		logging.VLog().WithFields(logrus.Fields{
 			"pid": message.MessageFrom(),
 		}).Debug("Duplicated ChainChunkData message data.")
 		return
 	}
 
+	chunkHeader := st.maxConsistentChunkHeaders.ChunkHeaders[chunkDataIndex]
 	if ok, err := verifyChunkData(chunkHeader, chunkData); ok == false {
 		logging.VLog().WithFields(logrus.Fields{
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Wrong ChainChunkData message data, retry.")
+		st.releaseChunkRequest(task, message.MessageFrom(), false)
 		st.netService.ClosePeer(message.MessageFrom(), err)
-		st.chunkDataRequest(chunkDataIndex)
+		delete(st.peerWindows, message.MessageFrom())
+		st.scheduleChunkRequests()
+		return
+	}
+
+	st.releaseChunkRequest(task, message.MessageFrom(), true)
+	st.chunkReady(chunkDataIndex, chunkData, message.MessageFrom())
+}
+
+func (st *Task) processStatePivot(message net.Message) {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	if st.stateSync == nil {
 		return
 	}
+	st.stateSync.put(st.stateSync.pivotCh, message)
+}
+
+func (st *Task) processTrieNodes(message net.Message) {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
 
+	if st.stateSync == nil {
+		return
+	}
+	st.stateSync.put(st.stateSync.nodesCh, message)
+}
+
+// chunkReady queues the downloaded chunk for execution.
+func (st *Task) chunkReady(chunkDataIndex int, chunkData *syncpb.ChunkData, source string) {
+	task := st.chainChunkTasks[chunkDataIndex]
+	task.stage = chunkStageReady
+	task.source = source
+	task.headers = nil
 	st.chainChunkData[chunkDataIndex] = chunkData
+	st.chainSyncRetryCount = 0
+	st.downloadedBlocks += uint64(len(chunkData.Blocks))
+	if err := st.store.saveChunkData(chunkData); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Warn("Failed to persist chunk data.")
+	}
+
+	st.executeNextChunk()
+	st.scheduleChunkRequests()
+}
+
+// executeNextChunk executes the chunks in order in background, one at a time,
+// so that the downloads go on while blocks are executed.
+func (st *Task) executeNextChunk() {
+	if st.executing {
+		return
+	}
 	chunk, ok := st.chainChunkData[st.chainChunkDataProcessPosition]
-	for ok {
-		// startAt := time.Now().Unix()
+	if !ok {
+		return
+	}
+
+	st.executing = true
+	round := st.executingRound
+	index := st.chainChunkDataProcessPosition
+	cancelCh, doneCh := make(chan bool), make(chan bool)
+	st.executionCancelCh, st.executionDoneCh = cancelCh, doneCh
+	go func() {
+		defer close(doneCh)
 		last, err := st.chunk.processChunkData(chunk)
-		if err != nil {
-			logging.VLog().WithFields(logrus.Fields{
-				"err": err,
-				"pid": message.MessageFrom(),
-			}).Debug("Wrong ChainChunkData message data, retry.")
-			st.netService.ClosePeer(message.MessageFrom(), err)
-			st.chunkDataRequest(chunkDataIndex)
-			return
+		select {
+		case st.chunkExecutedCh <- &chunkExecuted{round: round, index: index, size: len(chunk.Blocks), last: last, err: err}:
+		case <-cancelCh:
 		}
+	}()
+}
 
-		st.syncPointBlock = last
-		st.chainChunkDataProcessPosition++
-		chunk, ok = st.chainChunkData[st.chainChunkDataProcessPosition]
-	}
+func (st *Task) onChunkExecuted(result *chunkExecuted) {
+	// lock.
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
 
-	// mark done.
-	st.chainChunkDataStatus[chunkDataIndex] = chunkDataStatusFinished
+	// the chunk executed before a reset.
+	if result.round != st.executingRound {
+		return
+	}
 
-	// sync next chunk.
-	st.sendChainGetChunkForNext()
-}
+	st.executing = false
+	task := st.chainChunkTasks[result.index]
+	delete(st.chainChunkData, result.index)
+	st.store.deleteChunkData(st.maxConsistentChunkHeaders.ChunkHeaders[result.index].Root)
 
-func (st *Task) sendChainGetChunkForNext() {
-	nextPos := st.chainChunkDataSyncPosition + 1
-	if nextPos >= len(st.maxConsistentChunkHeaders.ChunkHeaders) {
-		if st.hasFinishedGetAllChunkData() {
-			st.chinGetChunkDataDoneCh <- true
+	if result.err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   result.err,
+			"pid":   task.source,
+			"index": result.index,
+		}).Debug("Failed to execute chunk, retry.")
+		// the chunk data from store has no source.
+		if task.source != "" {
+			st.netService.ClosePeer(task.source, result.err)
+			delete(st.peerWindows, task.source)
 		}
+		task.stage = chunkStageHeaders
+		st.failChunkRequest(task)
+		st.scheduleChunkRequests()
 		return
 	}
 
-	st.chainChunkDataSyncPosition = nextPos
-	st.chunkDataRequest(nextPos)
-}
+	task.stage = chunkStageFinished
+	st.syncPointBlock = result.last
+	st.chainChunkDataProcessPosition++
+	st.executedBlocks += uint64(result.size)
+	st.triggerProgress()
+	st.savePendingSyncPoint()
 
-func (st *Task) hasEnoughChunkHeaders() bool {
-	chainSyncPeersCount := 0
-	if st.chainSyncPeers != nil {
-		chainSyncPeersCount = len(st.chainSyncPeers)
+	if st.chainChunkDataProcessPosition >= len(st.maxConsistentChunkHeaders.ChunkHeaders) {
+		logging.VLog().Info("Received enough chunk data.")
+		st.chinGetChunkDataDoneCh <- true
+		return
 	}
+	st.executeNextChunk()
+}
 
-	return chainSyncPeersCount > 0 && st.maxConsistentChunkHeadersCount >= int(chainSyncPeersCount/2)+1
+// savePendingSyncPoint persists the chunks not executed yet from the new sync point,
+// or clears the pending sync once all are executed. The caller should hold the lock.
+func (st *Task) savePendingSyncPoint() {
+	headers := st.maxConsistentChunkHeaders
+	if st.chainChunkDataProcessPosition >= len(headers.ChunkHeaders) {
+		st.store.clear()
+		return
+	}
+	remaining := &syncpb.ChunkHeaders{
+		ChunkHeaders: headers.ChunkHeaders[st.chainChunkDataProcessPosition:],
+		Root:         headers.Root,
+		TailHeight:   headers.TailHeight,
+	}
+	if err := st.store.savePendingSync(st.syncPointBlock.Hash(), remaining); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"syncpoint": st.syncPointBlock,
+			"err":       err,
+		}).Warn("Failed to persist the pending sync.")
+	}
 }
 
-func (st *Task) hasFinishedGetAllChunkData() bool {
-	total := len(st.maxConsistentChunkHeaders.ChunkHeaders)
-	missing := 0
-	for i := 0; i < total; i++ {
-		if st.chainChunkDataStatus[i] != chunkDataStatusFinished {
-			missing++
+// progress return the progress of the task, the caller should hold the lock.
+func (st *Task) progress() *core.SyncProgress {
+	progress := &core.SyncProgress{
+		Syncing:        !st.finished,
+		StartingHeight: st.startingHeight,
+		CurrentHeight:  st.blockChain.TailBlock().Height(),
+		HighestHeight:  st.highestHeight,
+	}
+	if progress.HighestHeight < progress.CurrentHeight {
+		progress.HighestHeight = progress.CurrentHeight
+	}
+
+	for _, task := range st.chainChunkTasks {
+		if task.stage >= chunkStageReady {
+			continue
+		}
+		if task.peer != "" {
+			progress.InflightChunks++
+		} else {
+			progress.PendingChunks++
 		}
 	}
 
-	if missing > 0 {
-		logging.VLog().WithFields(logrus.Fields{
-			"totalSyncingChunkHeaders": total,
-			"missingCount":             missing,
-		}).Debug("Waiting for ChunkData.")
-		return false
+	if elapsed := time.Since(st.startAt).Seconds(); elapsed > 0 {
+		progress.DownloadRate = float64(st.downloadedBlocks) / elapsed
+		progress.ExecutionRate = float64(st.executedBlocks) / elapsed
+	}
+	if progress.ExecutionRate > 0 {
+		progress.ETA = int64(float64(progress.HighestHeight-progress.CurrentHeight) / progress.ExecutionRate)
 	}
+	return progress
+}
 
-	logging.VLog().Info("Received enough chunk data.")
-	return true
+// Progress return the progress of the task
+func (st *Task) Progress() *core.SyncProgress {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	return st.progress()
+}
+
+// triggerProgress emits the progress, the caller should hold the lock.
+func (st *Task) triggerProgress() {
+	st.blockChain.EventEmitter().Trigger(&state.Event{
+		Topic: core.TopicSyncProgress,
+		Data:  st.progress().String(),
+	})
+}
+
+// isWrongChunkData return if the error proves the data from peer is wrong,
+// other errors, e.g. from consensus, may be caused by the local state.
+func isWrongChunkData(err error) bool {
+	switch err {
+	case ErrWrongBlockHeadersSize, ErrWrongBlockHashInChunk, ErrWrongParentHashInChunk, ErrInvalidBlockHashInChunk,
+		core.ErrInvalidChainID, core.ErrInvalidProtoToCompactBlock, core.ErrInvalidProtoToBlockHeader, core.ErrVRFProofFailed:
+		return true
+	}
+	return false
+}
+
+func (st *Task) hasEnoughChunkHeaders() bool {
+	chainSyncPeersCount := 0
+	if st.chainSyncPeers != nil {
+		chainSyncPeersCount = len(st.chainSyncPeers)
+	}
+
+	return chainSyncPeersCount > 0 && st.maxConsistentChunkHeadersCount >= int(chainSyncPeersCount/2)+1
 }
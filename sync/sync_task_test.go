@@ -0,0 +1,419 @@
+// Copyright (C) 2018 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/core"
+	nebletpb "github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
+	syncpb "github.com/nebulasio/go-nebulas/sync/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+// mintBlocks mints count blocks on the chain of neb, every fourth one packs a transfer.
+func mintBlocks(t *testing.T, neb *core.MockNeb, count int) {
+	chain := neb.BlockChain()
+	from, _ := core.AddressParse("n1FF1nz6tarkDVwWQkMnnwFPuPKUaQTdptE")
+	assert.Nil(t, neb.AccountManager().Unlock(from, []byte("passphrase"), time.Second*60*60*24*365))
+
+	for i := 0; i < count; i++ {
+		context, err := chain.TailBlock().WorldState().NextConsensusState(dpos.BlockIntervalInMs / dpos.SecondInMs)
+		assert.Nil(t, err)
+		coinbase, err := core.AddressParseFromBytes(context.Proposer())
+		assert.Nil(t, err)
+		assert.Nil(t, neb.AccountManager().Unlock(coinbase, []byte("passphrase"), time.Second*60*60*24*365))
+
+		block, err := chain.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.WorldState().SetConsensusState(context)
+		block.SetTimestamp(chain.TailBlock().Timestamp() + dpos.BlockIntervalInMs/dpos.SecondInMs)
+		if core.RandomAvailableAtHeight(block.Height()) {
+			ancestorHash, parentSeed, err := chain.GetInputForVRFSigner(block.ParentHash(), block.Height())
+			assert.Nil(t, err)
+			vrfSeed, vrfProof, err := neb.AccountManager().GenerateRandomSeed(coinbase, ancestorHash, parentSeed)
+			assert.Nil(t, err)
+			block.SetRandomSeed(vrfSeed, vrfProof)
+		}
+		if i%4 == 0 {
+			value, _ := util.NewUint128FromInt(1)
+			gasLimit, _ := util.NewUint128FromInt(200000)
+			tx, _ := core.NewTransaction(chain.ChainID(), from, from, value, uint64(i/4+1), core.TxPayloadBinaryType, []byte("nas"), core.TransactionGasPrice, gasLimit)
+			assert.Nil(t, neb.AccountManager().SignTransaction(from, tx))
+			assert.Nil(t, chain.TransactionPool().Push(tx))
+			block.CollectTransactions(time.Now().UnixNano()/1e6 + 200)
+			assert.Equal(t, 1, len(block.Transactions()))
+		}
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, neb.AccountManager().SignBlock(coinbase, block))
+		assert.Nil(t, chain.BlockPool().Push(block))
+	}
+}
+
+func TestChunk_headerFirst(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 40)
+
+	ck := NewChunk(neb.BlockChain())
+	meta, err := ck.generateChunkHeaders(neb.BlockChain().GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, len(meta.ChunkHeaders), 1)
+	chunkHeader := meta.ChunkHeaders[0]
+
+	// verified on a fresh node.
+	neb2 := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	chain2 := neb2.BlockChain()
+	parentHash := chain2.GenesisBlock().Hash()
+
+	blockHeaders, err := ck.generateBlockHeaders(chunkHeader)
+	assert.Nil(t, err)
+	headers, err := verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, nil, blockHeaders)
+	assert.Nil(t, err)
+	assert.Equal(t, len(headers), core.ChunkSize)
+
+	blockBodies, err := ck.generateBlockBodies(chunkHeader)
+	assert.Nil(t, err)
+	chunkData, err := assembleChunkData(chunkHeader, headers, blockBodies)
+	assert.Nil(t, err)
+	ok, err := verifyChunkData(chunkHeader, chunkData)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	last, err := NewChunk(chain2).processChunkData(chunkData)
+	assert.Nil(t, err)
+	assert.True(t, last.Hash().Equals(chunkHeader.Headers[core.ChunkSize-1]))
+
+	// wrong parent.
+	_, err = verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, chunkHeader.Headers[0], nil, blockHeaders)
+	assert.Equal(t, ErrWrongParentHashInChunk, err)
+
+	// missing header.
+	blockHeaders.Headers = blockHeaders.Headers[1:]
+	_, err = verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, nil, blockHeaders)
+	assert.Equal(t, ErrWrongBlockHeadersSize, err)
+
+	// tampered header.
+	blockHeaders, _ = ck.generateBlockHeaders(chunkHeader)
+	blockHeaders.Headers[1].Header.Timestamp++
+	_, err = verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, nil, blockHeaders)
+	assert.Equal(t, ErrInvalidBlockHashInChunk, err)
+
+	// bodies swapped, every fourth block packs a transfer.
+	blockBodies.Bodies[0], blockBodies.Bodies[4] = blockBodies.Bodies[4], blockBodies.Bodies[0]
+	_, err = assembleChunkData(chunkHeader, headers, blockBodies)
+	assert.Equal(t, core.ErrInvalidTransactionHash, err)
+	blockBodies.Bodies[0], blockBodies.Bodies[1] = blockBodies.Bodies[1], blockBodies.Bodies[0]
+	_, err = assembleChunkData(chunkHeader, headers, blockBodies)
+	assert.Equal(t, core.ErrInvalidTransactionData, err)
+}
+
+func TestChunk_headerRandom(t *testing.T) {
+	compatibility := core.NebCompatibility
+	core.NebCompatibility = core.NewCompatibilityLocal()
+	defer func() { core.NebCompatibility = compatibility }()
+
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 40)
+
+	ck := NewChunk(neb.BlockChain())
+	meta, err := ck.generateChunkHeaders(neb.BlockChain().GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	chunkHeader := meta.ChunkHeaders[0]
+	blockHeaders, err := ck.generateBlockHeaders(chunkHeader)
+	assert.Nil(t, err)
+
+	neb2 := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	chain2 := neb2.BlockChain()
+	parentHash := chain2.GenesisBlock().Hash()
+	inputs := &randomInputs{
+		genesisHash:     chain2.GenesisBlock().Hash(),
+		blocksInDynasty: chain2.ConsensusHandler().NumberOfBlocksInDynasty(),
+		parentSeed:      chain2.GenesisBlock().Hash(),
+		blockHash:       func(height uint64) byteutils.Hash { return nil },
+	}
+	headers, err := verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, inputs, blockHeaders)
+	assert.Nil(t, err)
+	assert.Equal(t, headers[len(headers)-1].VrfSeed(), inputs.childSeed(headers[len(headers)-1]))
+
+	// the seed of the parent is unknown yet.
+	inputs.parentSeed = nil
+	_, err = verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, inputs, blockHeaders)
+	assert.Nil(t, err)
+
+	// wrong inputs.
+	inputs.parentSeed = []byte("wrong seed")
+	_, err = verifyBlockHeaders(chain2.ChainID(), chain2.ConsensusHandler(), chunkHeader, parentHash, inputs, blockHeaders)
+	assert.Equal(t, core.ErrVRFProofFailed, err)
+}
+
+func TestChunk_checkpoints(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	mintBlocks(t, neb, 3*core.ChunkSize)
+	chain := neb.BlockChain()
+
+	meta, err := NewChunk(chain).generateChunkHeaders(chain.GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(meta.ChunkHeaders))
+
+	chain2 := core.NewMockNeb(am, dpos.NewDpos(), nil).BlockChain()
+	block := chain.GetBlockOnCanonicalChainByHeight(core.ChunkSize)
+	assert.Nil(t, chain2.SetCheckpoints(map[uint64]string{block.Height(): block.Hash().String()}))
+	assert.Nil(t, verifyChunkHeadersCheckpoints(chain2, 1, core.ChunkSize, meta))
+
+	// a checkpoint on another chain.
+	assert.Nil(t, chain2.SetCheckpoints(map[uint64]string{block.Height(): block.ParentHash().String()}))
+	assert.Equal(t, core.ErrCheckpointMismatch, verifyChunkHeadersCheckpoints(chain2, 1, core.ChunkSize, meta))
+
+	// the chunks generated from a later sync point.
+	syncPoint := chain.GetBlockOnCanonicalChainByHeight(core.ChunkSize + 1)
+	meta, err = NewChunk(chain).generateChunkHeaders(syncPoint.Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(meta.ChunkHeaders))
+	last := chain.TailBlock()
+	assert.Nil(t, chain2.SetCheckpoints(map[uint64]string{last.Height(): last.Hash().String()}))
+	assert.Nil(t, verifyChunkHeadersCheckpoints(chain2, syncPoint.Height(), core.ChunkSize, meta))
+	assert.Nil(t, chain2.SetCheckpoints(map[uint64]string{last.Height(): last.ParentHash().String()}))
+	assert.Equal(t, core.ErrCheckpointMismatch, verifyChunkHeadersCheckpoints(chain2, syncPoint.Height(), core.ChunkSize, meta))
+}
+
+func TestPeerWindow(t *testing.T) {
+	w := newPeerWindow(DefaultConfig())
+	assert.Equal(t, InitialPeerWindowSize, w.available())
+
+	for i := 0; i < 2*MaxPeerWindowSize; i++ {
+		w.onRequest()
+		w.onSuccess(time.Second)
+	}
+	assert.Equal(t, MaxPeerWindowSize, w.size)
+	assert.Equal(t, 0, w.inflight)
+
+	w.onRequest()
+	w.onRequest()
+	assert.Equal(t, MaxPeerWindowSize-2, w.available())
+	w.onFailure()
+	assert.Equal(t, MaxPeerWindowSize/2, w.size)
+	assert.Equal(t, 1, w.inflight)
+
+	for i := 0; i < MaxPeerWindowSize; i++ {
+		w.onFailure()
+	}
+	assert.Equal(t, MinPeerWindowSize, w.size)
+	assert.Equal(t, 0, w.inflight)
+}
+
+func TestPeerWindow_throughput(t *testing.T) {
+	w := newPeerWindow(DefaultConfig())
+
+	// the fast peer gets more requests in flight.
+	for i := 0; i < 2*MaxPeerWindowSize; i++ {
+		w.onRequest()
+		w.onSuccess(100 * time.Millisecond)
+	}
+	assert.Equal(t, MaxPeerWindowSize, w.size)
+	assert.InDelta(t, float64(MaxPeerWindowSize)/0.1, w.throughput, 1)
+
+	// the slow peer gets fewer, enough to answer in the target latency.
+	for i := 0; i < 32; i++ {
+		w.onRequest()
+		w.onSuccess(4 * GetChunkDataTimeout * time.Second / 5)
+	}
+	assert.Equal(t, MinPeerWindowSize+1, w.size)
+	assert.Equal(t, 0, w.inflight)
+}
+
+func TestTask_configuredChunkSize(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+
+	network := net.NewSimNetwork(1)
+	defer network.Close()
+
+	var nebs []*core.MockNeb
+	var services []*Service
+	for _, id := range []string{"a", "b"} {
+		ns := network.NewService(id)
+		assert.Nil(t, ns.Start())
+		defer ns.Stop()
+		neb := core.NewMockNebWithNetService(am, dpos.NewDpos(), nil, ns)
+		service := NewService(neb.BlockChain(), ns)
+		service.Start()
+		defer service.Stop()
+		nebs = append(nebs, neb)
+		services = append(services, service)
+	}
+	mintBlocks(t, nebs[0], 3*core.ChunkSize+8)
+
+	// b syncs in smaller chunks, a few of them in a request.
+	config, err := NewConfig(&nebletpb.SyncConfig{ChunkSize: 16, MaxChunksPerRequest: 2, SyncInterval: 1})
+	assert.Nil(t, err)
+	services[1].SetConfig(config)
+
+	assert.True(t, services[1].StartActiveSync())
+	done := make(chan bool)
+	go func() {
+		services[1].WaitingForFinish()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(60 * time.Second):
+		t.Fatal("sync timeout")
+	}
+
+	// the blocks after the last full chunk are left to the block pool.
+	expect := nebs[0].BlockChain().GetBlockOnCanonicalChainByHeight(6*16 + 1)
+	actual := nebs[1].BlockChain().TailBlock()
+	assert.Equal(t, expect.Hash(), actual.Hash())
+}
+
+func TestTask_headerFirstSync(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+
+	network := net.NewSimNetwork(1)
+	defer network.Close()
+
+	var nebs []*core.MockNeb
+	var services []*Service
+	for _, id := range []string{"a", "b", "c"} {
+		ns := network.NewService(id)
+		assert.Nil(t, ns.Start())
+		defer ns.Stop()
+		neb := core.NewMockNebWithNetService(am, dpos.NewDpos(), nil, ns)
+		service := NewService(neb.BlockChain(), ns)
+		service.Start()
+		defer service.Stop()
+		nebs = append(nebs, neb)
+		services = append(services, service)
+	}
+
+	// a and b have the same chain, c syncs from them.
+	mintBlocks(t, nebs[0], 3*core.ChunkSize+8)
+	ck := NewChunk(nebs[0].BlockChain())
+	meta, err := ck.generateChunkHeaders(nebs[0].BlockChain().GenesisBlock().Hash(), core.ChunkSize, MaxChunkPerSyncRequest)
+	assert.Nil(t, err)
+	for _, header := range meta.ChunkHeaders {
+		chunkData, err := ck.generateChunkData(header)
+		assert.Nil(t, err)
+		_, err = NewChunk(nebs[1].BlockChain()).processChunkData(chunkData)
+		assert.Nil(t, err)
+	}
+
+	emitter := nebs[2].EventEmitter()
+	subscriber := core.NewEventSubscriber(128, []string{core.TopicSyncProgress})
+	emitter.Register(subscriber)
+	emitter.Start()
+	defer emitter.Stop()
+
+	assert.True(t, services[2].StartActiveSync())
+	progress := services[2].SyncProgress()
+	assert.True(t, progress.Syncing)
+	assert.Equal(t, uint64(1), progress.StartingHeight)
+
+	done := make(chan bool)
+	go func() {
+		services[2].WaitingForFinish()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(30 * time.Second):
+		t.Fatal("sync timeout")
+	}
+
+	expect := nebs[1].BlockChain().TailBlock()
+	actual := nebs[2].BlockChain().TailBlock()
+	assert.Equal(t, expect.Height(), actual.Height())
+	assert.Equal(t, expect.Hash(), actual.Hash())
+
+	// the highest is the tail reported by the peers.
+	highest := nebs[0].BlockChain().TailBlock().Height()
+
+	// a progress event for each executed chunk and one at the end.
+	events := []*core.SyncProgress{}
+	for len(events) < 4 {
+		select {
+		case e := <-subscriber.EventChan():
+			p := new(core.SyncProgress)
+			assert.Nil(t, json.Unmarshal([]byte(e.Data), p))
+			events = append(events, p)
+		case <-time.After(time.Second):
+			t.Fatal("missing sync progress event")
+		}
+	}
+	for i, p := range events[:3] {
+		assert.True(t, p.Syncing)
+		assert.Equal(t, uint64(1), p.StartingHeight)
+		assert.Equal(t, uint64((i+1)*core.ChunkSize+1), p.CurrentHeight)
+		assert.Equal(t, highest, p.HighestHeight)
+		assert.True(t, p.ExecutionRate > 0)
+		assert.True(t, p.DownloadRate >= p.ExecutionRate)
+	}
+	assert.Equal(t, 0, events[2].PendingChunks+events[2].InflightChunks)
+	assert.False(t, events[3].Syncing)
+	assert.Equal(t, expect.Height(), events[3].CurrentHeight)
+	assert.Equal(t, highest, events[3].HighestHeight)
+
+	progress = services[2].SyncProgress()
+	assert.False(t, progress.Syncing)
+	assert.Equal(t, expect.Height(), progress.CurrentHeight)
+}
+
+func TestTask_resetStopsExecution(t *testing.T) {
+	am, err := account.NewManager(nil)
+	assert.Nil(t, err)
+	neb := core.NewMockNeb(am, dpos.NewDpos(), nil)
+	chain := neb.BlockChain()
+	task := NewTask(chain, nil, NewChunk(chain), DefaultConfig())
+
+	// a result of an old round is not consumed, the execution can not report.
+	task.chunkExecutedCh <- &chunkExecuted{round: task.executingRound}
+	task.chainChunkData[0] = &syncpb.ChunkData{}
+	task.executeNextChunk()
+	assert.True(t, task.executing)
+	doneCh := task.executionDoneCh
+
+	done := make(chan bool)
+	go func() {
+		task.reset()
+		done <- true
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reset blocked by the execution")
+	}
+	_, ok := <-doneCh
+	assert.False(t, ok)
+	assert.False(t, task.executing)
+	assert.Equal(t, 1, len(task.chunkExecutedCh))
+}
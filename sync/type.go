@@ -26,23 +26,45 @@ import (
 
 // Error Types
 var (
-	ErrTooSmallGapToSync        = errors.New("the gap between syncpoint and current tail is smaller than a dynasty interval, ignore the sync task")
-	ErrCannotFindBlockByHeight  = errors.New("cannot find the block at given height")
-	ErrCannotFindBlockByHash    = errors.New("cannot find the block with the given hash")
-	ErrWrongChunkHeaderRootHash = errors.New("wrong chunk header root hash")
-	ErrWrongChunkDataRootHash   = errors.New("wrong chunk data root hash")
-	ErrWrongChunkDataSize       = errors.New("wrong chunk data size")
-	ErrInvalidBlockHashInChunk  = errors.New("invalid block hash in chunk data")
-	ErrWrongBlockHashInChunk    = errors.New("wrong block hash in chunk data compared with chunk header")
+	ErrTooSmallGapToSync         = errors.New("the gap between syncpoint and current tail is smaller than a dynasty interval, ignore the sync task")
+	ErrCannotFindBlockByHeight   = errors.New("cannot find the block at given height")
+	ErrCannotFindBlockByHash     = errors.New("cannot find the block with the given hash")
+	ErrWrongChunkHeaderRootHash  = errors.New("wrong chunk header root hash")
+	ErrWrongChunkDataRootHash    = errors.New("wrong chunk data root hash")
+	ErrWrongChunkDataSize        = errors.New("wrong chunk data size")
+	ErrInvalidBlockHashInChunk   = errors.New("invalid block hash in chunk data")
+	ErrWrongBlockHashInChunk     = errors.New("wrong block hash in chunk data compared with chunk header")
+	ErrWrongParentHashInChunk    = errors.New("wrong parent hash in chunk headers")
+	ErrWrongBlockHeadersSize     = errors.New("wrong block headers size")
+	ErrWrongBlockBodiesSize      = errors.New("wrong block bodies size")
+	ErrInvalidStatePivot         = errors.New("invalid state pivot")
+	ErrStatePivotBelowCheckpoint = errors.New("state pivot is below the last checkpoint")
+	ErrStatePivotNotEndorsed     = errors.New("state pivot follows a dynasty not endorsed by the former one")
+	ErrStateSyncUnsupported      = errors.New("consensus cannot verify the headers for state sync")
+	ErrStateSyncStopped          = errors.New("state sync is stopped")
+	ErrStateSyncTimeout          = errors.New("state sync timeout")
+	ErrWrongTrieNodes            = errors.New("wrong trie nodes")
 )
 
-// Contants
+// Contants, the sizes and timeouts are the defaults of Config.
 const (
 	MaxChunkPerSyncRequest       = 10
 	ConcurrentSyncChunkDataCount = 10
 	GetChunkDataTimeout          = 10 // 10s.
+	SyncInterval                 = 10 // 10s.
+	MaxGetChunkDataRetries       = 10
+	MinPeerWindowSize            = 1
+	InitialPeerWindowSize        = 2
+	MaxPeerWindowSize            = 8
+	MaxHeaderFirstFailures       = 2
+	StateSyncMinGap              = 64
+	MaxStateSyncRetries          = 3
+	MaxStatePivotDynasties       = 24
 )
 
+// PeerFilterPolicySync is the policy of the network.peer_filters config selecting the peers asked for the sync.
+const PeerFilterPolicySync = "sync"
+
 // Metrics
 var (
 	metricsCachedSync = metrics.NewGauge("neb.sync.cached")